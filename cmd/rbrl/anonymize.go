@@ -0,0 +1,50 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"math/rand/v2"
+	"os"
+)
+
+// anonymizeLabels maps each team to a neutral label, Team 1 through Team
+// N. perm[i] is the index of the team that gets label i+1, so labels don't
+// follow division order unless perm does.
+func anonymizeLabels(teams []string, perm []int) map[string]string {
+	names := make(map[string]string, len(teams))
+	for i, j := range perm {
+		names[teams[j]] = fmt.Sprintf("Team %d", i+1)
+	}
+	return names
+}
+
+// shuffledLabels labels teams in a random order for --anonymize.
+func shuffledLabels(teams []string) map[string]string {
+	return anonymizeLabels(teams, rand.Perm(len(teams)))
+}
+
+// writeAnonymizeKey writes the label-to-team mapping as a CSV, in label
+// order, so a reviewer's notes can be matched back to teams afterwards.
+func writeAnonymizeKey(path string, teams []string, names map[string]string) error {
+	byLabel := make(map[string]string, len(names))
+	for _, team := range teams {
+		byLabel[names[team]] = team
+	}
+	records := [][]string{{"label", "team"}}
+	for i := 1; i <= len(teams); i++ {
+		label := fmt.Sprintf("Team %d", i)
+		records = append(records, []string{label, byLabel[label]})
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("writing anonymize key: %w", err)
+	}
+	w := csv.NewWriter(f)
+	w.WriteAll(records)
+	if err := w.Error(); err != nil {
+		f.Close()
+		return fmt.Errorf("writing anonymize key: %w", err)
+	}
+	return f.Close()
+}
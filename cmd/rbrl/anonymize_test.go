@@ -0,0 +1,46 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestAnonymize(t *testing.T) {
+	teams := []string{"Angels", "Cubs", "Padres"}
+
+	t.Run("labels follow the permutation", func(t *testing.T) {
+		names := anonymizeLabels(teams, []int{2, 0, 1})
+		want := map[string]string{"Padres": "Team 1", "Angels": "Team 2", "Cubs": "Team 3"}
+		for team, label := range want {
+			if names[team] != label {
+				t.Errorf("%s = %q, want %q", team, names[team], label)
+			}
+		}
+	})
+
+	t.Run("shuffled labels cover every team once", func(t *testing.T) {
+		seen := make(map[string]bool)
+		for _, label := range shuffledLabels(teams) {
+			seen[label] = true
+		}
+		if len(seen) != len(teams) || !seen["Team 1"] || !seen["Team 3"] {
+			t.Errorf("labels = %v, want Team 1 through Team 3", seen)
+		}
+	})
+
+	t.Run("key lists teams in label order", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "key.csv")
+		if err := writeAnonymizeKey(path, teams, anonymizeLabels(teams, []int{2, 0, 1})); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := "label,team\nTeam 1,Padres\nTeam 2,Angels\nTeam 3,Cubs\n"
+		if string(data) != want {
+			t.Errorf("key =\n%s\nwant\n%s", data, want)
+		}
+	})
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/derekprior/rbrl/internal/excel"
+)
+
+func runAudit(configPath, schedulePath string) error {
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+	report, err := excel.Audit(schedulePath, cfg)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", schedulePath, err)
+	}
+	problems := printAudit(os.Stdout, report, cfg.Season.StartDate.Time.Format("01/02/2006"))
+	if problems > 0 {
+		return fmt.Errorf("%s doesn't match the config: %d problem(s)", schedulePath, problems)
+	}
+	return nil
+}
+
+// printAudit prints each way the schedule and config disagree, returning
+// how many it found. seasonStart labels the out-of-season line.
+func printAudit(w io.Writer, r *excel.AuditReport, seasonStart string) int {
+	problems := 0
+	problem := func(format string, args ...any) {
+		problems++
+		fmt.Fprintf(w, "%s⚠ %s%s\n", colorYellow, fmt.Sprintf(format, args...), colorReset)
+	}
+
+	if len(r.UnknownTeams) > 0 {
+		problem("Teams in the schedule but not the config: %s", strings.Join(r.UnknownTeams, ", "))
+	}
+	if len(r.MissingTeams) > 0 {
+		problem("Teams in the config but not the schedule: %s", strings.Join(r.MissingTeams, ", "))
+	}
+	if len(r.UnknownFields) > 0 {
+		problem("Field columns that match no configured field: %s", strings.Join(r.UnknownFields, ", "))
+	}
+	if len(r.UnusedFields) > 0 {
+		problem("Configured fields with no column in the schedule: %s", strings.Join(r.UnusedFields, ", "))
+	}
+	if len(r.UnknownFields) > 0 && len(r.UnusedFields) > 0 {
+		fmt.Fprintf(w, "  (a field may have been renamed)\n")
+	}
+	if r.OutOfSeason > 0 {
+		problem("%d of %d games fall outside the season starting %s (schedule runs %s to %s)",
+			r.OutOfSeason, r.Games, seasonStart, r.First.Format("01/02/2006"), r.Last.Format("01/02/2006"))
+	}
+
+	if problems == 0 {
+		fmt.Fprintf(w, "%s✓ %d games match the config's teams, fields, and season%s\n", colorGreen, r.Games, colorReset)
+	}
+	return problems
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/derekprior/rbrl/internal/config"
+)
+
+// Exit codes, so scripts can tell failures apart. Anything not listed,
+// such as a bad flag or an unreadable workbook, exits 1.
+const (
+	exitFailure    = 1
+	exitConfig     = 2 // config file missing or invalid
+	exitIncomplete = 3 // generate could not schedule every game
+	exitViolations = 4 // the schedule breaks a hard constraint
+)
+
+// exitError carries the exit code a command's error should produce.
+type exitError struct {
+	code int
+	err  error
+}
+
+func (e *exitError) Error() string { return e.err.Error() }
+func (e *exitError) Unwrap() error { return e.err }
+
+// withExitCode tags err so main exits with code.
+func withExitCode(code int, err error) error {
+	return &exitError{code: code, err: err}
+}
+
+// exitCode returns the exit code for an error returned by a command.
+func exitCode(err error) int {
+	var e *exitError
+	if errors.As(err, &e) {
+		return e.code
+	}
+	return exitFailure
+}
+
+// loadConfig loads the config file, tagging any error as a config error.
+func loadConfig(path string) (*config.Config, error) {
+	cfg, err := config.LoadFromFile(path)
+	if err != nil {
+		return nil, withExitCode(exitConfig, fmt.Errorf("loading config: %w", err))
+	}
+	printConfigWarnings(cfg.Warnings())
+	return cfg, nil
+}
+
+// printConfigWarnings prints config mistakes that don't stop it loading.
+func printConfigWarnings(warnings []string) {
+	for _, w := range warnings {
+		fmt.Fprintf(os.Stderr, "%s⚠ config: %s%s\n", colorYellow, w, colorReset)
+	}
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"plain error", errors.New("boom"), exitFailure},
+		{"tagged", withExitCode(exitIncomplete, errors.New("incomplete")), exitIncomplete},
+		{"tagged then wrapped", fmt.Errorf("generate: %w", withExitCode(exitViolations, errors.New("bad"))), exitViolations},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exitCode(tt.err); got != tt.want {
+				t.Errorf("exitCode() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("missing config", func(t *testing.T) {
+		_, err := loadConfig(filepath.Join(t.TempDir(), "config.yaml"))
+		if got := exitCode(err); got != exitConfig {
+			t.Errorf("exitCode() = %d, want %d (err: %v)", got, exitConfig, err)
+		}
+	})
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derekprior/rbrl/internal/schedule"
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+// explainAlternatives is how many legal alternative slots explain lists.
+const explainAlternatives = 5
+
+func runExplain(configPath, matchup string) error {
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	a, b, ok := parseMatchup(matchup)
+	if !ok {
+		return fmt.Errorf("matchup must look like \"Angels vs Cubs\", got %q", matchup)
+	}
+	known := make(map[string]bool)
+	for _, team := range cfg.AllTeams() {
+		known[team] = true
+	}
+	for _, team := range []string{a, b} {
+		if !known[team] {
+			return fmt.Errorf("%q is not in any division", team)
+		}
+	}
+
+	strat, err := strategy.FromConfig(cfg)
+	if err != nil {
+		return err
+	}
+	games := strat.GenerateMatchups(cfg.Divisions)
+	slots := schedule.GenerateSlots(cfg)
+	overflowSlots := schedule.GenerateOverflowSlots(cfg)
+
+	result, schedErr := schedule.Schedule(cfg, slots, overflowSlots, games)
+	if schedErr != nil {
+		fmt.Printf("%s⚠ Schedule is incomplete; explaining the best attempt%s\n\n", colorYellow, colorReset)
+	}
+
+	explanations := schedule.Explain(cfg, slots, overflowSlots, games, result, a, b)
+	if len(explanations) == 0 {
+		return fmt.Errorf("%s and %s do not play each other under strategy %q", a, b, cfg.StrategyName())
+	}
+
+	for i, e := range explanations {
+		if i > 0 {
+			fmt.Println()
+		}
+		title := fmt.Sprintf("%s @ %s", e.Game.Away, e.Game.Home)
+		if e.Game.Label != "" {
+			title += " (" + e.Game.Label + ")"
+		}
+		fmt.Printf("%s%s%s\n", colorBold, title, colorReset)
+
+		if e.Assigned != nil {
+			fmt.Printf("  Scheduled: %s  score %.2f\n", formatSlot(*e.Assigned), e.Score)
+		} else {
+			fmt.Printf("  %sNot scheduled%s\n", colorYellow, colorReset)
+		}
+
+		fmt.Printf("  Next best slots:\n")
+		shown := 0
+		for _, c := range e.Candidates {
+			if c.Rejection != "" || shown == explainAlternatives {
+				break
+			}
+			fmt.Printf("    %s  score %.2f\n", formatSlot(c.Slot), c.Score)
+			shown++
+		}
+		if shown == 0 {
+			fmt.Printf("    %s(none — every other slot is ruled out)%s\n", colorDim, colorReset)
+		}
+
+		counts := make(map[string]int)
+		examples := make(map[string]schedule.Slot)
+		var reasons []string
+		for _, c := range e.Candidates {
+			if c.Rejection == "" {
+				continue
+			}
+			if counts[c.Rejection] == 0 {
+				reasons = append(reasons, c.Rejection)
+				examples[c.Rejection] = c.Slot
+			}
+			counts[c.Rejection]++
+		}
+		if len(reasons) > 0 {
+			fmt.Printf("  Ruled out:\n")
+			for _, r := range reasons {
+				fmt.Printf("    %-40s %4d (e.g., %s)\n", r, counts[r], formatSlot(examples[r]))
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseMatchup splits "A vs B" (or "A @ B") into its two team names.
+func parseMatchup(s string) (a, b string, ok bool) {
+	for _, sep := range []string{" vs ", " vs. ", " @ "} {
+		if parts := strings.SplitN(s, sep, 2); len(parts) == 2 {
+			a, b = strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+			return a, b, a != "" && b != ""
+		}
+	}
+	return "", "", false
+}
+
+func formatSlot(s schedule.Slot) string {
+	return fmt.Sprintf("%s %s %s", s.Date.Format("Mon 01/02"), s.Time, s.Field)
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/derekprior/rbrl/internal/excel"
+	"github.com/derekprior/rbrl/internal/export"
+	"github.com/derekprior/rbrl/internal/schedule"
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+func runExport(configPath, inputPath, outputPath, format string) error {
+	if format != "sqlite" {
+		return fmt.Errorf("--format must be sqlite, got %q", format)
+	}
+
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	assignments, err := excel.ReadAssignments(inputPath, cfg)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", inputPath, err)
+	}
+	strat, err := strategy.FromConfig(cfg)
+	if err != nil {
+		return err
+	}
+	labelGames(assignments, strat.GenerateMatchups(cfg.Divisions))
+
+	blackouts := schedule.GenerateBlackoutSlots(cfg)
+	if err := export.WriteSQLite(outputPath, cfg, assignments, blackouts); err != nil {
+		return err
+	}
+	fmt.Printf("%s✓ Exported %d games and %d blackout slots to %s%s\n",
+		colorGreen, len(assignments), len(blackouts), outputPath, colorReset)
+	return nil
+}
+
+// labelGames restores game labels, which the workbook doesn't store, by
+// matching each assignment to an unused generated game with the same home
+// and away teams. Notes missing from the workbook, as in a CSV export, come
+// from the matched game too.
+func labelGames(assignments []schedule.Assignment, games []strategy.Game) {
+	used := make([]bool, len(games))
+	for i, a := range assignments {
+		for j, g := range games {
+			if !used[j] && g.Home == a.Game.Home && g.Away == a.Game.Away {
+				assignments[i].Game.Label = g.Label
+				if a.Game.Note == "" {
+					assignments[i].Game.Note = g.Note
+				}
+				used[j] = true
+				break
+			}
+		}
+	}
+}
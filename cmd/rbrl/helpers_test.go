@@ -0,0 +1,18 @@
+package main
+
+import (
+	"time"
+
+	"github.com/derekprior/rbrl/internal/schedule"
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+// testGame builds an assignment for away @ home on date (YYYY-MM-DD) at
+// hhmm on field.
+func testGame(date, hhmm, field, away, home, note string) schedule.Assignment {
+	d, _ := time.Parse("2006-01-02", date)
+	return schedule.Assignment{
+		Game: strategy.Game{Home: home, Away: away, Note: note},
+		Slot: schedule.Slot{Date: d, Time: hhmm, Field: field},
+	}
+}
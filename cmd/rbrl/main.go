@@ -1,13 +1,20 @@
 package main
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"os"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/derekprior/rbrl/internal/config"
 	"github.com/derekprior/rbrl/internal/excel"
+	"github.com/derekprior/rbrl/internal/export"
 	"github.com/derekprior/rbrl/internal/schedule"
 	"github.com/derekprior/rbrl/internal/strategy"
 	"github.com/derekprior/rbrl/internal/validator"
@@ -32,7 +39,7 @@ func resolveConfigPath(configFlag string) (string, error) {
 	if _, err := os.Stat(defaultConfigFile); err == nil {
 		return defaultConfigFile, nil
 	}
-	return "", fmt.Errorf("no config file found. Either create %s in the current directory or pass --config", defaultConfigFile)
+	return "", withExitCode(exitConfig, fmt.Errorf("no config file found. Either create %s in the current directory or pass --config", defaultConfigFile))
 }
 
 func main() {
@@ -53,6 +60,54 @@ func main() {
 	}
 	initCmd.Flags().StringVarP(&initOutputPath, "output", "o", defaultConfigFile, "Output path for the config file")
 
+	configCmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect and document the config file",
+	}
+
+	schemaCmd := &cobra.Command{
+		Use:          "schema",
+		Short:        "Print a JSON Schema for config.yaml (for editor autocomplete)",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSchema()
+		},
+	}
+
+	var reservationsConfig, reservationsFile string
+	reservationsCmd := &cobra.Command{
+		Use:          "reservations",
+		Short:        "List every blackout and field reservation as concrete dates and times",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			configPath, err := resolveConfigPath(reservationsConfig)
+			if err != nil {
+				return err
+			}
+			return runReservations(configPath, reservationsFile)
+		},
+	}
+	reservationsCmd.Flags().StringVar(&reservationsConfig, "config", "", "Path to config file (default: config.yaml in current directory)")
+	reservationsCmd.Flags().StringVar(&reservationsFile, "reservations", "", "Also list field reservations from this CSV or ICS file")
+	var auditConfig string
+	auditCmd := &cobra.Command{
+		Use:          "audit <prior.xlsx|master.csv>",
+		Short:        "Check that a schedule's teams, fields, and dates match the config",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			configPath, err := resolveConfigPath(auditConfig)
+			if err != nil {
+				return err
+			}
+			return runAudit(configPath, args[0])
+		},
+	}
+	auditCmd.Flags().StringVar(&auditConfig, "config", "", "Path to config file (default: config.yaml in current directory)")
+	configCmd.AddCommand(schemaCmd, reservationsCmd, auditCmd)
+
 	scheduleCmd := &cobra.Command{
 		Use:   "schedule",
 		Short: "Generate and validate schedules",
@@ -61,7 +116,11 @@ func main() {
 	var configFile string
 	scheduleCmd.PersistentFlags().StringVar(&configFile, "config", "", "Path to config file (default: config.yaml in current directory)")
 
-	var outputFile string
+	var outputFile, metricsFile, format, reservations, optimize, anonymizeKey string
+	var seeds, divisions []string
+	var priorSeason string
+	var repairIterations int
+	var verbose, quiet, anonymize, autoRelax, validate bool
 	generateCmd := &cobra.Command{
 		Use:          "generate",
 		Short:        "Generate a schedule from a config file",
@@ -72,13 +131,60 @@ func main() {
 			if err != nil {
 				return err
 			}
-			return runGenerate(configPath, outputFile)
+			if format != "xlsx" && format != "ods" {
+				return fmt.Errorf("--format must be xlsx or ods, got %q", format)
+			}
+			if !cmd.Flags().Changed("output") {
+				outputFile = "schedule." + format
+			}
+			if validate && format != "xlsx" {
+				return fmt.Errorf("--validate reads back the workbook, so it needs --format xlsx")
+			}
+			opts := generateOptions{
+				reservations: reservations,
+				priorSeason:  priorSeason,
+				seeds:        seeds,
+				divisions:    divisions,
+				optimize:     optimize,
+				autoRelax:    autoRelax,
+				verbose:      verbose,
+				quiet:        quiet,
+				validate:     validate,
+				anonymize:    anonymize || anonymizeKey != "",
+			}
+			if cmd.Flags().Changed("repair-iterations") {
+				if repairIterations < 0 {
+					return fmt.Errorf("--repair-iterations must be 0 or more, got %d", repairIterations)
+				}
+				opts.repairIterations = &repairIterations
+			}
+			out := artifacts{xlsx: outputFile, metrics: metricsFile, key: anonymizeKey}
+			if format == "ods" {
+				out = artifacts{ods: outputFile, metrics: metricsFile, key: anonymizeKey}
+			}
+			return runGenerate(configPath, out, opts)
 		},
 	}
 	generateCmd.Flags().StringVarP(&outputFile, "output", "o", "schedule.xlsx", "Output Excel file path")
+	generateCmd.Flags().StringVar(&format, "format", "xlsx", "Output format: xlsx, or ods (converted with LibreOffice)")
+	generateCmd.Flags().StringVar(&metricsFile, "metrics", "", "Also write per-team metrics and summary totals to this JSON file")
+	generateCmd.Flags().StringSliceVar(&seeds, "seeds", nil, "Playoff seeds, best first, for the bracket strategy (overrides playoffs.seeds)")
+	generateCmd.Flags().StringSliceVar(&divisions, "divisions", nil, "Schedule only these divisions, as a standalone league")
+	generateCmd.Flags().StringVar(&reservations, "reservations", "", "Merge field reservations from this CSV or ICS file into the config")
+	generateCmd.Flags().StringVar(&priorSeason, "prior-season", "", "Even out slot times and fields teams drew too often in this earlier season's workbook")
+	generateCmd.Flags().IntVar(&repairIterations, "repair-iterations", 0, "Rounds of local-search repair after scheduling, 0 to skip (overrides guidelines.repair_iterations)")
+	generateCmd.Flags().StringVar(&optimize, "optimize", "", "Make rematch-spacing the primary objective (overrides guidelines.optimize)")
+	generateCmd.Flags().BoolVar(&autoRelax, "auto-relax", false, "When the season doesn't fit, retry with successively relaxed guidelines and report what was relaxed")
+	generateCmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "Also print how many attempts succeeded, the soft score, and slot rejections by reason")
+	generateCmd.Flags().BoolVar(&validate, "validate", false, "Read the saved workbook back and validate it, flagging any game written differently than scheduled")
+	generateCmd.Flags().BoolVarP(&quiet, "quiet", "q", false, "Don't show the live attempt counter while scheduling")
+	generateCmd.Flags().BoolVar(&anonymize, "anonymize", false, "Replace team names with Team 1, Team 2, ... in every output, for a blind review")
+	generateCmd.Flags().StringVar(&anonymizeKey, "anonymize-key", "", "With --anonymize, write which team each label stands for to this CSV (implies --anonymize)")
 
+	var validateDivisions []string
+	var validateReservations string
 	validateCmd := &cobra.Command{
-		Use:          "validate <schedule.xlsx>",
+		Use:          "validate <schedule.xlsx|master.csv>",
 		Short:        "Validate a schedule against config rules",
 		Args:         cobra.ExactArgs(1),
 		SilenceUsage: true,
@@ -87,14 +193,160 @@ func main() {
 			if err != nil {
 				return err
 			}
-			return runValidate(configPath, args[0])
+			return runValidate(configPath, args[0], validateReservations, validateDivisions)
+		},
+	}
+	validateCmd.Flags().StringVar(&validateReservations, "reservations", "", "Merge field reservations from this CSV or ICS file, as generate --reservations did")
+	validateCmd.Flags().StringSliceVar(&validateDivisions, "divisions", nil, "Validate a schedule generated with --divisions against just those divisions")
+
+	var mergeOutput string
+	mergeCmd := &cobra.Command{
+		Use:          "merge <a.xlsx> <b.xlsx> [more.xlsx...]",
+		Short:        "Combine partial schedules into one workbook",
+		Args:         cobra.MinimumNArgs(2),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			configPath, err := resolveConfigPath(configFile)
+			if err != nil {
+				return err
+			}
+			return runMerge(configPath, args, mergeOutput)
+		},
+	}
+	mergeCmd.Flags().StringVarP(&mergeOutput, "output", "o", "merged.xlsx", "Output Excel file path")
+
+	explainCmd := &cobra.Command{
+		Use:          "explain <\"Team A vs Team B\">",
+		Short:        "Show why a matchup landed in its slot and what the alternatives were",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			configPath, err := resolveConfigPath(configFile)
+			if err != nil {
+				return err
+			}
+			return runExplain(configPath, args[0])
+		},
+	}
+
+	matchupsCmd := &cobra.Command{
+		Use:          "matchups",
+		Short:        "Print the matchups the strategy generates, without scheduling them",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			configPath, err := resolveConfigPath(configFile)
+			if err != nil {
+				return err
+			}
+			return runMatchups(configPath)
 		},
 	}
 
-	scheduleCmd.AddCommand(generateCmd, validateCmd)
-	rootCmd.AddCommand(initCmd, scheduleCmd)
+	var rebalanceOutput, rebalanceTarget string
+	rebalanceCmd := &cobra.Command{
+		Use:          "rebalance <schedule.xlsx>",
+		Short:        "Swap games between slots to even out one metric, then rewrite the workbook",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			configPath, err := resolveConfigPath(configFile)
+			if err != nil {
+				return err
+			}
+			if !cmd.Flags().Changed("output") {
+				rebalanceOutput = args[0]
+			}
+			return runRebalance(configPath, args[0], rebalanceOutput, rebalanceTarget)
+		},
+	}
+	rebalanceCmd.Flags().StringVarP(&rebalanceOutput, "output", "o", "", "Output Excel file path (default: overwrite the input)")
+	rebalanceCmd.Flags().StringVar(&rebalanceTarget, "target", "sunday", "Metric to even out: sunday")
+
+	var exportOutput, exportFormat string
+	exportCmd := &cobra.Command{
+		Use:          "export <schedule.xlsx>",
+		Short:        "Export a schedule for other tools, such as a SQLite database",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			configPath, err := resolveConfigPath(configFile)
+			if err != nil {
+				return err
+			}
+			return runExport(configPath, args[0], exportOutput, exportFormat)
+		},
+	}
+	exportCmd.Flags().StringVarP(&exportOutput, "output", "o", "season.db", "Output file path")
+	exportCmd.Flags().StringVar(&exportFormat, "format", "sqlite", "Export format: sqlite")
+
+	var weekDate, weekTeam, weekDivision string
+	weekCmd := &cobra.Command{
+		Use:          "week <schedule.xlsx>",
+		Short:        "Print one week's games as plain text, grouped by day, for an email",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			configPath, err := resolveConfigPath(configFile)
+			if err != nil {
+				return err
+			}
+			return runWeek(configPath, args[0], weekDate, weekTeam, weekDivision)
+		},
+	}
+	weekCmd.Flags().StringVar(&weekDate, "week", "", "Any date in the Monday-to-Sunday week to print (default: this week)")
+	weekCmd.Flags().StringVar(&weekTeam, "team", "", "Only list this team's games")
+	weekCmd.Flags().StringVar(&weekDivision, "division", "", "Only list games involving this division's teams")
+
+	var textTeam string
+	var textAll bool
+	textCmd := &cobra.Command{
+		Use:          "text <schedule.xlsx>",
+		Short:        "Print a team's games as one short line each, for pasting into a group chat",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			configPath, err := resolveConfigPath(configFile)
+			if err != nil {
+				return err
+			}
+			return runText(configPath, args[0], textTeam, textAll)
+		},
+	}
+	textCmd.Flags().StringVar(&textTeam, "team", "", "The team whose games to print")
+	textCmd.Flags().BoolVar(&textAll, "all", false, "Print every team's games, each under a heading")
+
+	var publishDir, publishReservations string
+	var publishFormats []string
+	publishCmd := &cobra.Command{
+		Use:          "publish",
+		Short:        "Generate a schedule once and write every format into a directory",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			configPath, err := resolveConfigPath(configFile)
+			if err != nil {
+				return err
+			}
+			out, err := publishArtifacts(publishDir, publishFormats)
+			if err != nil {
+				return err
+			}
+			if err := os.MkdirAll(publishDir, 0755); err != nil {
+				return fmt.Errorf("creating %s: %w", publishDir, err)
+			}
+			return runGenerate(configPath, out, generateOptions{reservations: publishReservations})
+		},
+	}
+	publishCmd.Flags().StringVar(&publishDir, "output-dir", "", "Directory to write the files into (created if missing)")
+	publishCmd.Flags().StringSliceVar(&publishFormats, "formats", []string{"xlsx", "metrics", "sqlite"}, "Formats to write: xlsx, ods, metrics, sqlite")
+	publishCmd.Flags().StringVar(&publishReservations, "reservations", "", "Merge field reservations from this CSV or ICS file into the config")
+	publishCmd.MarkFlagRequired("output-dir")
+
+	scheduleCmd.AddCommand(generateCmd, validateCmd, mergeCmd, explainCmd, rebalanceCmd, matchupsCmd, exportCmd, publishCmd, weekCmd, textCmd)
+	rootCmd.AddCommand(initCmd, configCmd, scheduleCmd)
 	if err := rootCmd.Execute(); err != nil {
-		os.Exit(1)
+		os.Exit(exitCode(err))
 	}
 }
 
@@ -111,6 +363,15 @@ func runInit(outputPath string) error {
 	return nil
 }
 
+func runSchema() error {
+	out, err := json.MarshalIndent(config.JSONSchema(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding schema: %w", err)
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
 const configTemplate = `# RBRL Season Configuration
 # ========================
 # This file defines the parameters for generating a baseball schedule.
@@ -124,6 +385,28 @@ season:
   # scheduled between end_date and overflow_end_date as a last resort.
   # The scheduler minimizes overflow usage, preferring fewer and earlier days.
   overflow_end_date: "2026-06-05"
+  # Optional: "spread" spreads overflow games across the overflow dates
+  # instead of packing them onto the earliest ones (default "earliest").
+  # overflow_strategy: spread
+
+  # Optional: prefer to finish the regular season by this date, leaving the
+  # days after it as rainout buffer. Later dates are still used when needed.
+  # target_end_date: "2026-05-24"
+
+  # Optional: IANA time zone the league plays in, used to localize game
+  # times for calendar apps. Defaults to the computer's local zone.
+  # time_zone: America/New_York
+
+  # Optional: keep some slots open as a built-in rain buffer. The last
+  # count slots (latest time first) of each Saturday, or of each week, are
+  # left off the schedule without being blacked out.
+  # reserve_open_slots:
+  #   count: 1
+  #   per: saturday                      # saturday or week
+
+  # Optional: no weekday games for the first N days of the season, for an
+  # easier opening week. Weekend games are still scheduled.
+  # weekday_start_offset: 7
 
   # Blackout dates are full days where no games will be scheduled on any field.
   # Common examples: holidays, town events, etc.
@@ -139,18 +422,80 @@ season:
 
 # Divisions and their teams. The number of divisions and teams per division
 # can vary. Team names must be unique across all divisions.
+#
+# preferred_fields: fields the division's games should use when one is free,
+# e.g. a smaller field for younger players. Unlike a team's excluded_fields
+# this is a preference: games go elsewhere when the season won't fit
+# otherwise. generate reports how many games landed on a preferred field.
+#
+# min_days_between_same_matchup: overrides the guideline of the same name
+# for the division's games, e.g. 21 for a competitive division and 7 for a
+# rec one. A game between divisions uses the stricter of the two.
 divisions:
   - name: American
     teams: [Angels, Astros, Athletics, Mariners, Royals]
   - name: National
     teams: [Cubs, Padres, Phillies, Pirates, Marlins]
+    # preferred_fields: [Symonds Field]
+
+# Optional per-team settings. Only teams that need settings are listed here;
+# names must match a team in divisions.
+#
+# preferred_off_dates: dates the team would rather not play (e.g., a vacation
+# weekend). The scheduler avoids them when it can, but unlike a blackout it
+# will still use them if the season won't fit otherwise.
+#
+# home_field: a field every home game for the team must be played on (e.g.,
+# a sponsor requirement). This is a hard constraint.
+#
+# excluded_fields: fields the team never plays on, home or away (e.g., no
+# accessible parking for one of its players). This is a hard constraint.
+#
+# max_saturday_games: most Saturday games the team plays (e.g., a team whose
+# players travel on weekends). This is a hard constraint; the team sits out
+# Saturdays once it reaches the cap, and its min_saturday_games is lowered to
+# match.
+#
+# max_distinct_fields: most different fields the team plays at over the
+# season (e.g., a team whose families don't want to learn every park). The
+# scheduler prefers fields the team has already played at once it reaches
+# the cap; set rules.enforce_max_distinct_fields to make it a hard rule.
+#
+# home_weight: tilt inter-division home games toward the team (e.g., a
+# rebuilding team). Defaults to 1 and must be positive; a team at 2 aims for
+# twice the home share of its opponent in each inter-division game. Total
+# games don't change.
+#
+# coach / email: contact details listed on the workbook's Contacts sheet
+# (with each team's game count) for mail-merging schedules to coaches. The
+# sheet is added when any team sets either.
+#
+# rating: the team's power rating, higher is stronger. With the
+# max_strong_opponent_streak guideline, teams rated above the league average
+# are spread out so no team faces too many of them in a row.
+#
+# teams:
+#   - name: Angels
+#     preferred_off_dates: ["2026-05-02", "2026-05-03"]
+#     home_field: Symonds Field
+#     excluded_fields: [Washington Park]
+#     max_saturday_games: 4
+#     max_distinct_fields: 3
+#     home_weight: 2
+#     rating: 8
+#     coach: Pat Doyle
+#     email: angels.coach@example.com
 
 # Fields available for scheduling. Each field can have reservations that block
 # it for specific dates or date ranges.
 #
 # Reservations block a field for a given date or date range.
 # If 'times' is omitted or empty, the field is blocked for the full day.
-# If 'times' is provided, only those specific time slots are blocked.
+# If 'times' is provided, only those specific time slots are blocked. Each
+# time must be one the day offers (a Saturday reservation at a weekday time
+# blocks nothing); rbrl warns when one isn't.
+# 'until' blocks every slot starting before a time, and 'from' every slot
+# starting at or after one, matching agreements like "available after 4".
 #
 # Single date reservation (full day):
 #   - date: "2026-05-04"
@@ -161,6 +506,11 @@ divisions:
 #     times: ["17:45"]
 #     reason: "Freshman"
 #
+# Single date, only slots starting at 4pm or later are usable:
+#   - date: "2026-05-09"
+#     until: "16:00"
+#     reason: "Softball"
+#
 # Date range reservation (blocks every day in the range):
 #   - start_date: "2026-04-25"
 #     end_date: "2026-05-31"
@@ -184,6 +534,16 @@ fields:
       - date: "2026-05-22"
         reason: "Freshman"
   - name: Washington Park
+    # Optional: minutes the field needs between one game ending and the next
+    # starting (e.g. to drag the infield). Games last time_slots.game_minutes.
+    # min_minutes_between_games: 30
+    # Optional: this field's own slot times, replacing time_slots' list for
+    # each day listed; [] means no games that day. Days left out keep the
+    # league's times.
+    # time_slots:
+    #   weekday: []
+    #   saturday: ["09:00", "11:00"]
+    #   sunday: []
     reservations:
       - date: "2026-04-29"
         reason: "JV"
@@ -203,14 +563,70 @@ time_slots:
 
   # Holiday dates are treated as Sundays for scheduling purposes.
   # Use this for holidays that fall on weekdays but have Sunday-style availability.
+  # To follow a different day's times, use the long form with 'as'
+  # (saturday, sunday, or weekday):
+  #   - date: "2026-05-25"
+  #     as: saturday
   holiday_dates:
     - "2026-05-25"
 
+  # Optional: league-wide curfew by day of the week. Slots starting later
+  # than the cutoff are dropped on that day (by calendar day, holidays
+  # included), whatever the lists above say.
+  # latest_start:
+  #   sunday: "17:00"
+  #   monday: "17:45"
+
+  # Optional: names for slot times, by day of the week, as posted at the
+  # fields. The master sheet lists them in a Slot column beside the times.
+  # labels:
+  #   saturday: {"12:30": Game 1, "14:45": Game 2, "17:00": Game 3}
+
+  # How long a game runs. validate flags games on the same field that
+  # overlap, and fields with min_minutes_between_games add their buffer.
+  # game_minutes: 120
+
 # Strategy determines how matchups are generated.
 # "division_weighted" plays each intra-division opponent twice and each
 # inter-division opponent once, with balanced home/away assignments.
+# "bracket" lays out a single-elimination playoff from the seeds below;
+# later rounds are scheduled after the games that feed them.
+# "banded" ignores divisions and gives every team between min and max games
+# (see banded below), meeting every opponent before any rematch.
+# "matrix" plays exactly the games per pair listed in matchup_matrix below.
 strategy: division_weighted
 
+# To layer special games onto the base slate, list strategies instead of
+# strategy; their matchups are combined in order. "fixed" plays exactly the
+# games listed. A block's games between teams an earlier block already pairs
+# are dropped unless it sets allow_repeats.
+# strategies:
+#   - strategy: division_weighted
+#   - strategy: fixed
+#     allow_repeats: true                # Cross-town games on top of the regular ones
+#     games:
+#       - {home: Cubs, away: Angels}
+#       - {home: Padres, away: Royals, weekend_only: true}   # Never on a weekday
+#       - {home: Cubs, away: Royals, note: Sponsor Night}    # Shown with the game in the workbook
+
+# Playoff settings for the bracket strategy. Seeds are listed best first and
+# default to division order; 'rbrl schedule generate --seeds' overrides them.
+# playoffs:
+#   seeds: [Angels, Cubs, Padres, Royals]
+#   rest_days: 1                         # Full days off between rounds
+
+# Game counts for the banded strategy. max defaults to min; a band lets an
+# odd number of teams pair up (one team plays an extra game).
+# banded:
+#   min_games_per_team: 10
+#   max_games_per_team: 12
+
+# Games per pair for the matrix strategy. List a pair under either team;
+# listed under both, the counts must match. Every team needs a game.
+# matchup_matrix:
+#   Cubs: {Padres: 3, Angels: 1, Royals: 1}
+#   Angels: {Royals: 2, Padres: 1}
+
 # Rules are hard constraints. A schedule that violates these is invalid.
 rules:
   max_games_per_day_per_team: 1    # No team plays more than once per day
@@ -218,6 +634,18 @@ rules:
   max_games_per_week: 3            # Max games per team per calendar week
   max_games_per_timeslot: 2        # Max simultaneous games (limited by umpire crews)
   max_3_in_4_days: true            # No team plays 3 games in any 4-day window
+  # max_home_games_per_week: 2       # Optional: cap home games per team per calendar week
+  # max_games_per_date: 4            # Optional: cap games per date across all fields
+  # matchup_blackouts:                # Optional: two teams can't meet on a date (either home)
+  #   - home: Cubs
+  #     away: Angels
+  #     date: "2026-05-12"
+  #     reason: Shared coach             # away: any keeps the Cubs from playing anyone that day
+  # require_slot_headroom: 1.1       # Optional: refuse to schedule without 10% more slots than games
+  # same_physical_location:          # Optional: fields that are one diamond; one game per timeslot among them
+  #   - [Symonds Field, Washington Park]
+  # enforce_max_distinct_fields: true # Optional: make teams' max_distinct_fields a hard rule
+  # enforce_max_opening_away_streak: true # Optional: make max_opening_away_streak a hard rule
 
 # Guidelines are soft constraints. The scheduler tries to honor them but
 # violations are reported as warnings, not errors. This allows manual edits
@@ -226,15 +654,143 @@ guidelines:
   min_days_between_same_matchup: 10      # Minimum days before two teams play again
   balance_sunday_games: true             # Spread Sunday games evenly across teams
   balance_pace: true                     # Keep games-played roughly equal across teams
+  # balance_season_end: true              # Keep teams' last game dates close together
+  # avoid_both_weekend_days: true         # Saturday or Sunday of a weekend, not both
+  # balance_start_times: true             # Give each team a mix of start times, not all 12:30
+  # balance_division_timeslots: true      # Mix divisions within each timeslot
+  # opponent_variety: true                # Meet every opponent before any rematch
+  # same_field_week_penalty: 10           # Vary a team's fields within a week
+  # min_saturday_games: 4                 # Saturday floor per team (default: every Saturday)
+  # sunday_balance_tolerance: 1           # Sunday-game spread allowed before warning
+  # pace_balance_tolerance: 2             # Games-played spread allowed at any week's end
+  # max_strong_opponent_streak: 2         # Above-average-rated opponents in a row (needs team ratings)
+  # max_opening_away_streak: 2            # Away games a team may open its season with
+
+  # Opponent groups treat several teams as one opponent for spacing purposes:
+  # a team's games against any members of a group are spread at least
+  # min_days_between_group_games apart (e.g., travel-affiliated clubs).
+  # opponent_groups:
+  #   - name: Travel
+  #     teams: [Cubs, Padres]
+  # min_days_between_group_games: 7
+
+  # Teams that share families (siblings on different teams). The scheduler
+  # tries to put linked teams' games on the same days, ideally back to back
+  # on the same field, and generate reports how often it managed to.
+  # family_links:
+  #   - [Angels, Cubs]
+
+  # When several fields are open at the same time, prefer them in this order.
+  # Fields not listed come last. Omit to treat all fields equally.
+  # field_priority: [Symonds Field, Washington Park]
+
+  # Weekdays to favor for weekday games, most preferred first. Days not
+  # listed come last. Omit to treat all weekdays equally.
+  # preferred_weekday_order: [friday, thursday, wednesday, tuesday, monday]
+
+  # Avoid long dry spells when teams play different numbers of games: spread
+  # each team's games so it has at most this many bye weeks in a row.
+  # max_consecutive_bye_weeks: 1
+
+  # Schedule each division's intra-division games on their own first, then
+  # layer inter-division games on top. generate reports each pass.
+  # intra_division_first: true
+
+  # After scheduling, try swapping games between slots (or into open slots)
+  # for up to this many rounds, keeping each swap that improves the
+  # guidelines. More rounds take longer. generate --repair-iterations
+  # overrides this.
+  # repair_iterations: 20
+
+  # Make one guideline the primary objective instead of weighing them all.
+  # rematch-spacing spreads out each pair's meetings as far as the season
+  # allows before considering pace, Sunday balance, and the rest. generate
+  # --optimize rematch-spacing sets this for one run.
+  # optimize: rematch-spacing
+
+  # Score guidelines on the regular season only; overflow games then count
+  # only toward the overflow penalty.
+  # exclude_overflow_from_scoring: true
+
+# Output controls optional extras in the generated workbook.
+output:
+  grid: false                            # Add a team-by-date "Grid" sheet showing each opponent
+  rounds: false                          # Add a Round column to the master sheet
+  combine_blackout_reasons: false        # List every reason when reservations overlap, not just the first
+  day_format: short                      # Day column as "Mon" (short) or "Monday" (long)
+  # locale: en                            # Day names in en, es, fr, de, or pt
+
+# Style overrides the workbook's look. Omit any setting to keep the default.
+# style:
+#   font_family: Arial
+#   font_size: 16
+#   header_fill: "#4472C4"                # Hex color for header rows
+#   highlight_blackouts: true             # Light red fill on blackout and reservation cells
+#   highlight_empty: false                # Light green fill on open slots
 `
 
-func runGenerate(configPath, outputPath string) error {
-	cfg, err := config.LoadFromFile(configPath)
+// generateOptions carries generate's flags into runGenerate. The zero value
+// is a plain run with the config as written, as publish does.
+type generateOptions struct {
+	reservations     string   // field reservations CSV or ICS to merge
+	priorSeason      string   // earlier season's workbook to even out against
+	seeds            []string // overrides playoffs.seeds
+	divisions        []string // schedule only these divisions
+	repairIterations *int     // overrides guidelines.repair_iterations
+	optimize         string   // overrides guidelines.optimize
+	autoRelax        bool
+	verbose          bool
+	quiet            bool
+	validate         bool
+	anonymize        bool
+}
+
+func runGenerate(configPath string, out artifacts, opts generateOptions) error {
+	if out.ods != "" {
+		if err := excel.RequireLibreOffice(); err != nil {
+			return err
+		}
+	}
+	cfg, err := loadConfig(configPath)
 	if err != nil {
-		return fmt.Errorf("loading config: %w", err)
+		return err
+	}
+	if cfg, err = importReservations(cfg, opts.reservations); err != nil {
+		return err
+	}
+	if len(opts.divisions) > 0 {
+		if cfg, err = cfg.OnlyDivisions(opts.divisions); err != nil {
+			return withExitCode(exitConfig, fmt.Errorf("--divisions: %w", err))
+		}
+		fmt.Printf("Scheduling divisions %s (%d teams)\n", strings.Join(opts.divisions, ", "), len(cfg.AllTeams()))
+	}
+	if cfg, err = importPriorSeason(cfg, opts.priorSeason); err != nil {
+		return err
+	}
+	if opts.repairIterations != nil {
+		cfg.Guidelines.RepairIterations = *opts.repairIterations
+	}
+	if opts.optimize != "" {
+		if opts.optimize != config.OptimizeRematchSpacing {
+			return fmt.Errorf("--optimize must be %s, got %q", config.OptimizeRematchSpacing, opts.optimize)
+		}
+		cfg.Guidelines.Optimize = opts.optimize
 	}
 
-	strat, err := strategy.Get(cfg.Strategy)
+	if len(opts.seeds) > 0 {
+		known := make(map[string]bool)
+		for _, team := range cfg.AllTeams() {
+			known[team] = true
+		}
+		for _, team := range opts.seeds {
+			if !known[team] {
+				return fmt.Errorf("--seeds: %q is not in any division", team)
+			}
+		}
+		cfg.Playoffs.Seeds = opts.seeds
+	}
+
+	strat, err := strategy.FromConfig(cfg)
 	if err != nil {
 		return err
 	}
@@ -244,6 +800,7 @@ func runGenerate(configPath, outputPath string) error {
 	overflowSlots := schedule.GenerateOverflowSlots(cfg)
 	blackouts := schedule.GenerateBlackoutSlots(cfg)
 
+	fmt.Printf("Strategy will generate %d games; %s\n", len(games), gameCounts(cfg.Divisions, games))
 	totalSlots := len(slots) + len(overflowSlots)
 	if len(overflowSlots) > 0 {
 		fmt.Printf("Scheduling %d games into %d available slots (%d regular + %d overflow)...\n",
@@ -251,8 +808,43 @@ func runGenerate(configPath, outputPath string) error {
 	} else {
 		fmt.Printf("Scheduling %d games into %d available slots...\n", len(games), len(slots))
 	}
+	if cfg.Season.WeekdayStartOffset > 0 {
+		fmt.Printf("Ramp-up: no weekday games before %s (%d weekday slots held back)\n",
+			cfg.Season.WeekdaysStart().Format("Mon 01/02"), len(schedule.RampUpSlots(cfg)))
+	}
+
+	if err := schedule.CheckHeadroom(cfg, slots, overflowSlots, games); err != nil {
+		return withExitCode(exitConfig, err)
+	}
 
-	result, schedErr := schedule.Schedule(cfg, slots, overflowSlots, games)
+	progress := progressLine(os.Stderr, opts.quiet)
+	var result *schedule.Result
+	var schedErr error
+	if opts.autoRelax {
+		result, schedErr = schedule.ScheduleRelaxed(cfg, slots, overflowSlots, games, progress)
+	} else {
+		result, schedErr = schedule.ScheduleWithProgress(cfg, slots, overflowSlots, games, progress)
+	}
+	if progress != nil {
+		fmt.Fprint(os.Stderr, "\r\033[K")
+	}
+
+	if opts.anonymize {
+		teams := cfg.AllTeams()
+		names := shuffledLabels(teams)
+		if out.key != "" {
+			if err := writeAnonymizeKey(out.key, teams, names); err != nil {
+				return err
+			}
+			fmt.Printf("%s✓ Anonymize key saved to %s%s\n", colorGreen, out.key, colorReset)
+		} else {
+			fmt.Printf("Teams anonymized; the key is not saved\n")
+		}
+		cfg, result = cfg.Relabel(names), result.Relabel(names)
+		if schedErr != nil {
+			schedErr = errors.New(schedule.TeamReplacer(names).Replace(schedErr.Error()))
+		}
+	}
 
 	if schedErr != nil {
 		fmt.Fprintf(os.Stderr, "%s⚠ %s%s\n", colorYellow, schedErr, colorReset)
@@ -261,11 +853,83 @@ func runGenerate(configPath, outputPath string) error {
 		fmt.Printf("%s✓ All %d games scheduled%s\n", colorGreen, len(result.Assignments), colorReset)
 	}
 
+	if len(result.Relaxed) > 0 {
+		fmt.Printf("\n%sRelaxed to fit (%d):%s\n", colorBold, len(result.Relaxed), colorReset)
+		for _, change := range result.Relaxed {
+			fmt.Printf("  %s⚠ %s%s\n", colorYellow, change, colorReset)
+		}
+	} else if opts.autoRelax && schedErr != nil {
+		fmt.Fprintf(os.Stderr, "No relaxation of the guidelines fit the season either\n")
+	}
+
+	if len(result.Phases) > 0 {
+		fmt.Printf("\n%sPhases:%s\n", colorBold, colorReset)
+		for _, p := range result.Phases {
+			fmt.Printf("  %-15s %d of %d games\n", p.Name, p.Scheduled, p.Games)
+		}
+	}
+
+	if opts.verbose {
+		printDiagnostics(result.Diagnostics)
+	}
+
+	if r := result.Repair; r != nil {
+		fmt.Printf("\n%sRepair:%s %d improving swaps in %d rounds; soft score %.1f → %.1f\n",
+			colorBold, colorReset, r.Swaps, r.Iterations, r.Before, r.After)
+	}
+
+	if len(result.HeldOpen) > 0 {
+		fmt.Printf("\n%sHeld open (%d):%s\n", colorBold, len(result.HeldOpen), colorReset)
+		for _, slot := range result.HeldOpen {
+			fmt.Printf("  %s\n", formatSlot(slot))
+		}
+	}
+
+	if len(result.FamilyLinks) > 0 {
+		fmt.Printf("\n%sFamily links:%s\n", colorBold, colorReset)
+		for _, link := range result.FamilyLinks {
+			fmt.Printf("  %-30s together on %d of %d game days (%d at the same field)\n",
+				strings.Join(link.Teams, ", "), link.Together, link.Days, link.SameField)
+		}
+	}
+
+	if len(result.Preferred) > 0 {
+		fmt.Printf("\n%sPreferred fields:%s\n", colorBold, colorReset)
+		for _, p := range result.Preferred {
+			fmt.Printf("  %-30s %d of %d games on a preferred field\n", p.Division, p.Preferred, p.Games)
+		}
+	}
+
 	fmt.Printf("\n%sPer Team Metrics:%s\n", colorBold, colorReset)
-	fmt.Printf("  %s%-15s %6s %4s %4s%s\n", colorDim, "Team", "Games", "Sat", "Sun", colorReset)
+	fmt.Printf("  %s%-15s %6s %5s %5s %4s %4s %10s %10s%s\n", colorDim, "Team", "Games", "Home", "Away", "Sat", "Sun", "Homestand", "Road trip", colorReset)
 	for _, team := range cfg.AllTeams() {
 		m := result.TeamMetrics[team]
-		fmt.Printf("  %-15s %6d %4d %4d\n", team, m.Games, m.Saturday, m.Sunday)
+		fmt.Printf("  %-15s %6d %5d %5d %4d %4d %10d %10d\n", team, m.Games, m.Home, m.Away, m.Saturday, m.Sunday, m.LongestHomestand, m.LongestRoadTrip)
+	}
+	if !result.LastGameDate.IsZero() {
+		fmt.Printf("\n  Last game: %s\n", result.LastGameDate.Format("Mon 01/02"))
+	}
+	if e := result.SeasonEnd; e != nil && e.Days() > 0 {
+		fmt.Printf("  Season ends: %s first (%s), %s last (%s); spread %dd\n",
+			e.FirstTeam, e.First.Format("Mon 01/02"), e.LastTeam, e.Last.Format("Mon 01/02"), e.Days())
+	}
+	if len(result.Overflow) > 0 {
+		fmt.Printf("  Overflow games: %s\n", overflowCounts(result.Overflow))
+	}
+	var capped []string
+	for _, team := range cfg.AllTeams() {
+		if result.TeamMetrics[team].SaturdayCapReached {
+			capped = append(capped, team)
+		}
+	}
+	if len(capped) > 0 {
+		fmt.Printf("  Saturday caps reached: %s\n", strings.Join(capped, ", "))
+	}
+	if len(cfg.Guidelines.PreferredWeekdayOrder) > 0 {
+		fmt.Printf("  Weekday games: %s\n", weekdayCounts(result.Assignments))
+	}
+	if r := result.Rematch; r != nil {
+		fmt.Printf("  Closest rematch: %s and %s, %d days apart\n", r.Teams[0], r.Teams[1], r.Days)
 	}
 
 	if len(result.Warnings) > 0 {
@@ -283,21 +947,144 @@ func runGenerate(configPath, outputPath string) error {
 		return fmt.Errorf("generating Excel: %w", err)
 	}
 
-	if err := f.SaveAs(outputPath); err != nil {
-		return fmt.Errorf("saving file: %w", err)
+	fmt.Println()
+	if out.xlsx != "" {
+		if err := f.SaveAs(out.xlsx); err != nil {
+			return fmt.Errorf("saving file: %w", err)
+		}
+		fmt.Printf("%s✓ Schedule saved to %s%s\n", colorGreen, out.xlsx, colorReset)
+	}
+	if out.ods != "" {
+		if err := excel.SaveODS(f, out.ods); err != nil {
+			return err
+		}
+		fmt.Printf("%s✓ Schedule saved to %s%s\n", colorGreen, out.ods, colorReset)
+	}
+	if out.metrics != "" {
+		if err := writeMetrics(out.metrics, cfg, result, len(games)); err != nil {
+			return err
+		}
+		fmt.Printf("%s✓ Metrics saved to %s%s\n", colorGreen, out.metrics, colorReset)
+	}
+	if out.sqlite != "" {
+		if err := export.WriteSQLite(out.sqlite, cfg, result.Assignments, blackouts); err != nil {
+			return err
+		}
+		fmt.Printf("%s✓ Database saved to %s%s\n", colorGreen, out.sqlite, colorReset)
+	}
+	problems := 0
+	if opts.validate {
+		if problems, err = checkWritten(cfg, out.xlsx, result.Assignments); err != nil {
+			return err
+		}
 	}
-
-	fmt.Printf("\n%s✓ Schedule saved to %s%s\n", colorGreen, outputPath, colorReset)
 	if schedErr != nil {
-		return fmt.Errorf("schedule is incomplete: %d of %d games scheduled", len(result.Assignments), len(games))
+		return withExitCode(exitIncomplete, fmt.Errorf("schedule is incomplete: %d of %d games scheduled", len(result.Assignments), len(games)))
+	}
+	if problems > 0 {
+		return withExitCode(exitViolations, fmt.Errorf("the saved schedule failed validation: %d problems found", problems))
 	}
 	return nil
 }
 
-func runValidate(configPath, schedulePath string) error {
-	cfg, err := config.LoadFromFile(configPath)
+// printDiagnostics prints the search details behind a schedule for --verbose.
+func printDiagnostics(d schedule.Diagnostics) {
+	fmt.Printf("\n%sDiagnostics:%s\n", colorBold, colorReset)
+	fmt.Printf("  %d of %d attempts placed every game; soft score %.1f\n", d.Succeeded, d.Attempts, d.SoftScore)
+	if len(d.Rejections) > 0 {
+		fmt.Printf("  Slot rejections in that attempt:\n")
+	}
+	for _, line := range rejectionLines(d.Rejections) {
+		fmt.Printf("  %s\n", line)
+	}
+}
+
+// rejectionLines formats rejection counts, most frequent first.
+func rejectionLines(rejections map[string]int) []string {
+	reasons := make([]string, 0, len(rejections))
+	for reason := range rejections {
+		reasons = append(reasons, reason)
+	}
+	sort.Slice(reasons, func(i, j int) bool {
+		if rejections[reasons[i]] != rejections[reasons[j]] {
+			return rejections[reasons[i]] > rejections[reasons[j]]
+		}
+		return reasons[i] < reasons[j]
+	})
+	lines := make([]string, len(reasons))
+	for i, reason := range reasons {
+		lines[i] = fmt.Sprintf("%7d × %s", rejections[reason], reason)
+	}
+	return lines
+}
+
+// weekdayCounts summarizes how many games fall on each day Monday through
+// Friday, e.g. "Mon 4, Tue 6, Wed 8, Thu 9, Fri 10".
+func weekdayCounts(assignments []schedule.Assignment) string {
+	counts := make(map[time.Weekday]int)
+	for _, a := range assignments {
+		counts[a.Slot.Date.Weekday()]++
+	}
+	var parts []string
+	for day := time.Monday; day <= time.Friday; day++ {
+		parts = append(parts, fmt.Sprintf("%s %d", day.String()[:3], counts[day]))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// gameCounts summarizes how many games each team plays: "each team plays 13"
+// when the league is even, per division when each division is even (a
+// sign of divisions of different sizes), or else the range.
+func gameCounts(divisions []config.Division, games []strategy.Game) string {
+	counts := make(map[string]int)
+	for _, g := range games {
+		counts[g.Home]++
+		counts[g.Away]++
+	}
+	var perDivision []string
+	lo, hi := math.MaxInt, 0
+	divisionsEven := true
+	for _, div := range divisions {
+		dlo, dhi := math.MaxInt, 0
+		for _, team := range div.Teams {
+			dlo, dhi = min(dlo, counts[team]), max(dhi, counts[team])
+		}
+		lo, hi = min(lo, dlo), max(hi, dhi)
+		divisionsEven = divisionsEven && dlo == dhi
+		perDivision = append(perDivision, fmt.Sprintf("each %s team plays %d", div.Name, dhi))
+	}
+	switch {
+	case lo == hi:
+		return fmt.Sprintf("each team plays %d", lo)
+	case divisionsEven:
+		return strings.Join(perDivision, ", ")
+	default:
+		return fmt.Sprintf("teams play %d to %d", lo, hi)
+	}
+}
+
+// overflowCounts summarizes games per overflow date, e.g. "Mon 06/01 2,
+// Wed 06/03 1".
+func overflowCounts(days []schedule.OverflowDay) string {
+	var parts []string
+	for _, day := range days {
+		parts = append(parts, fmt.Sprintf("%s %d", day.Date.Format("Mon 01/02"), day.Games))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func runValidate(configPath, schedulePath, reservationsPath string, divisions []string) error {
+	cfg, err := loadConfig(configPath)
 	if err != nil {
-		return fmt.Errorf("loading config: %w", err)
+		return err
+	}
+	if cfg, err = importReservations(cfg, reservationsPath); err != nil {
+		return err
+	}
+	if len(divisions) > 0 {
+		if cfg, err = cfg.OnlyDivisions(divisions); err != nil {
+			return withExitCode(exitConfig, fmt.Errorf("--divisions: %w", err))
+		}
 	}
 
 	violations, err := validator.Validate(cfg, schedulePath)
@@ -305,16 +1092,41 @@ func runValidate(configPath, schedulePath string) error {
 		return fmt.Errorf("validating: %w", err)
 	}
 
+	errors := reportViolations(violations)
+
+	// Regenerate team sheets from master schedule; a CSV export has none
+	if excel.IsCSV(schedulePath) {
+		fmt.Printf("Team sheets not updated: %s is a CSV export of the master sheet\n", schedulePath)
+	} else {
+		if err := excel.UpdateTeamSheets(schedulePath, cfg); err != nil {
+			return fmt.Errorf("updating team sheets: %w", err)
+		}
+		fmt.Printf("%s✓ Team sheets updated in %s%s\n", colorGreen, schedulePath, colorReset)
+	}
+
+	if errors > 0 {
+		return withExitCode(exitViolations, fmt.Errorf("%d constraint violations found", errors))
+	}
+	return nil
+}
+
+// reportViolations prints each violation and a summary line, returning the
+// number of rule (error) violations.
+func reportViolations(violations []validator.Violation) int {
 	errors := 0
 	warnings := 0
 	for _, v := range violations {
+		msg := v.Message
+		if v.Row > 0 {
+			msg = fmt.Sprintf("row %d: %s", v.Row, msg)
+		}
 		switch v.Type {
 		case "error":
 			errors++
-			fmt.Printf("%s✗ Rule violation: %s%s\n", colorRed, v.Message, colorReset)
+			fmt.Printf("%s✗ Rule violation: %s%s\n", colorRed, msg, colorReset)
 		case "warning":
 			warnings++
-			fmt.Printf("%s⚠ Guideline violation: %s%s\n", colorYellow, v.Message, colorReset)
+			fmt.Printf("%s⚠ Guideline violation: %s%s\n", colorYellow, msg, colorReset)
 		}
 	}
 
@@ -329,15 +1141,5 @@ func runValidate(configPath, schedulePath string) error {
 	} else {
 		fmt.Printf(", %s%d guideline violations%s\n", colorGreen, warnings, colorReset)
 	}
-
-	// Regenerate team sheets from master schedule
-	if err := excel.UpdateTeamSheets(schedulePath, cfg); err != nil {
-		return fmt.Errorf("updating team sheets: %w", err)
-	}
-	fmt.Printf("%s✓ Team sheets updated in %s%s\n", colorGreen, schedulePath, colorReset)
-
-	if errors > 0 {
-		return fmt.Errorf("%d constraint violations found", errors)
-	}
-	return nil
+	return errors
 }
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/schedule"
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+func TestConfigTemplate(t *testing.T) {
+	cfg, err := config.LoadFromBytes([]byte(configTemplate))
+	if err != nil {
+		t.Fatalf("template does not load: %v", err)
+	}
+
+	t.Run("no warnings", func(t *testing.T) {
+		if w := cfg.Warnings(); len(w) != 0 {
+			t.Errorf("Warnings() = %q, want none", w)
+		}
+	})
+
+	t.Run("max_3_in_4_days rule is active", func(t *testing.T) {
+		if !cfg.Rules.Max3In4Days {
+			t.Error("Rules.Max3In4Days = false, want true from template")
+		}
+	})
+}
+
+func TestParseMatchup(t *testing.T) {
+	tests := []struct {
+		in   string
+		a, b string
+		ok   bool
+	}{
+		{"Angels vs Cubs", "Angels", "Cubs", true},
+		{"Cubs @ Angels", "Cubs", "Angels", true},
+		{"Red Sox vs. Blue Jays", "Red Sox", "Blue Jays", true},
+		{"Angels", "", "", false},
+		{"Angels vs ", "", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			a, b, ok := parseMatchup(tt.in)
+			if ok != tt.ok || (ok && (a != tt.a || b != tt.b)) {
+				t.Errorf("parseMatchup(%q) = %q, %q, %v; want %q, %q, %v", tt.in, a, b, ok, tt.a, tt.b, tt.ok)
+			}
+		})
+	}
+}
+
+func TestRejectionLines(t *testing.T) {
+	got := rejectionLines(map[string]int{"3 games in 4 days": 12, "slot already taken": 340, "too many consecutive days": 12})
+	want := []string{
+		"    340 × slot already taken",
+		"     12 × 3 games in 4 days",
+		"     12 × too many consecutive days",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("rejectionLines = %q, want %q", got, want)
+	}
+}
+
+func TestWeekdayCounts(t *testing.T) {
+	day := func(s string) schedule.Assignment {
+		d, err := time.Parse("2006-01-02", s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return schedule.Assignment{Slot: schedule.Slot{Date: d}}
+	}
+	got := weekdayCounts([]schedule.Assignment{day("2026-05-04"), day("2026-05-08"), day("2026-05-15"), day("2026-05-09")})
+	if want := "Mon 1, Tue 0, Wed 0, Thu 0, Fri 2"; got != want {
+		t.Errorf("weekdayCounts = %q, want %q", got, want)
+	}
+}
+
+func TestGameCounts(t *testing.T) {
+	american := config.Division{Name: "American", Teams: []string{"Angels", "Astros", "Athletics"}}
+	national := config.Division{Name: "National", Teams: []string{"Cubs", "Padres"}}
+	both := []config.Division{american, national}
+	tests := []struct {
+		name      string
+		divisions []config.Division
+		games     []strategy.Game
+		want      string
+	}{
+		{"even league", both[:1], (&strategy.DivisionWeighted{}).GenerateMatchups(both[:1]), "each team plays 4"},
+		{"even divisions", both, (&strategy.DivisionWeighted{}).GenerateMatchups(both), "each American team plays 6, each National team plays 5"},
+		{"uneven", both, []strategy.Game{{Home: "Angels", Away: "Cubs"}, {Home: "Angels", Away: "Padres"}}, "teams play 0 to 2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gameCounts(tt.divisions, tt.games); got != tt.want {
+				t.Errorf("gameCounts = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunValidateCSV(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.yaml")
+	cfg := `
+season:
+  start_date: "2026-05-02"
+  end_date: "2026-05-09"
+divisions:
+  - name: A
+    teams: [Angels, Cubs]
+fields:
+  - name: Symonds Field
+time_slots:
+  weekday: ["17:45"]
+  saturday: ["12:30"]
+rules:
+  max_games_per_day_per_team: 1
+  max_games_per_week: 3
+  max_games_per_timeslot: 2
+`
+	if err := os.WriteFile(configPath, []byte(cfg), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	// Times as a spreadsheet might export them
+	masterPath := filepath.Join(dir, "master.csv")
+	master := "Date,Day,Time,Symonds\n" +
+		"5/2/2026,Sat,12:30:00,Cubs @ Angels\n" +
+		"2026-05-09,Sat,12:30 PM,Angels @ Cubs\n"
+	if err := os.WriteFile(masterPath, []byte(master), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := runValidate(configPath, masterPath, "", nil); err != nil {
+		t.Errorf("runValidate() error: %v", err)
+	}
+	if got, _ := os.ReadFile(masterPath); string(got) != master {
+		t.Errorf("master.csv was rewritten:\n%s", got)
+	}
+}
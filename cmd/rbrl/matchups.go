@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+func runMatchups(configPath string) error {
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	strat, err := strategy.FromConfig(cfg)
+	if err != nil {
+		return err
+	}
+	games := strat.GenerateMatchups(cfg.Divisions)
+	printMatchups(os.Stdout, cfg, games)
+	return nil
+}
+
+// pairing is every game between two teams, in the order they were generated.
+type pairing struct {
+	a, b  string
+	games []strategy.Game
+}
+
+// printMatchups lists games grouped by pairing, each with its home/away
+// split, followed by per-team totals. Teams appear in division order;
+// pairings appear in the order their first game was generated.
+func printMatchups(w io.Writer, cfg *config.Config, games []strategy.Game) {
+	var pairings []*pairing
+	byKey := make(map[[2]string]*pairing)
+	home := make(map[string]int)
+	away := make(map[string]int)
+	for _, g := range games {
+		key := [2]string{g.Home, g.Away}
+		if g.Away < g.Home {
+			key = [2]string{g.Away, g.Home}
+		}
+		p := byKey[key]
+		if p == nil {
+			p = &pairing{a: g.Home, b: g.Away}
+			byKey[key] = p
+			pairings = append(pairings, p)
+		}
+		p.games = append(p.games, g)
+		home[g.Home]++
+		away[g.Away]++
+	}
+
+	fmt.Fprintf(w, "%sMatchups (%d games, strategy %s):%s\n", colorBold, len(games), cfg.StrategyName(), colorReset)
+	for _, p := range pairings {
+		aHome := 0
+		for _, g := range p.games {
+			if g.Home == p.a {
+				aHome++
+			}
+		}
+		fmt.Fprintf(w, "  %s vs %s: %d games (%s home %d, %s home %d)\n",
+			p.a, p.b, len(p.games), p.a, aHome, p.b, len(p.games)-aHome)
+		for _, g := range p.games {
+			line := fmt.Sprintf("%s @ %s", g.Away, g.Home)
+			if g.Label != "" {
+				line += " (" + g.Label + ")"
+			}
+			fmt.Fprintf(w, "    %s\n", line)
+		}
+	}
+
+	fmt.Fprintf(w, "\n%sPer team:%s\n", colorBold, colorReset)
+	for _, team := range cfg.AllTeams() {
+		total := home[team] + away[team]
+		fmt.Fprintf(w, "  %-12s %2d games (%d home, %d away)\n", team, total, home[team], away[team])
+	}
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+func TestPrintMatchups(t *testing.T) {
+	cfg := &config.Config{
+		Strategy: "division_weighted",
+		Divisions: []config.Division{
+			{Name: "American", Teams: []string{"Angels", "Astros"}},
+			{Name: "National", Teams: []string{"Cubs"}},
+		},
+	}
+	games := (&strategy.DivisionWeighted{}).GenerateMatchups(cfg.Divisions)
+
+	var buf bytes.Buffer
+	printMatchups(&buf, cfg, games)
+	out := buf.String()
+
+	for _, want := range []string{
+		"Matchups (4 games, strategy division_weighted)",
+		"Angels vs Astros: 2 games (Angels home 1, Astros home 1)",
+		"Astros @ Angels",
+		"Angels @ Astros",
+		"Angels        3 games",
+		"Cubs          2 games",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	if n := strings.Count(out, " vs "); n != 3 {
+		t.Errorf("pairings = %d, want 3:\n%s", n, out)
+	}
+}
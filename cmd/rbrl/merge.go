@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/derekprior/rbrl/internal/excel"
+	"github.com/derekprior/rbrl/internal/schedule"
+	"github.com/derekprior/rbrl/internal/validator"
+)
+
+func runMerge(configPath string, inputPaths []string, outputPath string) error {
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	var sets [][]schedule.Assignment
+	for _, path := range inputPaths {
+		assignments, err := excel.ReadAssignments(path, cfg)
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", path, err)
+		}
+		fmt.Printf("Read %d games from %s\n", len(assignments), path)
+		sets = append(sets, assignments)
+	}
+
+	merged, conflicts := schedule.Merge(sets...)
+	fmt.Printf("Merged %d games\n", len(merged))
+
+	if len(conflicts) > 0 {
+		fmt.Printf("\n%sSlot conflicts (%d):%s\n", colorBold, len(conflicts), colorReset)
+		for _, c := range conflicts {
+			fmt.Printf("  %s✗ %s %s %s: kept %s @ %s, dropped %s @ %s%s\n", colorRed,
+				c.Slot.Date.Format("01/02"), c.Slot.Time, c.Slot.Field,
+				c.Kept.Away, c.Kept.Home, c.Rejected.Away, c.Rejected.Home, colorReset)
+		}
+	}
+
+	slots := append(schedule.GenerateSlots(cfg), schedule.GenerateOverflowSlots(cfg)...)
+	blackouts := schedule.GenerateBlackoutSlots(cfg)
+	f, err := excel.Generate(cfg, &schedule.Result{Assignments: merged}, slots, blackouts)
+	if err != nil {
+		return fmt.Errorf("generating Excel: %w", err)
+	}
+	if err := f.SaveAs(outputPath); err != nil {
+		return fmt.Errorf("saving file: %w", err)
+	}
+	fmt.Printf("%s✓ Merged schedule saved to %s%s\n\n", colorGreen, outputPath, colorReset)
+
+	violations, err := validator.Validate(cfg, outputPath)
+	if err != nil {
+		return fmt.Errorf("validating: %w", err)
+	}
+	errors := reportViolations(violations)
+
+	if len(conflicts) > 0 {
+		return withExitCode(exitViolations, fmt.Errorf("%d slot conflicts found", len(conflicts)))
+	}
+	if errors > 0 {
+		return withExitCode(exitViolations, fmt.Errorf("%d constraint violations found", errors))
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/schedule"
+)
+
+// metricsFile is the JSON document written by generate --metrics.
+type metricsFile struct {
+	Summary  metricsSummary `json:"summary"`
+	Teams    []teamMetrics  `json:"teams"`
+	Warnings []warning      `json:"warnings"`
+}
+
+type metricsSummary struct {
+	Games        int    `json:"games"`
+	Scheduled    int    `json:"scheduled"`
+	Unscheduled  int    `json:"unscheduled"`
+	Warnings     int    `json:"warnings"`
+	LastGameDate string `json:"last_game_date,omitempty"`
+	// SeasonEndSpreadDays is how many days separate the first and last
+	// teams' final games.
+	SeasonEndSpreadDays int `json:"season_end_spread_days"`
+	// MinRematchDays is the fewest days between two meetings of a pair,
+	// omitted when no pair meets twice.
+	MinRematchDays int `json:"min_rematch_days,omitempty"`
+	// OverflowDates counts games per overflow date (YYYY-MM-DD), omitted
+	// when no game spills past end_date.
+	OverflowDates map[string]int `json:"overflow_dates,omitempty"`
+	// PreferredFields has one entry per division with preferred_fields.
+	PreferredFields []preferredFields `json:"preferred_fields,omitempty"`
+	// Relaxed lists the guideline changes --auto-relax needed to fit.
+	Relaxed []string `json:"relaxed,omitempty"`
+}
+
+type preferredFields struct {
+	Division  string `json:"division"`
+	Games     int    `json:"games"`
+	Preferred int    `json:"preferred"` // games on one of the division's preferred fields
+}
+
+type warning struct {
+	Category string   `json:"category"`
+	Teams    []string `json:"teams"`
+	Message  string   `json:"message"`
+}
+
+type teamMetrics struct {
+	Team              string         `json:"team"`
+	Division          string         `json:"division"`
+	Games             int            `json:"games"`
+	Home              int            `json:"home"`
+	Away              int            `json:"away"`
+	Saturday          int            `json:"saturday"`
+	Sunday            int            `json:"sunday"`
+	Times             map[string]int `json:"times"`    // games per start time
+	Weekdays          map[string]int `json:"weekdays"` // games per day of the week
+	OpponentVariety   float64        `json:"opponent_variety"`
+	ToughestStretch   []string       `json:"toughest_stretch"`         // longest run of above-average opponents
+	LongestHomestand  int            `json:"longest_homestand"`        // most home games in a row
+	LongestRoadTrip   int            `json:"longest_road_trip"`        // most away games in a row
+	FullWeekends      int            `json:"full_weekends"`            // weekends played both Saturday and Sunday
+	SaturdayCap       bool           `json:"saturday_cap_reached"`     // plays its max_saturday_games
+	Fields            int            `json:"fields"`                   // distinct fields played at
+	OpeningAwayStreak int            `json:"opening_away_streak"`      // away games opening the season
+	StartTimeSpread   int            `json:"start_time_spread"`        // games at the most frequent start time minus the least, on days with a choice
+	RegularSeason     *balance       `json:"regular_season,omitempty"` // without overflow games; only when some were played
+	Violations        []string       `json:"violations"`
+}
+
+type balance struct {
+	Games    int `json:"games"`
+	Home     int `json:"home"`
+	Away     int `json:"away"`
+	Saturday int `json:"saturday"`
+	Sunday   int `json:"sunday"`
+}
+
+// writeMetrics writes the result's per-team metrics and season totals as
+// JSON. totalGames is the number of games the strategy generated.
+func writeMetrics(path string, cfg *config.Config, result *schedule.Result, totalGames int) error {
+	doc := metricsFile{
+		Summary: metricsSummary{
+			Games:       totalGames,
+			Scheduled:   len(result.Assignments),
+			Unscheduled: totalGames - len(result.Assignments),
+			Warnings:    len(result.Warnings),
+		},
+		Teams:    []teamMetrics{},
+		Warnings: []warning{},
+	}
+	if !result.LastGameDate.IsZero() {
+		doc.Summary.LastGameDate = result.LastGameDate.Format("2006-01-02")
+	}
+	if result.SeasonEnd != nil {
+		doc.Summary.SeasonEndSpreadDays = result.SeasonEnd.Days()
+	}
+	if result.Rematch != nil {
+		doc.Summary.MinRematchDays = result.Rematch.Days
+	}
+	for _, day := range result.Overflow {
+		if doc.Summary.OverflowDates == nil {
+			doc.Summary.OverflowDates = make(map[string]int)
+		}
+		doc.Summary.OverflowDates[day.Date.Format("2006-01-02")] = day.Games
+	}
+	doc.Summary.Relaxed = result.Relaxed
+	for _, p := range result.Preferred {
+		doc.Summary.PreferredFields = append(doc.Summary.PreferredFields,
+			preferredFields{Division: p.Division, Games: p.Games, Preferred: p.Preferred})
+	}
+	for _, w := range result.Warnings {
+		teams := w.Teams
+		if teams == nil {
+			teams = []string{}
+		}
+		doc.Warnings = append(doc.Warnings, warning{Category: w.Category, Teams: teams, Message: w.Message})
+	}
+
+	for _, div := range cfg.Divisions {
+		for _, team := range div.Teams {
+			tm := teamMetrics{Team: team, Division: div.Name, Times: map[string]int{}, Weekdays: map[string]int{}, ToughestStretch: []string{}, Violations: []string{}}
+			if m := result.TeamMetrics[team]; m != nil {
+				tm.Games, tm.Home, tm.Away = m.Games, m.Home, m.Away
+				tm.Saturday, tm.Sunday = m.Saturday, m.Sunday
+				tm.OpponentVariety = m.OpponentVariety
+				tm.LongestHomestand, tm.LongestRoadTrip = m.LongestHomestand, m.LongestRoadTrip
+				tm.OpeningAwayStreak = m.OpeningAwayStreak
+				tm.FullWeekends, tm.Fields = m.FullWeekends, m.Fields
+				tm.SaturdayCap = m.SaturdayCapReached
+				tm.StartTimeSpread = m.StartTimeSpread
+				if b := m.RegularSeason; b != nil {
+					tm.RegularSeason = &balance{Games: b.Games, Home: b.Home, Away: b.Away, Saturday: b.Saturday, Sunday: b.Sunday}
+				}
+				if m.Times != nil {
+					tm.Times = m.Times
+				}
+				if m.Weekdays != nil {
+					tm.Weekdays = m.Weekdays
+				}
+				if m.ToughestStretch != nil {
+					tm.ToughestStretch = m.ToughestStretch
+				}
+				if m.Violations != nil {
+					tm.Violations = m.Violations
+				}
+			}
+			doc.Teams = append(doc.Teams, tm)
+		}
+	}
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding metrics: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("writing metrics: %w", err)
+	}
+	return nil
+}
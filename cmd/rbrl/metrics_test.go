@@ -0,0 +1,96 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/schedule"
+)
+
+func TestWriteMetrics(t *testing.T) {
+	cfg := &config.Config{
+		Divisions: []config.Division{
+			{Name: "American", Teams: []string{"Angels"}},
+			{Name: "National", Teams: []string{"Cubs"}},
+		},
+	}
+	result := &schedule.Result{
+		Assignments: make([]schedule.Assignment, 1),
+		Warnings: []schedule.Warning{
+			{Category: schedule.WarningOffDate, Teams: []string{"Angels"}, Message: "Angels plays on requested off date 05/02"},
+			{Category: schedule.WarningOverflow, Message: "Overflow: 1 game(s) on 1 day(s) past end of regular season (through 06/01)"},
+		},
+		TeamMetrics: map[string]*schedule.TeamMetrics{
+			"Angels": {Games: 1, Home: 1, Saturday: 1, Times: map[string]int{"12:30": 1}, OpponentVariety: 1, LongestHomestand: 1, RegularSeason: &schedule.Balance{Games: 1, Home: 1, Saturday: 1}, Violations: []string{"Angels plays on requested off date 05/02"}},
+			"Cubs":   {Games: 1, Away: 1, Saturday: 1},
+		},
+		LastGameDate: time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC),
+		Overflow:     []schedule.OverflowDay{{Date: time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC), Games: 1}},
+	}
+
+	path := filepath.Join(t.TempDir(), "metrics.json")
+	if err := writeMetrics(path, cfg, result, 2); err != nil {
+		t.Fatalf("writeMetrics() error: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got metricsFile
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+
+	t.Run("summary", func(t *testing.T) {
+		want := metricsSummary{Games: 2, Scheduled: 1, Unscheduled: 1, Warnings: 2, LastGameDate: "2026-05-02",
+			OverflowDates: map[string]int{"2026-06-01": 1}}
+		if !reflect.DeepEqual(got.Summary, want) {
+			t.Errorf("summary = %+v, want %+v", got.Summary, want)
+		}
+	})
+
+	t.Run("warnings", func(t *testing.T) {
+		if len(got.Warnings) != 2 {
+			t.Fatalf("warnings = %d, want 2", len(got.Warnings))
+		}
+		if w := got.Warnings[0]; w.Category != "off-date" || len(w.Teams) != 1 || w.Teams[0] != "Angels" {
+			t.Errorf("first warning = %+v, want an off-date warning for the Angels", w)
+		}
+		if w := got.Warnings[1]; w.Category != "overflow" || w.Teams == nil {
+			t.Errorf("second warning = %+v, want an overflow warning with empty teams", w)
+		}
+	})
+
+	t.Run("teams in division order", func(t *testing.T) {
+		if len(got.Teams) != 2 {
+			t.Fatalf("teams = %d, want 2", len(got.Teams))
+		}
+		angels, cubs := got.Teams[0], got.Teams[1]
+		if angels.Team != "Angels" || angels.Division != "American" || angels.Home != 1 || len(angels.Violations) != 1 {
+			t.Errorf("Angels = %+v", angels)
+		}
+		if angels.OpponentVariety != 1 {
+			t.Errorf("Angels opponent variety = %g, want 1", angels.OpponentVariety)
+		}
+		if angels.LongestHomestand != 1 || angels.LongestRoadTrip != 0 {
+			t.Errorf("Angels streaks = %d home, %d away; want 1, 0", angels.LongestHomestand, angels.LongestRoadTrip)
+		}
+		if want := (balance{Games: 1, Home: 1, Saturday: 1}); angels.RegularSeason == nil || *angels.RegularSeason != want {
+			t.Errorf("Angels regular season = %+v, want %+v", angels.RegularSeason, want)
+		}
+		if cubs.RegularSeason != nil {
+			t.Errorf("Cubs regular season = %+v, want omitted", cubs.RegularSeason)
+		}
+		if angels.Times["12:30"] != 1 {
+			t.Errorf("Angels times = %v, want one 12:30 game", angels.Times)
+		}
+		if cubs.Team != "Cubs" || cubs.Away != 1 || cubs.Violations == nil || cubs.Times == nil {
+			t.Errorf("Cubs = %+v, want one away game and empty times and violations", cubs)
+		}
+	})
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/excel"
+	"github.com/derekprior/rbrl/internal/schedule"
+)
+
+// importPriorSeason reads the games in the workbook at path, an earlier
+// season sharing this one's fields, into a copy of cfg so the scheduler can
+// even out the slot times and fields teams drew then. An empty path leaves
+// cfg as is.
+func importPriorSeason(cfg *config.Config, path string) (*config.Config, error) {
+	if path == "" {
+		return cfg, nil
+	}
+	assignments, err := excel.ReadAssignments(path, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("--prior-season: reading %s: %w", path, err)
+	}
+	out := *cfg
+	out.PriorSeason = priorGames(cfg, assignments)
+	if len(out.PriorSeason) == 0 {
+		return nil, fmt.Errorf("--prior-season: %s has no games for this season's teams", path)
+	}
+	fmt.Printf("Prior season: %d games from %s; evening out slot times and fields teams drew too often\n",
+		len(out.PriorSeason), path)
+	return &out, nil
+}
+
+// priorGames converts a prior season's games for cfg, keeping those with at
+// least one of this season's teams.
+func priorGames(cfg *config.Config, assignments []schedule.Assignment) []config.PriorGame {
+	inLeague := make(map[string]bool)
+	for _, team := range cfg.AllTeams() {
+		inLeague[team] = true
+	}
+	var games []config.PriorGame
+	for _, a := range assignments {
+		if !inLeague[a.Game.Home] && !inLeague[a.Game.Away] {
+			continue
+		}
+		games = append(games, config.PriorGame{
+			Home:  a.Game.Home,
+			Away:  a.Game.Away,
+			Date:  a.Slot.Date,
+			Time:  a.Slot.Time,
+			Field: a.Slot.Field,
+		})
+	}
+	return games
+}
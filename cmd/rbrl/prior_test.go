@@ -0,0 +1,23 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/schedule"
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+func TestPriorGames(t *testing.T) {
+	cfg := &config.Config{Divisions: []config.Division{{Name: "A", Teams: []string{"Angels", "Astros"}}}}
+	slot := schedule.Slot{Date: time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC), Time: "12:30", Field: "Symonds Field"}
+	games := priorGames(cfg, []schedule.Assignment{
+		{Game: strategy.Game{Home: "Angels", Away: "Cubs"}, Slot: slot},
+		{Game: strategy.Game{Home: "Cubs", Away: "Padres"}, Slot: slot},
+	})
+	want := config.PriorGame{Home: "Angels", Away: "Cubs", Date: slot.Date, Time: "12:30", Field: "Symonds Field"}
+	if len(games) != 1 || games[0] != want {
+		t.Errorf("priorGames = %+v, want just %+v", games, want)
+	}
+}
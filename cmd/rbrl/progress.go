@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"os"
+
+	"github.com/derekprior/rbrl/internal/schedule"
+)
+
+// progressLine returns a callback that keeps one line on w updated with the
+// scheduler's attempt counter, or nil when quiet or w isn't a terminal, so
+// logs and pipes see nothing. The caller clears the line when the run ends.
+func progressLine(w *os.File, quiet bool) schedule.ProgressFunc {
+	if quiet {
+		return nil
+	}
+	if info, err := w.Stat(); err != nil || info.Mode()&os.ModeCharDevice == 0 {
+		return nil
+	}
+	return func(p schedule.Progress) {
+		writeProgress(w, p)
+	}
+}
+
+// writeProgress overwrites the current line with p, e.g.
+// "  attempt 12/50 · best 118 of 120 games".
+func writeProgress(w io.Writer, p schedule.Progress) {
+	fmt.Fprintf(w, "\r\033[K  attempt %d/%d · best %d of %d games", p.Attempt, p.Attempts, p.Scheduled, p.Games)
+	if !math.IsNaN(p.SoftScore) {
+		fmt.Fprintf(w, " · soft score %.1f", p.SoftScore)
+	}
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/derekprior/rbrl/internal/schedule"
+)
+
+func TestWriteProgress(t *testing.T) {
+	tests := []struct {
+		name string
+		p    schedule.Progress
+		want string
+	}{
+		{
+			"no attempt has placed every game",
+			schedule.Progress{Attempt: 3, Attempts: 50, Games: 120, Scheduled: 118, SoftScore: math.NaN()},
+			"\r\033[K  attempt 3/50 · best 118 of 120 games",
+		},
+		{
+			"with a soft score",
+			schedule.Progress{Attempt: 12, Attempts: 50, Games: 120, Scheduled: 120, SoftScore: 42},
+			"\r\033[K  attempt 12/50 · best 120 of 120 games · soft score 42.0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b strings.Builder
+			writeProgress(&b, tt.p)
+			if b.String() != tt.want {
+				t.Errorf("writeProgress() = %q, want %q", b.String(), tt.want)
+			}
+		})
+	}
+}
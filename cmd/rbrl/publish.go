@@ -0,0 +1,37 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// artifacts names the files one generate run writes. Empty paths are
+// skipped.
+type artifacts struct {
+	xlsx, ods, metrics, sqlite string
+	key                        string // label-to-team CSV for --anonymize
+}
+
+// publishArtifacts maps publish --formats to files in dir, each with a
+// fixed name so published directories look the same season to season.
+func publishArtifacts(dir string, formats []string) (artifacts, error) {
+	var out artifacts
+	if len(formats) == 0 {
+		return out, fmt.Errorf("--formats must name at least one format")
+	}
+	for _, format := range formats {
+		switch format {
+		case "xlsx":
+			out.xlsx = filepath.Join(dir, "schedule.xlsx")
+		case "ods":
+			out.ods = filepath.Join(dir, "schedule.ods")
+		case "metrics":
+			out.metrics = filepath.Join(dir, "metrics.json")
+		case "sqlite":
+			out.sqlite = filepath.Join(dir, "schedule.db")
+		default:
+			return out, fmt.Errorf("--formats: unknown format %q (want xlsx, ods, metrics, or sqlite)", format)
+		}
+	}
+	return out, nil
+}
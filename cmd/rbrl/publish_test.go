@@ -0,0 +1,51 @@
+package main
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPublishArtifacts(t *testing.T) {
+	dir := filepath.Join("out", "2026")
+	tests := []struct {
+		name    string
+		formats []string
+		want    artifacts
+		wantErr string
+	}{
+		{
+			name:    "default formats",
+			formats: []string{"xlsx", "metrics", "sqlite"},
+			want: artifacts{
+				xlsx:    filepath.Join(dir, "schedule.xlsx"),
+				metrics: filepath.Join(dir, "metrics.json"),
+				sqlite:  filepath.Join(dir, "schedule.db"),
+			},
+		},
+		{
+			name:    "ods only",
+			formats: []string{"ods"},
+			want:    artifacts{ods: filepath.Join(dir, "schedule.ods")},
+		},
+		{name: "unknown format", formats: []string{"xlsx", "pdf"}, wantErr: `unknown format "pdf"`},
+		{name: "no formats", formats: nil, wantErr: "at least one format"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := publishArtifacts(dir, tt.formats)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("artifacts = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
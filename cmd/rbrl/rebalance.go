@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/derekprior/rbrl/internal/excel"
+	"github.com/derekprior/rbrl/internal/schedule"
+	"github.com/derekprior/rbrl/internal/validator"
+)
+
+func runRebalance(configPath, inputPath, outputPath, target string) error {
+	if target != "sunday" {
+		return fmt.Errorf("--target must be sunday, got %q", target)
+	}
+
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	assignments, err := excel.ReadAssignments(inputPath, cfg)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", inputPath, err)
+	}
+	fmt.Printf("Read %d games from %s\n", len(assignments), inputPath)
+
+	slots := append(schedule.GenerateSlots(cfg), schedule.GenerateOverflowSlots(cfg)...)
+	rebalanced, swaps := schedule.RebalanceSundays(cfg, slots, assignments)
+	if len(swaps) == 0 {
+		fmt.Printf("%s✓ No swaps needed or possible; schedule unchanged%s\n\n", colorGreen, colorReset)
+		return nil
+	}
+
+	fmt.Printf("\n%sSwaps (%d):%s\n", colorBold, len(swaps), colorReset)
+	for _, sw := range swaps {
+		fmt.Printf("  %s @ %s (%s) ⇄ %s @ %s (%s)\n",
+			sw.Sunday.Game.Away, sw.Sunday.Game.Home, formatSlot(sw.Sunday.Slot),
+			sw.Other.Game.Away, sw.Other.Game.Home, formatSlot(sw.Other.Slot))
+	}
+	fmt.Println()
+
+	blackouts := schedule.GenerateBlackoutSlots(cfg)
+	f, err := excel.Generate(cfg, &schedule.Result{Assignments: rebalanced}, slots, blackouts)
+	if err != nil {
+		return fmt.Errorf("generating Excel: %w", err)
+	}
+	if err := f.SaveAs(outputPath); err != nil {
+		return fmt.Errorf("saving file: %w", err)
+	}
+	fmt.Printf("%s✓ Rebalanced schedule saved to %s%s\n\n", colorGreen, outputPath, colorReset)
+
+	violations, err := validator.Validate(cfg, outputPath)
+	if err != nil {
+		return fmt.Errorf("validating: %w", err)
+	}
+	if errors := reportViolations(violations); errors > 0 {
+		return withExitCode(exitViolations, fmt.Errorf("%d constraint violations found", errors))
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/schedule"
+)
+
+func runReservations(configPath, reservationsPath string) error {
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+	if cfg, err = importReservations(cfg, reservationsPath); err != nil {
+		return err
+	}
+	printReservations(os.Stdout, cfg)
+	return nil
+}
+
+// importReservations merges the reservations in path into cfg's fields.
+// An empty path leaves cfg as is.
+func importReservations(cfg *config.Config, path string) (*config.Config, error) {
+	if path == "" {
+		return cfg, nil
+	}
+	out, err := cfg.ImportReservations(path)
+	if err != nil {
+		return nil, withExitCode(exitConfig, fmt.Errorf("--reservations: %w", err))
+	}
+	// loadConfig already warned about the config's own reservations.
+	known := cfg.Warnings()
+	var warnings []string
+	for _, w := range out.Warnings() {
+		if !slices.Contains(known, w) {
+			warnings = append(warnings, "--reservations: "+w)
+		}
+	}
+	printConfigWarnings(warnings)
+	return out, nil
+}
+
+// printReservations lists every blackout date and field reservation as
+// concrete (date, field, times, reason) rows, sorted by date and field, so
+// the dates the scheduler treats as unavailable can be checked at a glance.
+// Reservation dates outside the season are listed separately since the
+// scheduler ignores them.
+func printReservations(w io.Writer, cfg *config.Config) {
+	type rowKey struct {
+		date   time.Time
+		field  string
+		reason string
+	}
+	var rows []rowKey
+	times := make(map[rowKey][]string)
+	days := make(map[time.Time]bool)
+	for _, b := range schedule.GenerateBlackoutSlots(cfg) {
+		k := rowKey{b.Date, b.Field, b.Reason}
+		if _, ok := times[k]; !ok {
+			rows = append(rows, k)
+		}
+		times[k] = append(times[k], b.Time)
+		days[b.Date] = true
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		if !rows[i].date.Equal(rows[j].date) {
+			return rows[i].date.Before(rows[j].date)
+		}
+		if rows[i].field != rows[j].field {
+			return rows[i].field < rows[j].field
+		}
+		return rows[i].reason < rows[j].reason
+	})
+
+	fmt.Fprintf(w, "%sUnavailable slots (%d days):%s\n", colorBold, len(days), colorReset)
+	if len(rows) == 0 {
+		fmt.Fprintln(w, "  none")
+	}
+	for _, k := range rows {
+		fmt.Fprintf(w, "  %s  %-22s %-22s %s\n", k.date.Format("Mon 01/02"), k.field, strings.Join(times[k], ", "), k.reason)
+	}
+
+	end := cfg.Season.EndDate.Time
+	if cfg.Season.OverflowEndDate != nil {
+		end = cfg.Season.OverflowEndDate.Time
+	}
+	var ignored []string
+	for _, f := range cfg.Fields {
+		for _, r := range f.Reservations {
+			for _, d := range r.Dates() {
+				if d.Before(cfg.Season.StartDate.Time) || d.After(end) {
+					ignored = append(ignored, fmt.Sprintf("%s  %-22s %s", d.Format("Mon 01/02/2006"), f.Name, r.Reason))
+				}
+			}
+		}
+	}
+	if len(ignored) > 0 {
+		fmt.Fprintf(w, "\n%sOutside the season, ignored (%d):%s\n", colorYellow, len(ignored), colorReset)
+		for _, line := range ignored {
+			fmt.Fprintf(w, "  %s\n", line)
+		}
+	}
+}
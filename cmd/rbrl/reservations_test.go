@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/derekprior/rbrl/internal/config"
+)
+
+func TestPrintReservations(t *testing.T) {
+	day := func(s string) *config.Date {
+		d, err := time.Parse("2006-01-02", s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &config.Date{Time: d}
+	}
+	cfg := &config.Config{
+		Season: config.Season{
+			StartDate:     *day("2026-04-25"),
+			EndDate:       *day("2026-05-31"),
+			BlackoutDates: []config.BlackoutDate{{Date: *day("2026-05-10"), Reason: "Mother's Day"}},
+		},
+		Fields: []config.Field{
+			{Name: "Symonds Field", Reservations: []config.Reservation{
+				{StartDate: day("2026-05-18"), EndDate: day("2026-05-19"), Reason: "Tournament"},
+				{Date: day("2026-06-20"), Reason: "Summer camp"},
+			}},
+			{Name: "Washington Park"},
+		},
+		TimeSlots: config.TimeSlots{Weekday: []string{"17:45"}, Sunday: []string{"12:30", "17:00"}},
+	}
+
+	var buf bytes.Buffer
+	printReservations(&buf, cfg)
+	out := buf.String()
+
+	for _, want := range []string{
+		"Unavailable slots (3 days)",
+		"Sun 05/10  Symonds Field          12:30, 17:00",
+		"Sun 05/10  Washington Park        12:30, 17:00",
+		"Mon 05/18  Symonds Field          17:45                  Tournament",
+		"Tue 05/19  Symonds Field          17:45                  Tournament",
+		"Outside the season, ignored (1)",
+		"Sat 06/20/2026  Symonds Field          Summer camp",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Index(out, "05/19") < strings.Index(out, "05/18") {
+		t.Errorf("rows are not in date order:\n%s", out)
+	}
+}
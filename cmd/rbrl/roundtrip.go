@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/excel"
+	"github.com/derekprior/rbrl/internal/schedule"
+	"github.com/derekprior/rbrl/internal/validator"
+)
+
+// checkWritten reads the workbook generate just saved at path back in and
+// validates it, for --validate: every game the scheduler placed must come
+// back as written, and the file must pass validate. It prints what it finds
+// and returns how many discrepancies and rule violations there were.
+func checkWritten(cfg *config.Config, path string, assignments []schedule.Assignment) (int, error) {
+	fmt.Printf("\n%sValidating %s:%s\n", colorBold, path, colorReset)
+	written, err := excel.ReadAssignments(path, cfg)
+	if err != nil {
+		return 0, fmt.Errorf("reading back %s: %w", path, err)
+	}
+	diffs := writtenDiff(assignments, written)
+	for _, d := range diffs {
+		fmt.Printf("%s✗ Round trip: %s%s\n", colorRed, d, colorReset)
+	}
+	if len(diffs) == 0 {
+		fmt.Printf("%s✓ All %d games read back as scheduled%s\n", colorGreen, len(assignments), colorReset)
+	}
+
+	violations, err := validator.Validate(cfg, path)
+	if err != nil {
+		return 0, fmt.Errorf("validating: %w", err)
+	}
+	return len(diffs) + reportViolations(violations), nil
+}
+
+// writtenDiff compares the games scheduled with the games read back from
+// the file, by date, time, field, teams, and note, describing each one
+// found on only one side: scheduled games first, in schedule order, then
+// games only in the file, in file order.
+func writtenDiff(scheduled, written []schedule.Assignment) []string {
+	type key struct {
+		slot             schedule.Slot
+		home, away, note string
+	}
+	keyOf := func(a schedule.Assignment) key {
+		return key{a.Slot, a.Game.Home, a.Game.Away, a.Game.Note}
+	}
+	describe := func(a schedule.Assignment) string {
+		s := fmt.Sprintf("%s %s %s, %s @ %s", a.Slot.Date.Format("Mon 01/02"), a.Slot.Time, a.Slot.Field, a.Game.Away, a.Game.Home)
+		if a.Game.Note != "" {
+			s += fmt.Sprintf(" (%s)", a.Game.Note)
+		}
+		return s
+	}
+
+	inFile := make(map[key]int)
+	for _, a := range written {
+		inFile[keyOf(a)]++
+	}
+	var diffs []string
+	for _, a := range scheduled {
+		k := keyOf(a)
+		if inFile[k] == 0 {
+			diffs = append(diffs, "scheduled but not in the file: "+describe(a))
+			continue
+		}
+		inFile[k]--
+	}
+	for _, a := range written {
+		if k := keyOf(a); inFile[k] > 0 {
+			inFile[k]--
+			diffs = append(diffs, "in the file but not scheduled: "+describe(a))
+		}
+	}
+	return diffs
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/derekprior/rbrl/internal/schedule"
+)
+
+func TestWrittenDiff(t *testing.T) {
+	scheduled := []schedule.Assignment{
+		testGame("2026-05-02", "12:30", "Symonds Field", "Padres", "Cubs", ""),
+		testGame("2026-05-02", "12:30", "Washington Park", "Royals", "Angels", "Sponsor Night"),
+		testGame("2026-05-02", "12:30", "Moscariello Ballpark", "Marlins", "Astros", ""),
+	}
+
+	t.Run("labels aren't compared", func(t *testing.T) {
+		written := slices.Clone(scheduled)
+		for i := range written {
+			written[i].Game.Label = "Game 1"
+		}
+		if diffs := writtenDiff(scheduled, written); len(diffs) != 0 {
+			t.Errorf("writtenDiff() = %q, want none", diffs)
+		}
+	})
+
+	t.Run("reports each side's extra games", func(t *testing.T) {
+		written := []schedule.Assignment{
+			testGame("2026-05-02", "12:30", "Symonds Field", "Cubs", "Padres", ""),
+			testGame("2026-05-02", "12:30", "Washington Park", "Royals", "Angels", ""),
+			testGame("2026-05-02", "12:30", "Moscariello Ballpark", "Marlins", "Astros", ""),
+		}
+		want := []string{
+			"scheduled but not in the file: Sat 05/02 12:30 Symonds Field, Padres @ Cubs",
+			"scheduled but not in the file: Sat 05/02 12:30 Washington Park, Royals @ Angels (Sponsor Night)",
+			"in the file but not scheduled: Sat 05/02 12:30 Symonds Field, Cubs @ Padres",
+			"in the file but not scheduled: Sat 05/02 12:30 Washington Park, Royals @ Angels",
+		}
+		if diffs := writtenDiff(scheduled, written); !slices.Equal(diffs, want) {
+			t.Errorf("writtenDiff() = %q, want %q", diffs, want)
+		}
+	})
+}
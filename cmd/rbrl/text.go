@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/excel"
+	"github.com/derekprior/rbrl/internal/schedule"
+)
+
+func runText(configPath, path, team string, all bool) error {
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+	switch {
+	case team != "" && all:
+		return fmt.Errorf("--team and --all can't be used together")
+	case team == "" && !all:
+		return fmt.Errorf("pass --team to print one team's games, or --all for every team")
+	case team != "" && !slices.Contains(cfg.AllTeams(), team):
+		return fmt.Errorf("--team: %q is not in any division", team)
+	}
+
+	assignments, err := excel.ReadAssignments(path, cfg)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", path, err)
+	}
+	if team != "" {
+		fmt.Print(teamText(cfg, assignments, team))
+		return nil
+	}
+	for i, t := range cfg.AllTeams() {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("%s\n%s\n", t, strings.Repeat("-", len(t)))
+		fmt.Print(teamText(cfg, assignments, t))
+	}
+	return nil
+}
+
+// teamText renders one team's games as one short line each, in date
+// order, for pasting into a group chat:
+//
+//	Sat 5/2 5:00 PM @ Washington vs Cubs (Home)
+//
+// Fields are named as on the master sheet, and a game's note follows the
+// home or away marker.
+func teamText(cfg *config.Config, assignments []schedule.Assignment, team string) string {
+	var games []schedule.Assignment
+	for _, a := range assignments {
+		if a.Game.Home == team || a.Game.Away == team {
+			games = append(games, a)
+		}
+	}
+	slices.SortStableFunc(games, func(a, b schedule.Assignment) int {
+		if c := a.Slot.Date.Compare(b.Slot.Date); c != 0 {
+			return c
+		}
+		return config.CompareTimes(a.Slot.Time, b.Slot.Time)
+	})
+	if len(games) == 0 {
+		return "No games.\n"
+	}
+
+	var fieldNames []string
+	for _, f := range cfg.Fields {
+		fieldNames = append(fieldNames, f.Name)
+	}
+	var b strings.Builder
+	for _, a := range games {
+		opponent, side := a.Game.Away, "Home"
+		if a.Game.Away == team {
+			opponent, side = a.Game.Home, "Away"
+		}
+		if a.Game.Note != "" {
+			side += ", " + a.Game.Note
+		}
+		fmt.Fprintf(&b, "%s %s @ %s vs %s (%s)\n", a.Slot.Date.Format("Mon 1/2"), clockTime(a.Slot.Time),
+			excel.FieldColumnName(a.Slot.Field, fieldNames), opponent, side)
+	}
+	return b.String()
+}
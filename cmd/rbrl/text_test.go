@@ -0,0 +1,32 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/schedule"
+)
+
+func TestTeamText(t *testing.T) {
+	cfg := &config.Config{Fields: []config.Field{{Name: "Symonds Field"}, {Name: "Washington Park"}}}
+	assignments := []schedule.Assignment{
+		testGame("2026-05-05", "17:45", "Symonds Field", "Angels", "Padres", "Opening night"),
+		testGame("2026-05-02", "17:00", "Washington Park", "Cubs", "Angels", ""),
+		testGame("2026-05-02", "12:30", "Symonds Field", "Astros", "Royals", ""),
+	}
+
+	t.Run("one line per game in date order", func(t *testing.T) {
+		want := `Sat 5/2 5:00 PM @ Washington vs Cubs (Home)
+Tue 5/5 5:45 PM @ Symonds vs Padres (Away, Opening night)
+`
+		if got := teamText(cfg, assignments, "Angels"); got != want {
+			t.Errorf("teamText =\n%s\nwant\n%s", got, want)
+		}
+	})
+
+	t.Run("no games", func(t *testing.T) {
+		if got := teamText(cfg, assignments, "Pirates"); got != "No games.\n" {
+			t.Errorf("teamText = %q, want %q", got, "No games.\n")
+		}
+	})
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/excel"
+	"github.com/derekprior/rbrl/internal/schedule"
+)
+
+func runWeek(configPath, path, week, team, division string) error {
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	// Today's date where the league plays, not in UTC, which is already
+	// tomorrow on a US evening
+	y, m, d := time.Now().In(cfg.Season.Location()).Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	if week != "" {
+		if day, err = time.Parse("2006-01-02", week); err != nil {
+			return fmt.Errorf("--week must be a date like 2026-05-04, got %q", week)
+		}
+	}
+	var teams []string
+	switch {
+	case team != "" && division != "":
+		return fmt.Errorf("--team and --division can't be used together")
+	case team != "":
+		if !slices.Contains(cfg.AllTeams(), team) {
+			return fmt.Errorf("--team: %q is not in any division", team)
+		}
+		teams = []string{team}
+	case division != "":
+		i := slices.IndexFunc(cfg.Divisions, func(d config.Division) bool { return d.Name == division })
+		if i < 0 {
+			return fmt.Errorf("--division: unknown division %q", division)
+		}
+		teams = cfg.Divisions[i].Teams
+	}
+
+	assignments, err := excel.ReadAssignments(path, cfg)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", path, err)
+	}
+	fmt.Print(weekDigest(assignments, schedule.WeekStart(day), team+division, teams))
+	return nil
+}
+
+// weekDigest renders the games in the calendar week starting monday as
+// plain text grouped by day, ready to paste into an email. With teams set,
+// only games involving one of them are listed, and the heading names who
+// they are for.
+func weekDigest(assignments []schedule.Assignment, monday time.Time, who string, teams []string) string {
+	next := monday.AddDate(0, 0, 7)
+	var games []schedule.Assignment
+	for _, a := range assignments {
+		if a.Slot.Date.Before(monday) || !a.Slot.Date.Before(next) {
+			continue
+		}
+		if teams != nil && !slices.Contains(teams, a.Game.Home) && !slices.Contains(teams, a.Game.Away) {
+			continue
+		}
+		games = append(games, a)
+	}
+	slices.SortStableFunc(games, func(a, b schedule.Assignment) int {
+		if c := a.Slot.Date.Compare(b.Slot.Date); c != 0 {
+			return c
+		}
+		if c := config.CompareTimes(a.Slot.Time, b.Slot.Time); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Slot.Field, b.Slot.Field)
+	})
+
+	var b strings.Builder
+	heading := "Games"
+	if who != "" {
+		heading = who + " games"
+	}
+	fmt.Fprintf(&b, "%s for the week of %s\n", heading, monday.Format("January 2, 2006"))
+	if len(games) == 0 {
+		b.WriteString("\nNo games this week.\n")
+		return b.String()
+	}
+	var day time.Time
+	for _, a := range games {
+		if !a.Slot.Date.Equal(day) {
+			day = a.Slot.Date
+			fmt.Fprintf(&b, "\n%s\n", day.Format("Monday, January 2"))
+		}
+		fmt.Fprintf(&b, "  %8s  %s @ %s, %s", clockTime(a.Slot.Time), a.Game.Away, a.Game.Home, a.Slot.Field)
+		if a.Game.Note != "" {
+			fmt.Fprintf(&b, " (%s)", a.Game.Note)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// clockTime renders a slot time like "17:45" as "5:45 PM", leaving times
+// that don't parse as they are.
+func clockTime(hhmm string) string {
+	t, err := time.Parse("15:04", hhmm)
+	if err != nil {
+		return hhmm
+	}
+	return t.Format("3:04 PM")
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derekprior/rbrl/internal/schedule"
+)
+
+func TestWeekDigest(t *testing.T) {
+	assignments := []schedule.Assignment{
+		testGame("2026-05-09", "14:45", "Symonds Field", "Cubs", "Angels", ""),
+		testGame("2026-05-03", "17:00", "Symonds Field", "Astros", "Angels", ""),
+		testGame("2026-05-05", "17:45", "Washington Park", "Padres", "Royals", "Opening night"),
+		testGame("2026-05-09", "12:30", "Symonds Field", "Pirates", "Astros", ""),
+		testGame("2026-05-11", "17:45", "Symonds Field", "Angels", "Royals", ""),
+	}
+	monday, _ := time.Parse("2006-01-02", "2026-05-04")
+
+	t.Run("whole league", func(t *testing.T) {
+		want := `Games for the week of May 4, 2026
+
+Tuesday, May 5
+   5:45 PM  Padres @ Royals, Washington Park (Opening night)
+
+Saturday, May 9
+  12:30 PM  Pirates @ Astros, Symonds Field
+   2:45 PM  Cubs @ Angels, Symonds Field
+`
+		if got := weekDigest(assignments, monday, "", nil); got != want {
+			t.Errorf("weekDigest =\n%s\nwant\n%s", got, want)
+		}
+	})
+
+	t.Run("one team", func(t *testing.T) {
+		want := `Angels games for the week of May 4, 2026
+
+Saturday, May 9
+   2:45 PM  Cubs @ Angels, Symonds Field
+`
+		if got := weekDigest(assignments, monday, "Angels", []string{"Angels"}); got != want {
+			t.Errorf("weekDigest =\n%s\nwant\n%s", got, want)
+		}
+	})
+
+	t.Run("empty week", func(t *testing.T) {
+		want := "Marlins games for the week of May 4, 2026\n\nNo games this week.\n"
+		if got := weekDigest(assignments, monday, "Marlins", []string{"Marlins"}); got != want {
+			t.Errorf("weekDigest = %q, want %q", got, want)
+		}
+	})
+}
@@ -2,7 +2,11 @@ package config
 
 import (
 	"fmt"
+	"maps"
 	"os"
+	"regexp"
+	"slices"
+	"strings"
 	"time"
 
 	"gopkg.in/yaml.v3"
@@ -28,10 +32,55 @@ type BlackoutDate struct {
 }
 
 type Season struct {
-	StartDate       Date           `yaml:"start_date"`
-	EndDate         Date           `yaml:"end_date"`
-	OverflowEndDate *Date          `yaml:"overflow_end_date"`
-	BlackoutDates   []BlackoutDate `yaml:"blackout_dates"`
+	StartDate        Date           `yaml:"start_date"`
+	EndDate          Date           `yaml:"end_date"`
+	OverflowEndDate  *Date          `yaml:"overflow_end_date"`
+	OverflowStrategy string         `yaml:"overflow_strategy"` // OverflowEarliest (default) or OverflowSpread
+	TargetEndDate    *Date          `yaml:"target_end_date"`   // prefer finishing by this date
+	BlackoutDates    []BlackoutDate `yaml:"blackout_dates"`
+	TimeZone         string         `yaml:"time_zone"` // IANA name, e.g. America/New_York
+
+	ReserveOpenSlots *ReserveOpenSlots `yaml:"reserve_open_slots"`
+
+	// WeekdayStartOffset keeps weekday slots off the first N days of the
+	// season, for an easier opening week. Weekend slots are unaffected.
+	WeekdayStartOffset int `yaml:"weekday_start_offset"`
+}
+
+// How games that don't fit the regular season are placed in the overflow
+// window: on the earliest open slots, finishing as soon as possible, or
+// spread across the window's dates.
+const (
+	OverflowEarliest = "earliest"
+	OverflowSpread   = "spread"
+)
+
+// WeekdaysStart returns the first date weekday games may be played, after
+// weekday_start_offset days of the season.
+func (s Season) WeekdaysStart() time.Time {
+	return s.StartDate.Time.AddDate(0, 0, s.WeekdayStartOffset)
+}
+
+// ReserveOpenSlots holds back Count regular-season slots in every Per
+// period ("saturday" or "week") so they stay open as a rain buffer. Held
+// slots are left off the schedule but are not blacked out.
+type ReserveOpenSlots struct {
+	Count int    `yaml:"count"`
+	Per   string `yaml:"per"`
+}
+
+// Location returns the season's time zone, defaulting to the local zone
+// when time_zone is unset. Slot dates and times are stored without a zone;
+// exporters use this to localize them.
+func (s Season) Location() *time.Location {
+	if s.TimeZone == "" {
+		return time.Local
+	}
+	loc, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		return time.Local
+	}
+	return loc
 }
 
 type Reservation struct {
@@ -39,9 +88,40 @@ type Reservation struct {
 	StartDate *Date    `yaml:"start_date"`
 	EndDate   *Date    `yaml:"end_date"`
 	Times     []string `yaml:"times"`
+	Until     string   `yaml:"until"` // blocks slots starting before this time
+	From      string   `yaml:"from"`  // blocks slots starting at or after this time
 	Reason    string   `yaml:"reason"`
 }
 
+// FullDay reports whether the reservation blocks every slot on its dates.
+func (r *Reservation) FullDay() bool {
+	return len(r.Times) == 0 && r.Until == "" && r.From == ""
+}
+
+// Blocks reports whether the reservation blocks a slot starting at t on
+// one of its dates.
+func (r *Reservation) Blocks(t string) bool {
+	if r.FullDay() {
+		return true
+	}
+	for _, rt := range r.Times {
+		if rt == t {
+			return true
+		}
+	}
+	start, err := time.Parse("15:04", t)
+	if err != nil {
+		return false
+	}
+	if until, err := time.Parse("15:04", r.Until); err == nil && start.Before(until) {
+		return true
+	}
+	if from, err := time.Parse("15:04", r.From); err == nil && !start.Before(from) {
+		return true
+	}
+	return false
+}
+
 // Dates returns all dates covered by this reservation.
 // Supports single date (date:) or range (start_date:/end_date:).
 func (r *Reservation) Dates() []time.Time {
@@ -63,18 +143,320 @@ func (r *Reservation) Dates() []time.Time {
 type Field struct {
 	Name         string        `yaml:"name"`
 	Reservations []Reservation `yaml:"reservations"`
+
+	// MinMinutesBetweenGames is the gap the field needs between one game
+	// ending and the next starting, e.g. to drag the infield.
+	MinMinutesBetweenGames int `yaml:"min_minutes_between_games"`
+
+	// TimeSlots overrides the league's time_slots for this field, e.g. a
+	// small diamond used only Saturday mornings.
+	TimeSlots *FieldTimeSlots `yaml:"time_slots"`
+}
+
+// ExtraColumns are the headers of the master sheet's optional Round and
+// Slot columns. No field's column may use them, or its games would be read
+// back as labels.
+var ExtraColumns = []string{"Round", "Slot"}
+
+// FieldColumnName returns the master sheet column header for a field: its
+// first word when that is unique among all field names, else the full name.
+func FieldColumnName(name string, allNames []string) string {
+	first, _, _ := strings.Cut(name, " ")
+	count := 0
+	for _, n := range allNames {
+		if word, _, _ := strings.Cut(n, " "); word == first {
+			count++
+		}
+	}
+	if count > 1 {
+		return name
+	}
+	return first
+}
+
+// FieldTimeSlots replaces a field's slot times by day template. A list
+// that is set, even to [], replaces the league's list for days following
+// that template; one left out keeps the league's times. Holidays follow
+// their template and latest_start still applies.
+type FieldTimeSlots struct {
+	Weekday  []string `yaml:"weekday"`
+	Saturday []string `yaml:"saturday"`
+	Sunday   []string `yaml:"sunday"`
+}
+
+// SlotTimes returns ts with the field's time_slots overrides applied.
+func (f Field) SlotTimes(ts TimeSlots) TimeSlots {
+	if f.TimeSlots == nil {
+		return ts
+	}
+	if f.TimeSlots.Weekday != nil {
+		ts.Weekday = f.TimeSlots.Weekday
+	}
+	if f.TimeSlots.Saturday != nil {
+		ts.Saturday = f.TimeSlots.Saturday
+	}
+	if f.TimeSlots.Sunday != nil {
+		ts.Sunday = f.TimeSlots.Sunday
+	}
+	return ts
+}
+
+// CompareTimes orders slot times like "9:00" and "12:30" by the clock,
+// falling back to comparing the strings when either doesn't parse.
+func CompareTimes(a, b string) int {
+	ta, errA := time.Parse("15:04", a)
+	tb, errB := time.Parse("15:04", b)
+	if errA != nil || errB != nil {
+		return strings.Compare(a, b)
+	}
+	return ta.Compare(tb)
+}
+
+// TooClose reports whether games starting at a and b on the field, each
+// lasting length, leave less than min_minutes_between_games between them.
+// Times that don't parse are never too close.
+func (f Field) TooClose(a, b string, length time.Duration) bool {
+	if f.MinMinutesBetweenGames <= 0 {
+		return false
+	}
+	gap, ok := gameGap(a, b, length)
+	return ok && gap < time.Duration(f.MinMinutesBetweenGames)*time.Minute
+}
+
+// gameGap returns the time between the earlier of two games starting at a
+// and b ending and the later one starting; it is negative when they
+// overlap. ok is false if either time doesn't parse.
+func gameGap(a, b string, length time.Duration) (gap time.Duration, ok bool) {
+	ta, errA := time.Parse("15:04", a)
+	tb, errB := time.Parse("15:04", b)
+	if errA != nil || errB != nil {
+		return 0, false
+	}
+	if tb.Before(ta) {
+		ta, tb = tb, ta
+	}
+	return tb.Sub(ta.Add(length)), true
 }
 
 type Division struct {
-	Name  string   `yaml:"name"`
-	Teams []string `yaml:"teams"`
+	Name            string   `yaml:"name"`
+	Teams           []string `yaml:"teams"`
+	PreferredFields []string `yaml:"preferred_fields"` // fields the division's games should use when one is free
+
+	// MinDaysBetweenSameMatchup overrides the guideline for the division's
+	// games; nil uses the guideline.
+	MinDaysBetweenSameMatchup *int `yaml:"min_days_between_same_matchup"`
+}
+
+// RematchDays returns how many days apart a and b's meetings should be:
+// the stricter of their divisions' min_days_between_same_matchup, each
+// defaulting to the guideline.
+func (c *Config) RematchDays(a, b string) int {
+	days, found := 0, false
+	for _, div := range c.Divisions {
+		if !slices.Contains(div.Teams, a) && !slices.Contains(div.Teams, b) {
+			continue
+		}
+		d := c.Guidelines.MinDaysBetweenSameMatchup
+		if div.MinDaysBetweenSameMatchup != nil {
+			d = *div.MinDaysBetweenSameMatchup
+		}
+		days, found = max(days, d), true
+	}
+	if !found {
+		return c.Guidelines.MinDaysBetweenSameMatchup
+	}
+	return days
+}
+
+// Holiday is a date that borrows another day's time slots. As names the
+// template to follow ("saturday", "sunday", or "weekday"); empty means sunday.
+type Holiday struct {
+	Date Date   `yaml:"date"`
+	As   string `yaml:"as"`
+}
+
+// UnmarshalYAML accepts either a bare date string or a mapping with date/as.
+func (h *Holiday) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		return h.Date.UnmarshalYAML(value)
+	}
+	type plain Holiday
+	return value.Decode((*plain)(h))
+}
+
+// Template returns the day template this holiday follows, defaulting to sunday.
+func (h Holiday) Template() string {
+	if h.As == "" {
+		return "sunday"
+	}
+	return h.As
+}
+
+// Team holds optional per-team settings. Teams are declared in divisions;
+// entries here only add settings for the teams that need them.
+type Team struct {
+	Name              string   `yaml:"name"`
+	PreferredOffDates []Date   `yaml:"preferred_off_dates"`
+	HomeField         string   `yaml:"home_field"`          // all home games on this field
+	ExcludedFields    []string `yaml:"excluded_fields"`     // fields the team never plays on, home or away
+	MaxSaturdayGames  int      `yaml:"max_saturday_games"`  // 0 = no cap
+	MaxDistinctFields int      `yaml:"max_distinct_fields"` // fields the team plays at all season; 0 = no cap
+
+	// HomeWeight tilts home/away assignment toward this team; 2 aims for
+	// twice the home share of a team at the default weight of 1. nil means
+	// unset; a weight that is set must be positive.
+	HomeWeight *float64 `yaml:"home_weight"`
+
+	// Rating is the team's power rating; higher is stronger. Only how a
+	// team compares to the league average matters.
+	Rating float64 `yaml:"rating"`
+
+	// Coach and Email fill the workbook's Contacts sheet for mail merges.
+	Coach string `yaml:"coach"`
+	Email string `yaml:"email"`
+}
+
+// PriorGame is a game from an earlier season, read from its workbook with
+// generate --prior-season.
+type PriorGame struct {
+	Home, Away string
+	Date       time.Time
+	Time       string
+	Field      string
 }
 
 type TimeSlots struct {
-	Weekday      []string `yaml:"weekday"`
-	Saturday     []string `yaml:"saturday"`
-	Sunday       []string `yaml:"sunday"`
-	HolidayDates []Date   `yaml:"holiday_dates"`
+	Weekday      []string  `yaml:"weekday"`
+	Saturday     []string  `yaml:"saturday"`
+	Sunday       []string  `yaml:"sunday"`
+	HolidayDates []Holiday `yaml:"holiday_dates"`
+	GameMinutes  int       `yaml:"game_minutes"` // how long a game runs; default 120
+
+	// LatestStart caps slot start times by day of the week, e.g.
+	// {monday: "18:00"} for a school-night curfew: slots starting later
+	// are dropped on that day, whatever the day's slot list says.
+	LatestStart map[string]string `yaml:"latest_start"`
+
+	// Labels names slot times by day of the week, for fields whose posted
+	// schedules say "Game 1" rather than "12:30", e.g.
+	// {saturday: {"12:30": "Game 1", "14:45": "Game 2"}}. The master sheet
+	// shows them in a Slot column beside the times.
+	Labels map[string]map[string]string `yaml:"labels"`
+}
+
+// Label returns the name time_slots.labels gives the slot time on d's day
+// of the week, or "" when it has none.
+func (ts TimeSlots) Label(d time.Time, hhmm string) string {
+	for name, labels := range ts.Labels {
+		if weekdayNames[strings.ToLower(name)] == d.Weekday() {
+			return labels[hhmm]
+		}
+	}
+	return ""
+}
+
+// GameLength returns how long a game runs, defaulting to two hours.
+func (ts TimeSlots) GameLength() time.Duration {
+	if ts.GameMinutes <= 0 {
+		return 2 * time.Hour
+	}
+	return time.Duration(ts.GameMinutes) * time.Minute
+}
+
+// Overlap reports whether games starting at a and b would be on the field
+// at the same time, given the game length. Times that don't parse never
+// overlap.
+func (ts TimeSlots) Overlap(a, b string) bool {
+	gap, ok := gameGap(a, b, ts.GameLength())
+	return ok && gap < 0
+}
+
+// TimesForDay returns the slot times offered on d on any field, in clock
+// order: its holiday template's times if d is in holiday_dates, otherwise
+// its weekday's, with each field's time_slots overrides.
+func (c *Config) TimesForDay(d time.Time) []string {
+	if !slices.ContainsFunc(c.Fields, func(f Field) bool { return f.TimeSlots != nil }) {
+		return c.TimeSlots.timesOn(d)
+	}
+	var times []string
+	for _, f := range c.Fields {
+		for _, t := range f.SlotTimes(c.TimeSlots).timesOn(d) {
+			if !slices.Contains(times, t) {
+				times = append(times, t)
+			}
+		}
+	}
+	slices.SortFunc(times, CompareTimes)
+	return times
+}
+
+// FieldTimesForDay returns the slot times the named field offers on d.
+func (c *Config) FieldTimesForDay(field string, d time.Time) []string {
+	ts := c.TimeSlots
+	if i := slices.IndexFunc(c.Fields, func(f Field) bool { return f.Name == field }); i >= 0 {
+		ts = c.Fields[i].SlotTimes(ts)
+	}
+	return ts.timesOn(d)
+}
+
+// timesOn returns the slot times of d's day template, before any field
+// overrides.
+func (ts TimeSlots) timesOn(d time.Time) []string {
+	times := ts.Weekday
+	switch ts.DayTemplate(d) {
+	case "saturday":
+		times = ts.Saturday
+	case "sunday":
+		times = ts.Sunday
+	}
+	return ts.StartingBy(d.Weekday(), times)
+}
+
+// DayTemplate returns the day template d follows: "saturday", "sunday", or
+// "weekday", from holiday_dates when d is listed and its weekday otherwise.
+func (ts TimeSlots) DayTemplate(d time.Time) string {
+	template := ""
+	for _, h := range ts.HolidayDates {
+		if h.Date.Time.Equal(d) {
+			template = h.Template()
+		}
+	}
+	if template != "" {
+		return template
+	}
+	switch d.Weekday() {
+	case time.Saturday:
+		return "saturday"
+	case time.Sunday:
+		return "sunday"
+	}
+	return "weekday"
+}
+
+// StartingBy returns the times that start no later than the day's
+// latest_start, or all of them when the day has none.
+func (ts TimeSlots) StartingBy(day time.Weekday, times []string) []string {
+	var cutoff time.Time
+	for name, latest := range ts.LatestStart {
+		if weekdayNames[strings.ToLower(name)] == day {
+			t, err := time.Parse("15:04", latest)
+			if err != nil {
+				return times
+			}
+			cutoff = t
+		}
+	}
+	if cutoff.IsZero() {
+		return times
+	}
+	var kept []string
+	for _, s := range times {
+		if t, err := time.Parse("15:04", s); err != nil || !t.After(cutoff) {
+			kept = append(kept, s)
+		}
+	}
+	return kept
 }
 
 type Rules struct {
@@ -83,22 +465,447 @@ type Rules struct {
 	MaxGamesPerWeek       int  `yaml:"max_games_per_week"`
 	MaxGamesPerTimeslot   int  `yaml:"max_games_per_timeslot"`
 	Max3In4Days           bool `yaml:"max_3_in_4_days"`
+
+	// MaxHomeGamesPerWeek caps how often a team hosts in an ISO week, for
+	// the volunteer crew that runs its home games. 0 means unlimited.
+	MaxHomeGamesPerWeek int `yaml:"max_home_games_per_week"`
+
+	// MaxGamesPerDate caps the games on any one date across every field,
+	// e.g. for a noise ordinance. 0 means unlimited.
+	MaxGamesPerDate int `yaml:"max_games_per_date"`
+
+	// MatchupBlackouts keep two teams from meeting on a date, e.g. when
+	// they share a coach, while leaving each free to play someone else.
+	MatchupBlackouts []MatchupBlackout `yaml:"matchup_blackouts"`
+
+	// RequireSlotHeadroom refuses to schedule unless the slots can hold at
+	// least this multiple of the games, e.g. 1.1 for 10% to spare. 0 is off.
+	RequireSlotHeadroom float64 `yaml:"require_slot_headroom"`
+
+	// SamePhysicalLocation lists groups of fields that are really one
+	// diamond, so at most one of each group hosts a game per timeslot.
+	SamePhysicalLocation [][]string `yaml:"same_physical_location"`
+
+	// EnforceMaxDistinctFields makes teams' max_distinct_fields a hard
+	// rule rather than a guideline.
+	EnforceMaxDistinctFields bool `yaml:"enforce_max_distinct_fields"`
+
+	// EnforceMaxOpeningAwayStreak makes the max_opening_away_streak
+	// guideline a hard rule.
+	EnforceMaxOpeningAwayStreak bool `yaml:"enforce_max_opening_away_streak"`
+}
+
+// SharedLocation returns the other fields listed in same_physical_location
+// with field, or nil when it stands alone.
+func (r *Rules) SharedLocation(field string) []string {
+	for _, group := range r.SamePhysicalLocation {
+		if slices.Contains(group, field) {
+			var others []string
+			for _, f := range group {
+				if f != field {
+					others = append(others, f)
+				}
+			}
+			return others
+		}
+	}
+	return nil
+}
+
+// MatchupBlackout is a date two teams can't play each other. It applies
+// whichever team is at home. Either side may be AnyOpponent to keep the
+// other team from playing anyone that day.
+type MatchupBlackout struct {
+	Home   string `yaml:"home"`
+	Away   string `yaml:"away"`
+	Date   Date   `yaml:"date"`
+	Reason string `yaml:"reason"`
+}
+
+// AnyOpponent stands for every team as one side of a matchup_blackouts
+// entry.
+const AnyOpponent = "any"
+
+// Matches reports whether the entry keeps home and away from meeting on
+// date, whichever of them is at home.
+func (m MatchupBlackout) Matches(home, away string, date time.Time) bool {
+	if !m.Date.Time.Equal(date) {
+		return false
+	}
+	side := func(name, team string) bool { return name == AnyOpponent || name == team }
+	return side(m.Home, home) && side(m.Away, away) || side(m.Home, away) && side(m.Away, home)
+}
+
+// OpponentGroup is a set of teams that count as one opponent for spacing:
+// a team's games against any members should be spread out.
+type OpponentGroup struct {
+	Name  string   `yaml:"name"`
+	Teams []string `yaml:"teams"`
 }
 
 type Guidelines struct {
-	MinDaysBetweenSameMatchup int  `yaml:"min_days_between_same_matchup"`
-	BalanceSundayGames        bool `yaml:"balance_sunday_games"`
-	BalancePace               bool `yaml:"balance_pace"`
+	MinDaysBetweenSameMatchup int             `yaml:"min_days_between_same_matchup"`
+	BalanceSundayGames        bool            `yaml:"balance_sunday_games"`
+	BalancePace               bool            `yaml:"balance_pace"`
+	BalanceSeasonEnd          bool            `yaml:"balance_season_end"`      // keep teams' final game dates close together
+	AvoidBothWeekendDays      bool            `yaml:"avoid_both_weekend_days"` // a team plays Saturday or Sunday of a weekend, not both
+	BalanceStartTimes         bool            `yaml:"balance_start_times"`     // give each team a mix of start times on days with a choice
+	OpponentGroups            []OpponentGroup `yaml:"opponent_groups"`
+	MinDaysBetweenGroupGames  int             `yaml:"min_days_between_group_games"`
+	FieldPriority             []string        `yaml:"field_priority"` // most preferred field first
+	MaxConsecutiveByeWeeks    int             `yaml:"max_consecutive_bye_weeks"`
+	SameFieldWeekPenalty      float64         `yaml:"same_field_week_penalty"` // per repeat of a field in a team's week
+	OpponentVariety           bool            `yaml:"opponent_variety"`        // cycle through opponents before repeating one
+	BalanceDivisionTimeslots  bool            `yaml:"balance_division_timeslots"`
+	MinSaturdayGames          int             `yaml:"min_saturday_games"`         // 0 = every Saturday
+	IntraDivisionFirst        bool            `yaml:"intra_division_first"`       // schedule each division alone, then inter-division games
+	RepairIterations          int             `yaml:"repair_iterations"`          // local-search rounds after scheduling; 0 = off
+	SundayBalanceTolerance    *int            `yaml:"sunday_balance_tolerance"`   // default 1
+	PaceBalanceTolerance      *int            `yaml:"pace_balance_tolerance"`     // unset = no pace warnings
+	MaxStrongOpponentStreak   int             `yaml:"max_strong_opponent_streak"` // above-average-rated opponents in a row; 0 = off
+	MaxOpeningAwayStreak      int             `yaml:"max_opening_away_streak"`    // away games to open a team's season; 0 = off
+	FamilyLinks               [][]string      `yaml:"family_links"`               // teams sharing families, scheduled on the same days when possible
+	PreferredWeekdayOrder     []string        `yaml:"preferred_weekday_order"`    // most preferred weekday first, e.g. [friday, thursday]
+	Optimize                  string          `yaml:"optimize"`                   // "" to weigh every guideline, or OptimizeRematchSpacing
+
+	// ExcludeOverflowFromScoring scores every guideline on the regular
+	// season alone; overflow games count only toward the overflow penalty.
+	ExcludeOverflowFromScoring bool `yaml:"exclude_overflow_from_scoring"`
+}
+
+// OptimizeRematchSpacing makes spacing out each pair's meetings the
+// scheduler's primary objective, ahead of every other guideline.
+const OptimizeRematchSpacing = "rematch-spacing"
+
+// SundayTolerance returns how far apart teams' Sunday game counts may be
+// before balance_sunday_games reports an imbalance, defaulting to 1.
+func (g *Guidelines) SundayTolerance() int {
+	if g.SundayBalanceTolerance == nil {
+		return 1
+	}
+	return *g.SundayBalanceTolerance
+}
+
+// PaceTolerance returns how far apart teams' games played may be at the end
+// of a week before balance_pace reports an imbalance. ok is false when
+// pace_balance_tolerance isn't set: balance_pace alone steers the scheduler
+// without reporting anything.
+func (g *Guidelines) PaceTolerance() (tolerance int, ok bool) {
+	if g.PaceBalanceTolerance == nil {
+		return 0, false
+	}
+	return *g.PaceBalanceTolerance, true
+}
+
+// GroupsOf returns the names of the opponent groups the team belongs to.
+func (g *Guidelines) GroupsOf(team string) []string {
+	var groups []string
+	for _, og := range g.OpponentGroups {
+		for _, t := range og.Teams {
+			if t == team {
+				groups = append(groups, og.Name)
+				break
+			}
+		}
+	}
+	return groups
+}
+
+// weekdayNames maps the day names latest_start and labels accept to days of
+// the week; preferred_weekday_order takes only Monday through Friday.
+var weekdayNames = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// WeekdayRank returns the day's position in preferred_weekday_order, 0 for
+// the most preferred. Weekdays not listed rank after every listed one.
+func (g *Guidelines) WeekdayRank(day time.Weekday) int {
+	for i, name := range g.PreferredWeekdayOrder {
+		if weekdayNames[strings.ToLower(name)] == day {
+			return i
+		}
+	}
+	return len(g.PreferredWeekdayOrder)
+}
+
+// LinkedTeams returns the teams that share a family_links entry with team.
+func (g *Guidelines) LinkedTeams(team string) []string {
+	var linked []string
+	for _, link := range g.FamilyLinks {
+		if !slices.Contains(link, team) {
+			continue
+		}
+		for _, t := range link {
+			if t != team && !slices.Contains(linked, t) {
+				linked = append(linked, t)
+			}
+		}
+	}
+	return linked
+}
+
+// StrategyBlock is one entry of strategies. Its matchups are added after
+// earlier blocks'; games between a pair an earlier block already plays are
+// dropped unless AllowRepeats is set.
+type StrategyBlock struct {
+	Strategy     string      `yaml:"strategy"`
+	Games        []FixedGame `yaml:"games"` // matchups for the "fixed" strategy
+	AllowRepeats bool        `yaml:"allow_repeats"`
+}
+
+// FixedGame is one matchup listed by hand for the "fixed" strategy, such as
+// a traditional exhibition game.
+type FixedGame struct {
+	Home        string `yaml:"home"`
+	Away        string `yaml:"away"`
+	WeekendOnly bool   `yaml:"weekend_only"` // only on a Saturday or Sunday
+	Note        string `yaml:"note"`         // shown with the game, e.g. "Sponsor Night"
+}
+
+// StrategyName describes the season's strategy for messages, joining the
+// names of strategies blocks with " + ".
+func (c *Config) StrategyName() string {
+	if len(c.Strategies) == 0 {
+		return c.Strategy
+	}
+	names := make([]string, len(c.Strategies))
+	for i, b := range c.Strategies {
+		names[i] = b.Strategy
+	}
+	return strings.Join(names, " + ")
+}
+
+// usesStrategy reports whether the season uses the named strategy, alone
+// or as one of its strategies blocks.
+func (c *Config) usesStrategy(name string) bool {
+	if c.Strategy == name {
+		return true
+	}
+	for _, b := range c.Strategies {
+		if b.Strategy == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Playoffs configures the "bracket" strategy.
+type Playoffs struct {
+	Seeds    []string `yaml:"seeds"`     // best seed first; defaults to division order
+	RestDays int      `yaml:"rest_days"` // minimum full days off between rounds
+}
+
+// validateMatrix checks the matchup_matrix the "matrix" strategy plays:
+// known teams, no team against itself, counts that agree when a pair is
+// listed both ways round, and at least one game for every team.
+func (c *Config) validateMatrix() error {
+	if len(c.MatchupMatrix) == 0 {
+		return fmt.Errorf("strategy matrix needs a matchup_matrix")
+	}
+	inLeague := make(map[string]bool)
+	for _, team := range c.AllTeams() {
+		inLeague[team] = true
+	}
+	plays := make(map[string]bool)
+	for _, team := range slices.Sorted(maps.Keys(c.MatchupMatrix)) {
+		if !inLeague[team] {
+			return fmt.Errorf("matchup_matrix: %q is not in any division", team)
+		}
+		row := c.MatchupMatrix[team]
+		for _, opponent := range slices.Sorted(maps.Keys(row)) {
+			n := row[opponent]
+			switch {
+			case !inLeague[opponent]:
+				return fmt.Errorf("matchup_matrix: %s's opponent %q is not in any division", team, opponent)
+			case opponent == team:
+				return fmt.Errorf("matchup_matrix: %s can't play itself", team)
+			case n < 0:
+				return fmt.Errorf("matchup_matrix: %s vs %s must be 0 or more games, got %d", team, opponent, n)
+			}
+			if back, ok := c.MatchupMatrix[opponent][team]; ok && back != n {
+				return fmt.Errorf("matchup_matrix: %s vs %s is %d games but %s vs %s is %d; the matrix must be symmetric",
+					team, opponent, n, opponent, team, back)
+			}
+			if n > 0 {
+				plays[team], plays[opponent] = true, true
+			}
+		}
+	}
+	for _, team := range c.AllTeams() {
+		if !plays[team] {
+			return fmt.Errorf("matchup_matrix: %q has no games", team)
+		}
+	}
+	return nil
+}
+
+// Banded configures the "banded" strategy: every team plays between
+// MinGamesPerTeam and MaxGamesPerTeam games. Max defaults to Min.
+type Banded struct {
+	MinGamesPerTeam int `yaml:"min_games_per_team"`
+	MaxGamesPerTeam int `yaml:"max_games_per_team"`
+}
+
+// validate reports a band no schedule for teams teams can land in.
+func (b Banded) validate(teams int) error {
+	lo, hi := b.MinGamesPerTeam, max(b.MaxGamesPerTeam, b.MinGamesPerTeam)
+	switch {
+	case lo <= 0:
+		return fmt.Errorf("banded: min_games_per_team must be positive, got %d", lo)
+	case b.MaxGamesPerTeam != 0 && b.MaxGamesPerTeam < lo:
+		return fmt.Errorf("banded: max_games_per_team %d is below min_games_per_team %d", b.MaxGamesPerTeam, lo)
+	case teams < 2:
+		return fmt.Errorf("banded: needs at least two teams, got %d", teams)
+	case lo == hi && teams*lo%2 == 1:
+		return fmt.Errorf("banded: %d teams can't each play exactly %d games (every game needs two teams); raise max_games_per_team", teams, lo)
+	}
+	return nil
+}
+
+// Output controls optional content in the generated workbook.
+type Output struct {
+	Grid   bool `yaml:"grid"`   // add a team-by-date "Grid" sheet
+	Rounds bool `yaml:"rounds"` // add a Round column to the master sheet
+
+	// CombineBlackoutReasons lists every reason on a master sheet cell that
+	// several blackouts or reservations cover, e.g. "Varsity / Tournament",
+	// instead of just the first in config order.
+	CombineBlackoutReasons bool `yaml:"combine_blackout_reasons"`
+
+	// DayFormat renders the Day column as "short" (Mon, the default) or
+	// "long" (Monday) names, in Locale's language (default "en").
+	DayFormat string `yaml:"day_format"`
+	Locale    string `yaml:"locale"`
+}
+
+// localDayNames holds each supported locale's long and short day names,
+// Sunday first.
+var localDayNames = map[string]struct{ long, short [7]string }{
+	"en": {
+		[7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		[7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+	},
+	"es": {
+		[7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		[7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+	},
+	"fr": {
+		[7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		[7]string{"dim", "lun", "mar", "mer", "jeu", "ven", "sam"},
+	},
+	"de": {
+		[7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		[7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+	},
+	"pt": {
+		[7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+		[7]string{"dom", "seg", "ter", "qua", "qui", "sex", "sáb"},
+	},
+}
+
+// DayName renders d's day of the week for the Day column, per day_format
+// and locale.
+func (o Output) DayName(d time.Time) string {
+	names, ok := localDayNames[o.Locale]
+	if !ok {
+		names = localDayNames["en"]
+	}
+	if o.DayFormat == "long" {
+		return names.long[d.Weekday()]
+	}
+	return names.short[d.Weekday()]
+}
+
+var hexColor = regexp.MustCompile(`^#?[0-9A-Fa-f]{6}$`)
+
+// Style overrides the workbook's look. Zero values keep the default
+// Arial 16 with blue headers.
+type Style struct {
+	FontFamily string  `yaml:"font_family"`
+	FontSize   float64 `yaml:"font_size"`
+	HeaderFill string  `yaml:"header_fill"` // hex color, e.g. "#4472C4"
+
+	HighlightBlackouts *bool `yaml:"highlight_blackouts"` // default true
+	HighlightEmpty     bool  `yaml:"highlight_empty"`
+}
+
+// Family returns the font family, defaulting to Arial.
+func (s Style) Family() string {
+	if s.FontFamily == "" {
+		return "Arial"
+	}
+	return s.FontFamily
+}
+
+// Size returns the base font size, defaulting to 16.
+func (s Style) Size() float64 {
+	if s.FontSize == 0 {
+		return 16
+	}
+	return s.FontSize
+}
+
+// Fill returns the header fill color, defaulting to blue.
+func (s Style) Fill() string {
+	if s.HeaderFill == "" {
+		return "#4472C4"
+	}
+	if !strings.HasPrefix(s.HeaderFill, "#") {
+		return "#" + s.HeaderFill
+	}
+	return s.HeaderFill
+}
+
+// BlackoutsHighlighted reports whether blackout and reservation cells on the
+// master sheet are filled red, which they are unless turned off.
+func (s Style) BlackoutsHighlighted() bool {
+	return s.HighlightBlackouts == nil || *s.HighlightBlackouts
 }
 
 type Config struct {
-	Season     Season     `yaml:"season"`
-	Divisions  []Division `yaml:"divisions"`
-	Fields     []Field    `yaml:"fields"`
-	TimeSlots  TimeSlots  `yaml:"time_slots"`
-	Strategy   string     `yaml:"strategy"`
-	Rules      Rules      `yaml:"rules"`
-	Guidelines Guidelines `yaml:"guidelines"`
+	Season        Season                    `yaml:"season"`
+	Divisions     []Division                `yaml:"divisions"`
+	Fields        []Field                   `yaml:"fields"`
+	Teams         []Team                    `yaml:"teams"`
+	TimeSlots     TimeSlots                 `yaml:"time_slots"`
+	Strategy      string                    `yaml:"strategy"`
+	Strategies    []StrategyBlock           `yaml:"strategies"` // instead of strategy, several strategies' matchups combined
+	Playoffs      Playoffs                  `yaml:"playoffs"`
+	Banded        Banded                    `yaml:"banded"`
+	MatchupMatrix map[string]map[string]int `yaml:"matchup_matrix"` // games per pair for the "matrix" strategy
+	Rules         Rules                     `yaml:"rules"`
+	Guidelines    Guidelines                `yaml:"guidelines"`
+	Output        Output                    `yaml:"output"`
+	Style         Style                     `yaml:"style"`
+
+	// PriorSeason holds an earlier season's games, set by generate
+	// --prior-season rather than the config file. Teams that drew more
+	// than their share of a slot time or field then are steered away from
+	// it now.
+	PriorSeason []PriorGame `yaml:"-"`
+}
+
+// Meetings returns the matchup_matrix as a game count per unordered pair,
+// keyed with the name that sorts first. A pair need only be listed under
+// one of its teams.
+func (c *Config) Meetings() map[[2]string]int {
+	meetings := make(map[[2]string]int)
+	for team, row := range c.MatchupMatrix {
+		for opponent, n := range row {
+			a, b := team, opponent
+			if b < a {
+				a, b = b, a
+			}
+			meetings[[2]string{a, b}] = n
+		}
+	}
+	return meetings
 }
 
 // AllTeams returns all team names across all divisions.
@@ -110,6 +917,248 @@ func (c *Config) AllTeams() []string {
 	return teams
 }
 
+// OnlyDivisions returns a copy of the config limited to the named
+// divisions, kept in config order, so one division's slate can be scheduled
+// on its own. Per-team settings, playoff seeds, opponent group members, and
+// family links for teams in other divisions are dropped, as are fixed
+// games, matchup blackouts, and matchup_matrix entries involving them. It
+// fails if a name matches no division or the smaller league doesn't
+// validate.
+func (c *Config) OnlyDivisions(names []string) (*Config, error) {
+	keep := make(map[string]bool)
+	for _, name := range names {
+		keep[name] = true
+	}
+	out := *c
+	out.Divisions = nil
+	for _, div := range c.Divisions {
+		if keep[div.Name] {
+			out.Divisions = append(out.Divisions, div)
+			delete(keep, div.Name)
+		}
+	}
+	for _, name := range names {
+		if keep[name] {
+			return nil, fmt.Errorf("unknown division %q", name)
+		}
+	}
+
+	inLeague := make(map[string]bool)
+	for _, team := range out.AllTeams() {
+		inLeague[team] = true
+	}
+	out.Teams = nil
+	for _, t := range c.Teams {
+		if inLeague[t.Name] {
+			out.Teams = append(out.Teams, t)
+		}
+	}
+	out.Playoffs.Seeds = nil
+	for _, team := range c.Playoffs.Seeds {
+		if inLeague[team] {
+			out.Playoffs.Seeds = append(out.Playoffs.Seeds, team)
+		}
+	}
+	out.Guidelines.OpponentGroups = nil
+	for _, g := range c.Guidelines.OpponentGroups {
+		var teams []string
+		for _, team := range g.Teams {
+			if inLeague[team] {
+				teams = append(teams, team)
+			}
+		}
+		if len(teams) > 0 {
+			out.Guidelines.OpponentGroups = append(out.Guidelines.OpponentGroups, OpponentGroup{Name: g.Name, Teams: teams})
+		}
+	}
+
+	out.Guidelines.FamilyLinks = nil
+	for _, link := range c.Guidelines.FamilyLinks {
+		var teams []string
+		for _, team := range link {
+			if inLeague[team] {
+				teams = append(teams, team)
+			}
+		}
+		if len(teams) > 1 {
+			out.Guidelines.FamilyLinks = append(out.Guidelines.FamilyLinks, teams)
+		}
+	}
+
+	out.Rules.MatchupBlackouts = nil
+	for _, m := range c.Rules.MatchupBlackouts {
+		if (inLeague[m.Home] || m.Home == AnyOpponent) && (inLeague[m.Away] || m.Away == AnyOpponent) {
+			out.Rules.MatchupBlackouts = append(out.Rules.MatchupBlackouts, m)
+		}
+	}
+
+	out.MatchupMatrix = nil
+	for team, row := range c.MatchupMatrix {
+		if !inLeague[team] {
+			continue
+		}
+		for opponent, n := range row {
+			if !inLeague[opponent] {
+				continue
+			}
+			if out.MatchupMatrix == nil {
+				out.MatchupMatrix = make(map[string]map[string]int)
+			}
+			if out.MatchupMatrix[team] == nil {
+				out.MatchupMatrix[team] = make(map[string]int)
+			}
+			out.MatchupMatrix[team][opponent] = n
+		}
+	}
+
+	out.Strategies = nil
+	for _, b := range c.Strategies {
+		if b.Strategy == "fixed" {
+			var games []FixedGame
+			for _, g := range b.Games {
+				if inLeague[g.Home] && inLeague[g.Away] {
+					games = append(games, g)
+				}
+			}
+			if len(games) == 0 {
+				continue
+			}
+			b.Games = games
+		}
+		out.Strategies = append(out.Strategies, b)
+	}
+
+	if err := out.validate(); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Relabel returns a copy of the config with every team renamed through
+// names, for a blind review of the schedule. Teams missing from names keep
+// their name. Coach and email are dropped since they identify the team.
+func (c *Config) Relabel(names map[string]string) *Config {
+	rename := func(team string) string {
+		if name, ok := names[team]; ok {
+			return name
+		}
+		return team
+	}
+	renameAll := func(teams []string) []string {
+		out := make([]string, len(teams))
+		for i, team := range teams {
+			out[i] = rename(team)
+		}
+		return out
+	}
+
+	out := *c
+	out.Divisions = make([]Division, len(c.Divisions))
+	for i, div := range c.Divisions {
+		out.Divisions[i] = div
+		out.Divisions[i].Teams = renameAll(div.Teams)
+	}
+	out.Teams = make([]Team, len(c.Teams))
+	for i, t := range c.Teams {
+		t.Name = rename(t.Name)
+		t.Coach, t.Email = "", ""
+		out.Teams[i] = t
+	}
+	out.Playoffs.Seeds = renameAll(c.Playoffs.Seeds)
+	out.Guidelines.OpponentGroups = make([]OpponentGroup, len(c.Guidelines.OpponentGroups))
+	for i, g := range c.Guidelines.OpponentGroups {
+		out.Guidelines.OpponentGroups[i] = OpponentGroup{Name: g.Name, Teams: renameAll(g.Teams)}
+	}
+	out.Guidelines.FamilyLinks = make([][]string, len(c.Guidelines.FamilyLinks))
+	for i, link := range c.Guidelines.FamilyLinks {
+		out.Guidelines.FamilyLinks[i] = renameAll(link)
+	}
+	out.Strategies = make([]StrategyBlock, len(c.Strategies))
+	for i, b := range c.Strategies {
+		b.Games = slices.Clone(b.Games)
+		for j, g := range b.Games {
+			b.Games[j].Home, b.Games[j].Away = rename(g.Home), rename(g.Away)
+		}
+		out.Strategies[i] = b
+	}
+	if c.MatchupMatrix != nil {
+		out.MatchupMatrix = make(map[string]map[string]int, len(c.MatchupMatrix))
+		for team, row := range c.MatchupMatrix {
+			renamed := make(map[string]int, len(row))
+			for opponent, n := range row {
+				renamed[rename(opponent)] = n
+			}
+			out.MatchupMatrix[rename(team)] = renamed
+		}
+	}
+	out.Rules.MatchupBlackouts = slices.Clone(c.Rules.MatchupBlackouts)
+	for i, m := range out.Rules.MatchupBlackouts {
+		out.Rules.MatchupBlackouts[i].Home, out.Rules.MatchupBlackouts[i].Away = rename(m.Home), rename(m.Away)
+	}
+	return &out
+}
+
+// Team returns the settings for the named team, or a zero Team with just
+// the name set if the team has no entry under teams.
+func (c *Config) Team(name string) Team {
+	for _, t := range c.Teams {
+		if t.Name == name {
+			return t
+		}
+	}
+	return Team{Name: name}
+}
+
+// HasContacts reports whether any team sets a coach or email.
+func (c *Config) HasContacts() bool {
+	for _, t := range c.Teams {
+		if t.Coach != "" || t.Email != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Ratings returns the rating of each team that sets one.
+func (c *Config) Ratings() map[string]float64 {
+	var ratings map[string]float64
+	for _, t := range c.Teams {
+		if t.Rating != 0 {
+			if ratings == nil {
+				ratings = make(map[string]float64)
+			}
+			ratings[t.Name] = t.Rating
+		}
+	}
+	return ratings
+}
+
+// HomeWeights returns the home_weight of each team that sets one. Teams not
+// in the map have the default weight of 1.
+func (c *Config) HomeWeights() map[string]float64 {
+	var weights map[string]float64
+	for _, t := range c.Teams {
+		if t.HomeWeight != nil {
+			if weights == nil {
+				weights = make(map[string]float64)
+			}
+			weights[t.Name] = *t.HomeWeight
+		}
+	}
+	return weights
+}
+
+// Field returns the named field, or a zero Field with just the name set if
+// no field has that name.
+func (c *Config) Field(name string) Field {
+	for _, f := range c.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return Field{Name: name}
+}
+
 // LoadFromBytes parses YAML bytes into a Config and validates it.
 func LoadFromBytes(data []byte) (*Config, error) {
 	var cfg Config
@@ -131,6 +1180,39 @@ func LoadFromFile(path string) (*Config, error) {
 	return LoadFromBytes(data)
 }
 
+// Warnings returns likely mistakes in a config that loads but may not do
+// what was meant, such as a reservation listing a time no slot starts at on
+// any of its dates, which then blocks nothing.
+func (c *Config) Warnings() []string {
+	var warnings []string
+	for _, f := range c.Fields {
+		for _, r := range f.Reservations {
+			dates := r.Dates()
+			if len(dates) == 0 {
+				continue
+			}
+			for _, t := range r.Times {
+				offered := slices.ContainsFunc(dates, func(d time.Time) bool {
+					return slices.Contains(c.FieldTimesForDay(f.Name, d), t)
+				})
+				if offered {
+					continue
+				}
+				var msg string
+				if len(dates) == 1 {
+					msg = fmt.Sprintf("field %q: reservation on %s lists %s, but that day's slots start at %s; it blocks nothing",
+						f.Name, dates[0].Format("2006-01-02"), t, strings.Join(c.FieldTimesForDay(f.Name, dates[0]), ", "))
+				} else {
+					msg = fmt.Sprintf("field %q: reservation from %s to %s lists %s, but no slot on those dates starts then; it blocks nothing",
+						f.Name, dates[0].Format("2006-01-02"), dates[len(dates)-1].Format("2006-01-02"), t)
+				}
+				warnings = append(warnings, msg)
+			}
+		}
+	}
+	return warnings
+}
+
 func (c *Config) validate() error {
 	if !c.Season.EndDate.Time.After(c.Season.StartDate.Time) {
 		return fmt.Errorf("end date %s must be after start date %s",
@@ -144,6 +1226,49 @@ func (c *Config) validate() error {
 			c.Season.EndDate.Time.Format("2006-01-02"))
 	}
 
+	switch c.Season.OverflowStrategy {
+	case "", OverflowEarliest, OverflowSpread:
+	default:
+		return fmt.Errorf("overflow_strategy must be %q or %q, got %q", OverflowEarliest, OverflowSpread, c.Season.OverflowStrategy)
+	}
+
+	if t := c.Season.TargetEndDate; t != nil &&
+		(t.Time.Before(c.Season.StartDate.Time) || t.Time.After(c.Season.EndDate.Time)) {
+		return fmt.Errorf("target_end_date %s must be between start_date %s and end_date %s",
+			t.Time.Format("2006-01-02"),
+			c.Season.StartDate.Time.Format("2006-01-02"),
+			c.Season.EndDate.Time.Format("2006-01-02"))
+	}
+
+	if c.Season.TimeZone != "" {
+		if _, err := time.LoadLocation(c.Season.TimeZone); err != nil {
+			return fmt.Errorf("time_zone %q is not a known IANA time zone", c.Season.TimeZone)
+		}
+	}
+
+	if c.Rules.MaxHomeGamesPerWeek < 0 {
+		return fmt.Errorf("rules: max_home_games_per_week must be 0 or more, got %d", c.Rules.MaxHomeGamesPerWeek)
+	}
+	if c.Rules.MaxGamesPerDate < 0 {
+		return fmt.Errorf("rules: max_games_per_date must be 0 or more, got %d", c.Rules.MaxGamesPerDate)
+	}
+	if h := c.Rules.RequireSlotHeadroom; h != 0 && h < 1 {
+		return fmt.Errorf("rules: require_slot_headroom must be 0 (off) or at least 1, got %g", h)
+	}
+
+	if c.Season.WeekdayStartOffset < 0 {
+		return fmt.Errorf("weekday_start_offset must be 0 or more, got %d", c.Season.WeekdayStartOffset)
+	}
+
+	if r := c.Season.ReserveOpenSlots; r != nil {
+		if r.Count <= 0 {
+			return fmt.Errorf("reserve_open_slots: count must be positive, got %d", r.Count)
+		}
+		if r.Per != "saturday" && r.Per != "week" {
+			return fmt.Errorf("reserve_open_slots: per must be saturday or week, got %q", r.Per)
+		}
+	}
+
 	if len(c.Divisions) == 0 {
 		return fmt.Errorf("at least one division is required")
 	}
@@ -166,6 +1291,301 @@ func (c *Config) validate() error {
 		}
 	}
 
+	// Per-team settings must refer to known teams
+	configured := make(map[string]bool)
+	for _, t := range c.Teams {
+		if _, ok := seen[t.Name]; !ok {
+			return fmt.Errorf("teams: %q is not in any division", t.Name)
+		}
+		if configured[t.Name] {
+			return fmt.Errorf("teams: %q is listed more than once", t.Name)
+		}
+		configured[t.Name] = true
+	}
+
+	seeded := make(map[string]bool)
+	for _, team := range c.Playoffs.Seeds {
+		if _, ok := seen[team]; !ok {
+			return fmt.Errorf("playoffs: seed %q is not in any division", team)
+		}
+		if seeded[team] {
+			return fmt.Errorf("playoffs: %q is seeded more than once", team)
+		}
+		seeded[team] = true
+	}
+
+	if c.Strategy != "" && len(c.Strategies) > 0 {
+		return fmt.Errorf("set strategy or strategies, not both")
+	}
+	if c.Strategy == "fixed" {
+		return fmt.Errorf("the fixed strategy lists its games under strategies")
+	}
+	for i, b := range c.Strategies {
+		if b.Strategy == "" {
+			return fmt.Errorf("strategies: entry %d needs a strategy", i+1)
+		}
+		if b.Strategy != "fixed" {
+			if len(b.Games) > 0 {
+				return fmt.Errorf("strategies: games only apply to the fixed strategy, not %q", b.Strategy)
+			}
+			continue
+		}
+		if len(b.Games) == 0 {
+			return fmt.Errorf("strategies: the fixed strategy needs at least one game")
+		}
+		for _, g := range b.Games {
+			for _, team := range []string{g.Home, g.Away} {
+				if _, ok := seen[team]; !ok {
+					return fmt.Errorf("strategies: fixed game team %q is not in any division", team)
+				}
+			}
+			if g.Home == g.Away {
+				return fmt.Errorf("strategies: %s can't play itself", g.Home)
+			}
+		}
+	}
+
+	if c.usesStrategy("banded") {
+		if err := c.Banded.validate(len(seen)); err != nil {
+			return err
+		}
+	}
+	if c.usesStrategy("matrix") {
+		if err := c.validateMatrix(); err != nil {
+			return err
+		}
+	}
+
+	for _, og := range c.Guidelines.OpponentGroups {
+		if og.Name == "" {
+			return fmt.Errorf("opponent_groups: every group needs a name")
+		}
+		for _, team := range og.Teams {
+			if _, ok := seen[team]; !ok {
+				return fmt.Errorf("opponent group %q: %q is not in any division", og.Name, team)
+			}
+		}
+	}
+
+	for _, link := range c.Guidelines.FamilyLinks {
+		if len(link) < 2 {
+			return fmt.Errorf("family_links: each link needs at least two teams, got %v", link)
+		}
+		for i, team := range link {
+			if _, ok := seen[team]; !ok {
+				return fmt.Errorf("family_links: %q is not in any division", team)
+			}
+			if slices.Contains(link[:i], team) {
+				return fmt.Errorf("family_links: %q appears twice in %v", team, link)
+			}
+		}
+	}
+
+	for _, m := range c.Rules.MatchupBlackouts {
+		for _, team := range []string{m.Home, m.Away} {
+			if _, ok := seen[team]; !ok && team != AnyOpponent {
+				return fmt.Errorf("matchup_blackouts: %q is not in any division", team)
+			}
+		}
+		if m.Home == AnyOpponent && m.Away == AnyOpponent {
+			return fmt.Errorf("matchup_blackouts: %q vs %q needs at least one team", AnyOpponent, AnyOpponent)
+		}
+		if m.Home == m.Away {
+			return fmt.Errorf("matchup_blackouts: %q can't be blacked out against itself", m.Home)
+		}
+		if m.Date.Time.IsZero() {
+			return fmt.Errorf("matchup_blackouts: %s vs %s needs a date", m.Home, m.Away)
+		}
+	}
+
+	for _, h := range c.TimeSlots.HolidayDates {
+		switch h.Template() {
+		case "saturday", "sunday", "weekday":
+		default:
+			return fmt.Errorf("holiday %s: 'as' must be saturday, sunday, or weekday, got %q",
+				h.Date.Time.Format("2006-01-02"), h.As)
+		}
+	}
+
+	fieldNames := make(map[string]bool)
+	for _, f := range c.Fields {
+		fieldNames[f.Name] = true
+	}
+	for _, name := range c.Guidelines.FieldPriority {
+		if !fieldNames[name] {
+			return fmt.Errorf("field_priority: unknown field %q", name)
+		}
+	}
+	grouped := make(map[string]bool)
+	for _, group := range c.Rules.SamePhysicalLocation {
+		if len(group) < 2 {
+			return fmt.Errorf("same_physical_location: %v needs at least two fields", group)
+		}
+		for _, name := range group {
+			if !fieldNames[name] {
+				return fmt.Errorf("same_physical_location: unknown field %q", name)
+			}
+			if grouped[name] {
+				return fmt.Errorf("same_physical_location: %q is in more than one group", name)
+			}
+			grouped[name] = true
+		}
+	}
+	for _, div := range c.Divisions {
+		if d := div.MinDaysBetweenSameMatchup; d != nil && *d < 0 {
+			return fmt.Errorf("division %q: min_days_between_same_matchup must be 0 or more, got %d", div.Name, *d)
+		}
+		for _, name := range div.PreferredFields {
+			if !fieldNames[name] {
+				return fmt.Errorf("division %q: unknown preferred_fields entry %q", div.Name, name)
+			}
+		}
+	}
+	seenDays := make(map[time.Weekday]bool)
+	for _, name := range c.Guidelines.PreferredWeekdayOrder {
+		day, ok := weekdayNames[strings.ToLower(name)]
+		if !ok || day == time.Saturday || day == time.Sunday {
+			return fmt.Errorf("preferred_weekday_order: %q is not a weekday (monday through friday)", name)
+		}
+		if seenDays[day] {
+			return fmt.Errorf("preferred_weekday_order: %q is listed twice", name)
+		}
+		seenDays[day] = true
+	}
+	for _, t := range c.Teams {
+		if t.Rating < 0 {
+			return fmt.Errorf("team %q: rating must be 0 or more, got %g", t.Name, t.Rating)
+		}
+		if t.HomeWeight != nil && *t.HomeWeight <= 0 {
+			return fmt.Errorf("team %q: home_weight must be positive, got %g", t.Name, *t.HomeWeight)
+		}
+		if t.MaxSaturdayGames < 0 {
+			return fmt.Errorf("team %q: max_saturday_games must be 0 or more, got %d", t.Name, t.MaxSaturdayGames)
+		}
+		if t.MaxDistinctFields < 0 {
+			return fmt.Errorf("team %q: max_distinct_fields must be 0 or more, got %d", t.Name, t.MaxDistinctFields)
+		}
+		if t.HomeField != "" && !fieldNames[t.HomeField] {
+			return fmt.Errorf("team %q: unknown home_field %q", t.Name, t.HomeField)
+		}
+		if t.Email != "" && !strings.Contains(t.Email, "@") {
+			return fmt.Errorf("team %q: email %q is not an email address", t.Name, t.Email)
+		}
+		for _, name := range t.ExcludedFields {
+			if !fieldNames[name] {
+				return fmt.Errorf("team %q: unknown excluded_fields entry %q", t.Name, name)
+			}
+			if name == t.HomeField {
+				return fmt.Errorf("team %q: home_field %q is also in excluded_fields", t.Name, name)
+			}
+		}
+	}
+
+	if t := c.Guidelines.SundayBalanceTolerance; t != nil && *t < 0 {
+		return fmt.Errorf("guidelines: sunday_balance_tolerance must be 0 or more, got %d", *t)
+	}
+	if t := c.Guidelines.PaceBalanceTolerance; t != nil && *t < 0 {
+		return fmt.Errorf("guidelines: pace_balance_tolerance must be 0 or more, got %d", *t)
+	}
+
+	if n := c.Guidelines.MaxStrongOpponentStreak; n < 0 {
+		return fmt.Errorf("guidelines: max_strong_opponent_streak must be positive, got %d", n)
+	} else if n > 0 && len(c.Ratings()) == 0 {
+		return fmt.Errorf("guidelines: max_strong_opponent_streak needs a rating on at least one team")
+	}
+
+	if n := c.Guidelines.MaxOpeningAwayStreak; n < 0 {
+		return fmt.Errorf("guidelines: max_opening_away_streak must be positive, got %d", n)
+	} else if n == 0 && c.Rules.EnforceMaxOpeningAwayStreak {
+		return fmt.Errorf("rules: enforce_max_opening_away_streak needs guidelines.max_opening_away_streak")
+	}
+
+	if o := c.Guidelines.Optimize; o != "" && o != OptimizeRematchSpacing {
+		return fmt.Errorf("guidelines: optimize must be %q, got %q", OptimizeRematchSpacing, o)
+	}
+
+	if c.Guidelines.RepairIterations < 0 {
+		return fmt.Errorf("guidelines: repair_iterations must be positive, got %d", c.Guidelines.RepairIterations)
+	}
+
+	cutoffDays := make(map[time.Weekday]bool)
+	for name, latest := range c.TimeSlots.LatestStart {
+		day, ok := weekdayNames[strings.ToLower(name)]
+		if !ok {
+			return fmt.Errorf("time_slots: latest_start: %q is not a day of the week", name)
+		}
+		if cutoffDays[day] {
+			return fmt.Errorf("time_slots: latest_start: %s is listed twice", strings.ToLower(name))
+		}
+		cutoffDays[day] = true
+		if _, err := time.Parse("15:04", latest); err != nil {
+			return fmt.Errorf("time_slots: latest_start for %s %q must be a time like \"18:00\"", name, latest)
+		}
+	}
+
+	labelDays := make(map[time.Weekday]bool)
+	for name, labels := range c.TimeSlots.Labels {
+		day, ok := weekdayNames[strings.ToLower(name)]
+		if !ok {
+			return fmt.Errorf("time_slots: labels: %q is not a day of the week", name)
+		}
+		if labelDays[day] {
+			return fmt.Errorf("time_slots: labels: %s is listed twice", strings.ToLower(name))
+		}
+		labelDays[day] = true
+		for hhmm, label := range labels {
+			if _, err := time.Parse("15:04", hhmm); err != nil {
+				return fmt.Errorf("time_slots: labels for %s: %q must be a time like \"12:30\"", name, hhmm)
+			}
+			if strings.TrimSpace(label) == "" {
+				return fmt.Errorf("time_slots: labels for %s: %s has an empty label", name, hhmm)
+			}
+		}
+	}
+
+	if c.TimeSlots.GameMinutes < 0 {
+		return fmt.Errorf("time_slots: game_minutes must be positive, got %d", c.TimeSlots.GameMinutes)
+	}
+	var names []string
+	for _, f := range c.Fields {
+		names = append(names, f.Name)
+	}
+	for _, f := range c.Fields {
+		if col := FieldColumnName(f.Name, names); slices.Contains(ExtraColumns, col) {
+			return fmt.Errorf("field %q: its master sheet column would be %q, which is reserved for the %s column; rename the field", f.Name, col, col)
+		}
+		if f.MinMinutesBetweenGames < 0 {
+			return fmt.Errorf("field %q: min_minutes_between_games must be positive, got %d", f.Name, f.MinMinutesBetweenGames)
+		}
+		if f.TimeSlots == nil {
+			continue
+		}
+		for _, times := range [][]string{f.TimeSlots.Weekday, f.TimeSlots.Saturday, f.TimeSlots.Sunday} {
+			for _, hhmm := range times {
+				if _, err := time.Parse("15:04", hhmm); err != nil {
+					return fmt.Errorf("field %q: time_slots: %q must be a time like \"09:00\"", f.Name, hhmm)
+				}
+			}
+		}
+	}
+
+	switch c.Output.DayFormat {
+	case "", "short", "long":
+	default:
+		return fmt.Errorf("output: day_format must be short or long, got %q", c.Output.DayFormat)
+	}
+	if _, ok := localDayNames[c.Output.Locale]; c.Output.Locale != "" && !ok {
+		locales := slices.Sorted(maps.Keys(localDayNames))
+		return fmt.Errorf("output: locale must be one of %s, got %q", strings.Join(locales, ", "), c.Output.Locale)
+	}
+
+	if c.Style.FontSize < 0 {
+		return fmt.Errorf("style: font_size must be positive, got %g", c.Style.FontSize)
+	}
+	if c.Style.HeaderFill != "" && !hexColor.MatchString(c.Style.HeaderFill) {
+		return fmt.Errorf("style: header_fill must be a hex color like \"#4472C4\", got %q", c.Style.HeaderFill)
+	}
+
 	// Validate reservations
 	for _, f := range c.Fields {
 		for _, r := range f.Reservations {
@@ -183,6 +1603,14 @@ func (c *Config) validate() error {
 			if hasRange && !r.EndDate.Time.After(r.StartDate.Time) && r.EndDate.Time != r.StartDate.Time {
 				return fmt.Errorf("field %q: reservation end_date must be on or after start_date", f.Name)
 			}
+			for _, b := range []struct{ key, value string }{{"until", r.Until}, {"from", r.From}} {
+				if b.value == "" {
+					continue
+				}
+				if _, err := time.Parse("15:04", b.value); err != nil {
+					return fmt.Errorf("field %q: reservation %s %q must be a time like \"16:00\"", f.Name, b.key, b.value)
+				}
+			}
 		}
 	}
 
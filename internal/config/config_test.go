@@ -1,6 +1,9 @@
 package config
 
 import (
+	"fmt"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 )
@@ -52,6 +55,7 @@ rules:
   max_consecutive_days: 2
   max_games_per_week: 3
   max_games_per_timeslot: 2
+  max_3_in_4_days: true
 
 guidelines:
   min_days_between_same_matchup: 14
@@ -145,6 +149,9 @@ func TestLoadConfig(t *testing.T) {
 		if cfg.Rules.MaxGamesPerTimeslot != 2 {
 			t.Errorf("max games/timeslot = %d, want 2", cfg.Rules.MaxGamesPerTimeslot)
 		}
+		if !cfg.Rules.Max3In4Days {
+			t.Error("max_3_in_4_days should be true")
+		}
 	})
 
 	t.Run("guidelines", func(t *testing.T) {
@@ -186,89 +193,1603 @@ rules:
 		}
 	})
 
-	t.Run("no divisions", func(t *testing.T) {
+	t.Run("target_end_date after end date", func(t *testing.T) {
 		yaml := `
 season:
   start_date: "2026-04-25"
   end_date: "2026-05-31"
-divisions: []
+  target_end_date: "2026-06-02"
+divisions:
+  - name: A
+    teams: [T1, T2]
 fields:
   - name: F1
 time_slots:
   weekday: ["17:45"]
-strategy: division_weighted
-rules:
-  max_games_per_day_per_team: 1
-  max_consecutive_days: 2
-  max_games_per_week: 3
-  max_games_per_timeslot: 2
 `
 		_, err := LoadFromBytes([]byte(yaml))
 		if err == nil {
-			t.Error("expected error for no divisions")
+			t.Error("expected error for target_end_date after end_date")
 		}
 	})
 
-	t.Run("no fields", func(t *testing.T) {
+	t.Run("unknown time_zone", func(t *testing.T) {
 		yaml := `
 season:
   start_date: "2026-04-25"
   end_date: "2026-05-31"
+  time_zone: "America/Springfield"
 divisions:
   - name: A
     teams: [T1, T2]
-fields: []
+fields:
+  - name: F1
 time_slots:
   weekday: ["17:45"]
-strategy: division_weighted
-rules:
-  max_games_per_day_per_team: 1
-  max_consecutive_days: 2
-  max_games_per_week: 3
-  max_games_per_timeslot: 2
 `
 		_, err := LoadFromBytes([]byte(yaml))
 		if err == nil {
-			t.Error("expected error for no fields")
+			t.Error("expected error for unknown time_zone")
 		}
 	})
 
-	t.Run("duplicate team names", func(t *testing.T) {
-		yaml := `
+	t.Run("reserve_open_slots", func(t *testing.T) {
+		base := `
 season:
   start_date: "2026-04-25"
   end_date: "2026-05-31"
+  reserve_open_slots:
+%s
 divisions:
   - name: A
-    teams: [Angels, Astros]
-  - name: B
-    teams: [Angels, Cubs]
+    teams: [T1, T2]
 fields:
   - name: F1
 time_slots:
   weekday: ["17:45"]
-strategy: division_weighted
+`
+		tests := []struct {
+			name    string
+			body    string
+			wantErr bool
+		}{
+			{"per saturday", "    count: 1\n    per: saturday", false},
+			{"per week", "    count: 2\n    per: week", false},
+			{"zero count", "    count: 0\n    per: week", true},
+			{"unknown period", "    count: 1\n    per: month", true},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := LoadFromBytes([]byte(fmt.Sprintf(base, tt.body)))
+				if (err != nil) != tt.wantErr {
+					t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+				}
+			})
+		}
+	})
+
+	t.Run("same_physical_location", func(t *testing.T) {
+		base := `
+season:
+  start_date: "2026-04-25"
+  end_date: "2026-05-31"
+divisions:
+  - name: A
+    teams: [T1, T2]
+fields:
+  - name: F1
+  - name: F2
+  - name: F3
+time_slots:
+  weekday: ["17:45"]
 rules:
-  max_games_per_day_per_team: 1
-  max_consecutive_days: 2
-  max_games_per_week: 3
-  max_games_per_timeslot: 2
+  same_physical_location: %s
 `
-		_, err := LoadFromBytes([]byte(yaml))
-		if err == nil {
-			t.Error("expected error for duplicate team name")
+		for _, tt := range []struct {
+			body    string
+			wantErr bool
+		}{
+			{"[[F1, F2]]", false},
+			{"[[F1]]", true},
+			{"[[F1, F9]]", true},
+			{"[[F1, F2], [F2, F3]]", true},
+		} {
+			t.Run(tt.body, func(t *testing.T) {
+				cfg, err := LoadFromBytes([]byte(fmt.Sprintf(base, tt.body)))
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if err == nil && !slices.Equal(cfg.Rules.SharedLocation("F1"), []string{"F2"}) {
+					t.Errorf("SharedLocation(F1) = %v, want [F2]", cfg.Rules.SharedLocation("F1"))
+				}
+			})
 		}
 	})
-}
 
-func TestAllTeams(t *testing.T) {
-	cfg, err := LoadFromBytes([]byte(testConfigYAML))
-	if err != nil {
-		t.Fatalf("unexpected error: %v", err)
-	}
+	t.Run("preferred_fields", func(t *testing.T) {
+		base := `
+season:
+  start_date: "2026-04-25"
+  end_date: "2026-05-31"
+divisions:
+  - name: A
+    teams: [T1, T2]
+    preferred_fields: [%s]
+fields:
+  - name: F1
+  - name: F2
+time_slots:
+  weekday: ["17:45"]
+`
+		for _, tt := range []struct {
+			body    string
+			wantErr bool
+		}{
+			{"F1", false},
+			{"F1, F2", false},
+			{"F9", true},
+		} {
+			t.Run(tt.body, func(t *testing.T) {
+				_, err := LoadFromBytes([]byte(fmt.Sprintf(base, tt.body)))
+				if (err != nil) != tt.wantErr {
+					t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+				}
+			})
+		}
+	})
 
-	teams := cfg.AllTeams()
-	if len(teams) != 10 {
-		t.Errorf("AllTeams() = %d teams, want 10", len(teams))
+	t.Run("division min_days_between_same_matchup", func(t *testing.T) {
+		body := "season:\n  start_date: \"2026-04-25\"\n  end_date: \"2026-05-31\"\n" +
+			"divisions:\n  - name: A\n    teams: [T1, T2]\n    min_days_between_same_matchup: %d\n" +
+			"fields:\n  - name: F1\ntime_slots:\n  weekday: [\"17:45\"]\n"
+		cfg, err := LoadFromBytes([]byte(fmt.Sprintf(body, 21)))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := cfg.RematchDays("T1", "T2"); got != 21 {
+			t.Errorf("RematchDays = %d, want 21", got)
+		}
+		if _, err := LoadFromBytes([]byte(fmt.Sprintf(body, -1))); err == nil {
+			t.Error("expected error for a negative override")
+		}
+	})
+
+	t.Run("require_slot_headroom", func(t *testing.T) {
+		base := `
+season:
+  start_date: "2026-04-25"
+  end_date: "2026-05-31"
+divisions:
+  - name: A
+    teams: [T1, T2]
+fields:
+  - name: F1
+time_slots:
+  weekday: ["17:45"]
+rules:
+  require_slot_headroom: %s
+`
+		for _, tt := range []struct {
+			body    string
+			wantErr bool
+		}{
+			{"0", false},
+			{"1", false},
+			{"1.1", false},
+			{"0.9", true},
+			{"-1", true},
+		} {
+			t.Run(tt.body, func(t *testing.T) {
+				_, err := LoadFromBytes([]byte(fmt.Sprintf(base, tt.body)))
+				if (err != nil) != tt.wantErr {
+					t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+				}
+			})
+		}
+	})
+
+	t.Run("banded", func(t *testing.T) {
+		base := `
+season:
+  start_date: "2026-04-25"
+  end_date: "2026-05-31"
+divisions:
+  - name: A
+    teams: [T1, T2, T3]
+fields:
+  - name: F1
+time_slots:
+  weekday: ["17:45"]
+strategy: banded
+banded:
+%s
+`
+		tests := []struct {
+			name    string
+			body    string
+			wantErr bool
+		}{
+			{"band", "  min_games_per_team: 3\n  max_games_per_team: 4", false},
+			{"exact even total", "  min_games_per_team: 2", false},
+			{"missing min", "  max_games_per_team: 4", true},
+			{"max below min", "  min_games_per_team: 4\n  max_games_per_team: 3", true},
+			{"exact odd total", "  min_games_per_team: 3", true},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := LoadFromBytes([]byte(fmt.Sprintf(base, tt.body)))
+				if (err != nil) != tt.wantErr {
+					t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+				}
+			})
+		}
+	})
+
+	t.Run("matchup_matrix", func(t *testing.T) {
+		base := `
+season:
+  start_date: "2026-04-25"
+  end_date: "2026-05-31"
+divisions:
+  - name: A
+    teams: [T1, T2, T3]
+fields:
+  - name: F1
+time_slots:
+  weekday: ["17:45"]
+strategy: matrix
+%s
+`
+		tests := []struct {
+			name    string
+			body    string
+			wantErr bool
+		}{
+			{"each pair listed once", "matchup_matrix:\n  T1: {T2: 2, T3: 1}\n  T2: {T3: 3}", false},
+			{"pair listed both ways", "matchup_matrix:\n  T1: {T2: 2, T3: 1}\n  T2: {T1: 2, T3: 3}", false},
+			{"missing", "", true},
+			{"asymmetric", "matchup_matrix:\n  T1: {T2: 2, T3: 1}\n  T2: {T1: 1, T3: 3}", true},
+			{"unknown team", "matchup_matrix:\n  T1: {T2: 2, T3: 1, T9: 1}", true},
+			{"team plays itself", "matchup_matrix:\n  T1: {T1: 1, T2: 2, T3: 1}", true},
+			{"negative count", "matchup_matrix:\n  T1: {T2: -1, T3: 1}\n  T2: {T3: 1}", true},
+			{"team left out", "matchup_matrix:\n  T1: {T2: 2}", true},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := LoadFromBytes([]byte(fmt.Sprintf(base, tt.body)))
+				if (err != nil) != tt.wantErr {
+					t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+				}
+			})
+		}
+	})
+
+	t.Run("weekday_start_offset", func(t *testing.T) {
+		base := `
+season:
+  start_date: "2026-04-25"
+  end_date: "2026-05-31"
+  weekday_start_offset: %d
+divisions:
+  - name: A
+    teams: [T1, T2]
+fields:
+  - name: F1
+time_slots:
+  weekday: ["17:45"]
+`
+		cfg, err := LoadFromBytes([]byte(fmt.Sprintf(base, 9)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := cfg.Season.WeekdaysStart(), mustDate("2026-05-04"); !got.Equal(want) {
+			t.Errorf("WeekdaysStart() = %s, want %s", got.Format("2006-01-02"), want.Format("2006-01-02"))
+		}
+		if _, err := LoadFromBytes([]byte(fmt.Sprintf(base, -1))); err == nil || !strings.Contains(err.Error(), "weekday_start_offset") {
+			t.Errorf("error = %v, want a weekday_start_offset error", err)
+		}
+	})
+
+	t.Run("optimize", func(t *testing.T) {
+		base := `
+season:
+  start_date: "2026-04-25"
+  end_date: "2026-05-31"
+divisions:
+  - name: A
+    teams: [T1, T2]
+fields:
+  - name: F1
+time_slots:
+  weekday: ["17:45"]
+guidelines:
+  optimize: %s
+`
+		cfg, err := LoadFromBytes([]byte(fmt.Sprintf(base, "rematch-spacing")))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cfg.Guidelines.Optimize != OptimizeRematchSpacing {
+			t.Errorf("optimize = %q, want %q", cfg.Guidelines.Optimize, OptimizeRematchSpacing)
+		}
+		if _, err := LoadFromBytes([]byte(fmt.Sprintf(base, "pace"))); err == nil || !strings.Contains(err.Error(), `optimize must be "rematch-spacing", got "pace"`) {
+			t.Errorf("error = %v, want an unknown optimize error", err)
+		}
+	})
+
+	t.Run("strategies", func(t *testing.T) {
+		base := `
+season:
+  start_date: "2026-04-25"
+  end_date: "2026-05-31"
+divisions:
+  - name: A
+    teams: [T1, T2]
+fields:
+  - name: F1
+time_slots:
+  weekday: ["17:45"]
+%s
+`
+		cfg, err := LoadFromBytes([]byte(fmt.Sprintf(base, `strategies:
+  - strategy: division_weighted
+  - strategy: fixed
+    allow_repeats: true
+    games:
+      - {home: T2, away: T1, weekend_only: true}`)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := cfg.StrategyName(); got != "division_weighted + fixed" {
+			t.Errorf("StrategyName() = %q", got)
+		}
+		if b := cfg.Strategies[1]; !b.AllowRepeats || len(b.Games) != 1 || b.Games[0] != (FixedGame{Home: "T2", Away: "T1", WeekendOnly: true}) {
+			t.Errorf("fixed block = %+v", b)
+		}
+
+		errs := []struct{ yaml, want string }{
+			{"strategy: division_weighted\nstrategies:\n  - strategy: banded", "set strategy or strategies, not both"},
+			{"strategy: fixed", "the fixed strategy lists its games under strategies"},
+			{"strategies:\n  - strategy: fixed", "the fixed strategy needs at least one game"},
+			{"strategies:\n  - strategy: fixed\n    games: [{home: T1, away: T9}]", `fixed game team "T9" is not in any division`},
+			{"strategies:\n  - strategy: division_weighted\n    games: [{home: T1, away: T2}]", `games only apply to the fixed strategy`},
+		}
+		for _, tt := range errs {
+			if _, err := LoadFromBytes([]byte(fmt.Sprintf(base, tt.yaml))); err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("%q: error = %v, want %q", tt.yaml, err, tt.want)
+			}
+		}
+	})
+
+	t.Run("overflow_strategy", func(t *testing.T) {
+		base := `
+season:
+  start_date: "2026-04-25"
+  end_date: "2026-05-31"
+  overflow_end_date: "2026-06-07"
+  overflow_strategy: %s
+divisions:
+  - name: A
+    teams: [T1, T2]
+fields:
+  - name: F1
+time_slots:
+  weekday: ["17:45"]
+`
+		cfg, err := LoadFromBytes([]byte(fmt.Sprintf(base, "spread")))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cfg.Season.OverflowStrategy != OverflowSpread {
+			t.Errorf("overflow_strategy = %q, want %q", cfg.Season.OverflowStrategy, OverflowSpread)
+		}
+		if _, err := LoadFromBytes([]byte(fmt.Sprintf(base, "latest"))); err == nil || !strings.Contains(err.Error(), `overflow_strategy must be "earliest" or "spread", got "latest"`) {
+			t.Errorf("error = %v, want an unknown overflow_strategy error", err)
+		}
+	})
+
+	t.Run("preferred_weekday_order", func(t *testing.T) {
+		base := `
+season:
+  start_date: "2026-04-25"
+  end_date: "2026-05-31"
+divisions:
+  - name: A
+    teams: [T1, T2]
+fields:
+  - name: F1
+time_slots:
+  weekday: ["17:45"]
+guidelines:
+  preferred_weekday_order: %s
+`
+		cfg, err := LoadFromBytes([]byte(fmt.Sprintf(base, "[Friday, thursday]")))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for day, want := range map[time.Weekday]int{time.Friday: 0, time.Thursday: 1, time.Monday: 2} {
+			if got := cfg.Guidelines.WeekdayRank(day); got != want {
+				t.Errorf("WeekdayRank(%s) = %d, want %d", day, got, want)
+			}
+		}
+
+		for _, tt := range []struct{ name, body, want string }{
+			{"weekend day", "[friday, saturday]", `"saturday" is not a weekday`},
+			{"typo", "[fri]", `"fri" is not a weekday`},
+			{"repeated day", "[friday, Friday]", `"Friday" is listed twice`},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := LoadFromBytes([]byte(fmt.Sprintf(base, tt.body)))
+				if err == nil || !strings.Contains(err.Error(), tt.want) {
+					t.Errorf("error = %v, want %q", err, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("family_links", func(t *testing.T) {
+		base := `
+season:
+  start_date: "2026-04-25"
+  end_date: "2026-05-31"
+divisions:
+  - name: A
+    teams: [T1, T2, T3, T4]
+fields:
+  - name: F1
+time_slots:
+  weekday: ["17:45"]
+guidelines:
+  family_links:
+%s
+`
+		cfg, err := LoadFromBytes([]byte(fmt.Sprintf(base, "    - [T1, T2]\n    - [T1, T3]")))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := cfg.Guidelines.LinkedTeams("T1"); !slices.Equal(got, []string{"T2", "T3"}) {
+			t.Errorf("LinkedTeams(T1) = %v, want [T2 T3]", got)
+		}
+		if got := cfg.Guidelines.LinkedTeams("T4"); got != nil {
+			t.Errorf("LinkedTeams(T4) = %v, want none", got)
+		}
+
+		for _, tt := range []struct{ name, body, want string }{
+			{"one team", "    - [T1]", "at least two teams"},
+			{"unknown team", "    - [T1, T9]", `"T9" is not in any division`},
+			{"repeated team", "    - [T1, T1]", `"T1" appears twice`},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := LoadFromBytes([]byte(fmt.Sprintf(base, tt.body)))
+				if err == nil || !strings.Contains(err.Error(), tt.want) {
+					t.Errorf("error = %v, want %q", err, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("matchup_blackouts", func(t *testing.T) {
+		base := `
+season:
+  start_date: "2026-04-25"
+  end_date: "2026-05-31"
+divisions:
+  - name: A
+    teams: [T1, T2, T3]
+fields:
+  - name: F1
+time_slots:
+  weekday: ["17:45"]
+rules:
+  matchup_blackouts:
+%s
+`
+		cfg, err := LoadFromBytes([]byte(fmt.Sprintf(base, `    - {home: T1, away: T2, date: "2026-05-05", reason: shared coach}`)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := cfg.Rules.MatchupBlackouts; len(got) != 1 || got[0].Reason != "shared coach" || got[0].Date.Time.Day() != 5 {
+			t.Errorf("MatchupBlackouts = %+v", got)
+		}
+		if _, err := LoadFromBytes([]byte(fmt.Sprintf(base, `    - {home: any, away: T2, date: "2026-05-05"}`))); err != nil {
+			t.Errorf("any opponent: unexpected error: %v", err)
+		}
+
+		for _, tt := range []struct{ name, body, want string }{
+			{"unknown team", `    - {home: T1, away: T9, date: "2026-05-05"}`, `"T9" is not in any division`},
+			{"same team", `    - {home: T1, away: T1, date: "2026-05-05"}`, "against itself"},
+			{"no date", `    - {home: T1, away: T2}`, "needs a date"},
+			{"any against any", `    - {home: any, away: any, date: "2026-05-05"}`, "needs at least one team"},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := LoadFromBytes([]byte(fmt.Sprintf(base, tt.body)))
+				if err == nil || !strings.Contains(err.Error(), tt.want) {
+					t.Errorf("error = %v, want %q", err, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("balance tolerances", func(t *testing.T) {
+		base := `
+season:
+  start_date: "2026-04-25"
+  end_date: "2026-05-31"
+divisions:
+  - name: A
+    teams: [T1, T2]
+fields:
+  - name: F1
+time_slots:
+  weekday: ["17:45"]
+strategy: division_weighted
+guidelines:
+%s
+`
+		tests := []struct {
+			name       string
+			body       string
+			wantSunday int
+			wantPace   int
+			paceSet    bool
+			wantErr    bool
+		}{
+			{"defaults", "  balance_pace: true", 1, 0, false, false},
+			{"zero", "  sunday_balance_tolerance: 0\n  pace_balance_tolerance: 0", 0, 0, true, false},
+			{"set", "  sunday_balance_tolerance: 2\n  pace_balance_tolerance: 3", 2, 3, true, false},
+			{"negative sunday", "  sunday_balance_tolerance: -1", 0, 0, false, true},
+			{"negative pace", "  pace_balance_tolerance: -1", 0, 0, false, true},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				cfg, err := LoadFromBytes([]byte(fmt.Sprintf(base, tt.body)))
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if err != nil {
+					return
+				}
+				if got := cfg.Guidelines.SundayTolerance(); got != tt.wantSunday {
+					t.Errorf("SundayTolerance() = %d, want %d", got, tt.wantSunday)
+				}
+				if got, ok := cfg.Guidelines.PaceTolerance(); got != tt.wantPace || ok != tt.paceSet {
+					t.Errorf("PaceTolerance() = %d, %v; want %d, %v", got, ok, tt.wantPace, tt.paceSet)
+				}
+			})
+		}
+	})
+
+	t.Run("no divisions", func(t *testing.T) {
+		yaml := `
+season:
+  start_date: "2026-04-25"
+  end_date: "2026-05-31"
+divisions: []
+fields:
+  - name: F1
+time_slots:
+  weekday: ["17:45"]
+strategy: division_weighted
+rules:
+  max_games_per_day_per_team: 1
+  max_consecutive_days: 2
+  max_games_per_week: 3
+  max_games_per_timeslot: 2
+`
+		_, err := LoadFromBytes([]byte(yaml))
+		if err == nil {
+			t.Error("expected error for no divisions")
+		}
+	})
+
+	t.Run("no fields", func(t *testing.T) {
+		yaml := `
+season:
+  start_date: "2026-04-25"
+  end_date: "2026-05-31"
+divisions:
+  - name: A
+    teams: [T1, T2]
+fields: []
+time_slots:
+  weekday: ["17:45"]
+strategy: division_weighted
+rules:
+  max_games_per_day_per_team: 1
+  max_consecutive_days: 2
+  max_games_per_week: 3
+  max_games_per_timeslot: 2
+`
+		_, err := LoadFromBytes([]byte(yaml))
+		if err == nil {
+			t.Error("expected error for no fields")
+		}
+	})
+
+	t.Run("duplicate team names", func(t *testing.T) {
+		yaml := `
+season:
+  start_date: "2026-04-25"
+  end_date: "2026-05-31"
+divisions:
+  - name: A
+    teams: [Angels, Astros]
+  - name: B
+    teams: [Angels, Cubs]
+fields:
+  - name: F1
+time_slots:
+  weekday: ["17:45"]
+strategy: division_weighted
+rules:
+  max_games_per_day_per_team: 1
+  max_consecutive_days: 2
+  max_games_per_week: 3
+  max_games_per_timeslot: 2
+`
+		_, err := LoadFromBytes([]byte(yaml))
+		if err == nil {
+			t.Error("expected error for duplicate team name")
+		}
+	})
+}
+
+func TestMatchupBlackoutMatches(t *testing.T) {
+	date := time.Date(2026, 5, 5, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name               string
+		home, away         string
+		gameHome, gameAway string
+		date               time.Time
+		want               bool
+	}{
+		{"same pairing", "Cubs", "Angels", "Cubs", "Angels", date, true},
+		{"either way round", "Cubs", "Angels", "Angels", "Cubs", date, true},
+		{"other opponent", "Cubs", "Angels", "Cubs", "Padres", date, false},
+		{"other date", "Cubs", "Angels", "Cubs", "Angels", date.AddDate(0, 0, 1), false},
+		{"any opponent at home", "Cubs", AnyOpponent, "Cubs", "Padres", date, true},
+		{"any opponent away", "Cubs", AnyOpponent, "Padres", "Cubs", date, true},
+		{"any home side", AnyOpponent, "Cubs", "Angels", "Cubs", date, true},
+		{"any leaves other teams alone", "Cubs", AnyOpponent, "Angels", "Padres", date, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := MatchupBlackout{Home: tt.home, Away: tt.away, Date: Date{Time: date}}
+			if got := m.Matches(tt.gameHome, tt.gameAway, tt.date); got != tt.want {
+				t.Errorf("Matches(%s, %s) = %v, want %v", tt.gameHome, tt.gameAway, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTeamSettings(t *testing.T) {
+	base := `
+season:
+  start_date: "2026-04-25"
+  end_date: "2026-05-31"
+divisions:
+  - name: A
+    teams: [Angels, Astros]
+fields:
+  - name: F1
+time_slots:
+  weekday: ["17:45"]
+`
+	t.Run("preferred off dates parsed", func(t *testing.T) {
+		cfg, err := LoadFromBytes([]byte(base + `
+teams:
+  - name: Angels
+    preferred_off_dates: ["2026-05-02", "2026-05-03"]
+`))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		team := cfg.Team("Angels")
+		if len(team.PreferredOffDates) != 2 {
+			t.Fatalf("preferred off dates = %d, want 2", len(team.PreferredOffDates))
+		}
+		if team.PreferredOffDates[0].Time != mustDate("2026-05-02") {
+			t.Errorf("first off date = %v, want 2026-05-02", team.PreferredOffDates[0].Time)
+		}
+		if other := cfg.Team("Astros"); other.Name != "Astros" || len(other.PreferredOffDates) != 0 {
+			t.Errorf("Team(Astros) = %+v, want zero settings", other)
+		}
+	})
+
+	t.Run("unknown team rejected", func(t *testing.T) {
+		_, err := LoadFromBytes([]byte(base + `
+teams:
+  - name: Cubs
+`))
+		if err == nil {
+			t.Error("expected error for team not in any division")
+		}
+	})
+
+	t.Run("duplicate team entry rejected", func(t *testing.T) {
+		_, err := LoadFromBytes([]byte(base + `
+teams:
+  - name: Angels
+  - name: Angels
+`))
+		if err == nil {
+			t.Error("expected error for duplicate team entry")
+		}
+	})
+
+	t.Run("home field parsed", func(t *testing.T) {
+		cfg, err := LoadFromBytes([]byte(base + `
+teams:
+  - name: Angels
+    home_field: F1
+`))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := cfg.Team("Angels").HomeField; got != "F1" {
+			t.Errorf("home_field = %q, want F1", got)
+		}
+	})
+
+	t.Run("unknown home field rejected", func(t *testing.T) {
+		_, err := LoadFromBytes([]byte(base + `
+teams:
+  - name: Angels
+    home_field: F9
+`))
+		if err == nil {
+			t.Error("expected error for unknown home_field")
+		}
+	})
+
+	t.Run("excluded fields", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			team    string
+			wantErr bool
+		}{
+			{"known field", "    excluded_fields: [F1]", false},
+			{"unknown field", "    excluded_fields: [F9]", true},
+			{"home field excluded", "    home_field: F1\n    excluded_fields: [F1]", true},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				cfg, err := LoadFromBytes([]byte(base + "teams:\n  - name: Angels\n" + tt.team + "\n"))
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if err == nil && !slices.Equal(cfg.Team("Angels").ExcludedFields, []string{"F1"}) {
+					t.Errorf("excluded_fields = %v, want [F1]", cfg.Team("Angels").ExcludedFields)
+				}
+			})
+		}
+	})
+
+	t.Run("max saturday games", func(t *testing.T) {
+		cfg, err := LoadFromBytes([]byte(base + "teams:\n  - name: Angels\n    max_saturday_games: 3\n"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := cfg.Team("Angels").MaxSaturdayGames; got != 3 {
+			t.Errorf("max_saturday_games = %d, want 3", got)
+		}
+		if _, err := LoadFromBytes([]byte(base + "teams:\n  - name: Angels\n    max_saturday_games: -1\n")); err == nil {
+			t.Error("expected error for negative max_saturday_games")
+		}
+	})
+
+	t.Run("max distinct fields", func(t *testing.T) {
+		cfg, err := LoadFromBytes([]byte(base + "rules:\n  enforce_max_distinct_fields: true\nteams:\n  - name: Angels\n    max_distinct_fields: 2\n"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := cfg.Team("Angels").MaxDistinctFields; got != 2 || !cfg.Rules.EnforceMaxDistinctFields {
+			t.Errorf("max_distinct_fields = %d, enforce = %v; want 2, true", got, cfg.Rules.EnforceMaxDistinctFields)
+		}
+		if _, err := LoadFromBytes([]byte(base + "teams:\n  - name: Angels\n    max_distinct_fields: -1\n")); err == nil {
+			t.Error("expected error for negative max_distinct_fields")
+		}
+	})
+
+	t.Run("max opening away streak", func(t *testing.T) {
+		cfg, err := LoadFromBytes([]byte(base + "rules:\n  enforce_max_opening_away_streak: true\nguidelines:\n  max_opening_away_streak: 2\n"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := cfg.Guidelines.MaxOpeningAwayStreak; got != 2 || !cfg.Rules.EnforceMaxOpeningAwayStreak {
+			t.Errorf("max_opening_away_streak = %d, enforce = %v; want 2, true", got, cfg.Rules.EnforceMaxOpeningAwayStreak)
+		}
+		if _, err := LoadFromBytes([]byte(base + "guidelines:\n  max_opening_away_streak: -1\n")); err == nil {
+			t.Error("expected error for negative max_opening_away_streak")
+		}
+		if _, err := LoadFromBytes([]byte(base + "rules:\n  enforce_max_opening_away_streak: true\n")); err == nil {
+			t.Error("expected error for enforce_max_opening_away_streak without a cap")
+		}
+	})
+
+	t.Run("home weight", func(t *testing.T) {
+		cfg, err := LoadFromBytes([]byte(base + "teams:\n  - name: Angels\n    home_weight: 2\n  - name: Astros\n"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := cfg.HomeWeights(); len(got) != 1 || got["Angels"] != 2 {
+			t.Errorf("HomeWeights() = %v, want Angels 2 only", got)
+		}
+		for _, w := range []string{"0", "-1"} {
+			if _, err := LoadFromBytes([]byte(base + "teams:\n  - name: Angels\n    home_weight: " + w + "\n")); err == nil {
+				t.Errorf("expected error for home_weight %s", w)
+			}
+		}
+	})
+
+	t.Run("contacts", func(t *testing.T) {
+		cfg, err := LoadFromBytes([]byte(base + `
+teams:
+  - name: Angels
+    coach: Pat Doyle
+    email: pat@example.com
+`))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if team := cfg.Team("Angels"); team.Coach != "Pat Doyle" || team.Email != "pat@example.com" {
+			t.Errorf("contact = %q <%s>, want Pat Doyle <pat@example.com>", team.Coach, team.Email)
+		}
+		if !cfg.HasContacts() {
+			t.Error("HasContacts() = false, want true")
+		}
+
+		_, err = LoadFromBytes([]byte(base + `
+teams:
+  - name: Angels
+    email: pat.example.com
+`))
+		if err == nil {
+			t.Error("expected error for an email without @")
+		}
+	})
+
+	t.Run("ratings parsed", func(t *testing.T) {
+		cfg, err := LoadFromBytes([]byte(base + `
+teams:
+  - name: Angels
+    rating: 7.5
+guidelines:
+  max_strong_opponent_streak: 2
+`))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := cfg.Ratings(); len(got) != 1 || got["Angels"] != 7.5 {
+			t.Errorf("Ratings() = %v, want Angels 7.5 only", got)
+		}
+	})
+
+	t.Run("max_strong_opponent_streak without ratings rejected", func(t *testing.T) {
+		_, err := LoadFromBytes([]byte(base + `
+guidelines:
+  max_strong_opponent_streak: 2
+`))
+		if err == nil {
+			t.Error("expected error for max_strong_opponent_streak with no team ratings")
+		}
+	})
+}
+
+func TestExtraColumnFieldNames(t *testing.T) {
+	base := `
+season:
+  start_date: "2026-04-25"
+  end_date: "2026-05-31"
+divisions:
+  - name: A
+    teams: [Angels, Astros]
+time_slots:
+  weekday: ["17:45"]
+fields:
+`
+	tests := []struct {
+		name    string
+		fields  string
+		wantErr string
+	}{
+		{"field named Slot", "  - name: Slot\n", `field "Slot": its master sheet column would be "Slot"`},
+		{"first word Round", "  - name: Round Pond\n  - name: Symonds Field\n", `field "Round Pond": its master sheet column would be "Round"`},
+		{"shared first word keeps full names", "  - name: Slot A\n  - name: Slot B\n", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadFromBytes([]byte(base + tt.fields))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFieldPriority(t *testing.T) {
+	base := `
+season:
+  start_date: "2026-04-25"
+  end_date: "2026-05-31"
+divisions:
+  - name: A
+    teams: [T1, T2]
+fields:
+  - name: F1
+  - name: F2
+time_slots:
+  weekday: ["17:45"]
+guidelines:
+`
+	t.Run("known fields accepted", func(t *testing.T) {
+		cfg, err := LoadFromBytes([]byte(base + "  field_priority: [F2, F1]\n"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(cfg.Guidelines.FieldPriority) != 2 || cfg.Guidelines.FieldPriority[0] != "F2" {
+			t.Errorf("field_priority = %v, want [F2 F1]", cfg.Guidelines.FieldPriority)
+		}
+	})
+
+	t.Run("unknown field rejected", func(t *testing.T) {
+		if _, err := LoadFromBytes([]byte(base + "  field_priority: [F3]\n")); err == nil {
+			t.Error("expected error for unknown field in field_priority")
+		}
+	})
+}
+
+func TestStyle(t *testing.T) {
+	base := `
+season:
+  start_date: "2026-04-25"
+  end_date: "2026-05-31"
+divisions:
+  - name: A
+    teams: [T1, T2]
+fields:
+  - name: F1
+time_slots:
+  weekday: ["17:45"]
+`
+	t.Run("defaults to Arial 16 with blue headers", func(t *testing.T) {
+		cfg, err := LoadFromBytes([]byte(base))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := cfg.Style.Family(); got != "Arial" {
+			t.Errorf("Family() = %q, want Arial", got)
+		}
+		if got := cfg.Style.Size(); got != 16 {
+			t.Errorf("Size() = %g, want 16", got)
+		}
+		if got := cfg.Style.Fill(); got != "#4472C4" {
+			t.Errorf("Fill() = %q, want #4472C4", got)
+		}
+	})
+
+	t.Run("overrides", func(t *testing.T) {
+		cfg, err := LoadFromBytes([]byte(base + "style:\n  font_family: Calibri\n  font_size: 11\n  header_fill: \"2E7D32\"\n"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := cfg.Style.Family(); got != "Calibri" {
+			t.Errorf("Family() = %q, want Calibri", got)
+		}
+		if got := cfg.Style.Size(); got != 11 {
+			t.Errorf("Size() = %g, want 11", got)
+		}
+		if got := cfg.Style.Fill(); got != "#2E7D32" {
+			t.Errorf("Fill() = %q, want #2E7D32", got)
+		}
+	})
+
+	t.Run("invalid header_fill rejected", func(t *testing.T) {
+		if _, err := LoadFromBytes([]byte(base + "style:\n  header_fill: blue\n")); err == nil {
+			t.Error("expected error for non-hex header_fill")
+		}
+	})
+
+	t.Run("negative font_size rejected", func(t *testing.T) {
+		if _, err := LoadFromBytes([]byte(base + "style:\n  font_size: -1\n")); err == nil {
+			t.Error("expected error for negative font_size")
+		}
+	})
+}
+
+func TestDayName(t *testing.T) {
+	monday := mustDate("2026-05-04")
+	tests := []struct {
+		output Output
+		want   string
+	}{
+		{Output{}, "Mon"},
+		{Output{DayFormat: "long"}, "Monday"},
+		{Output{Locale: "es"}, "lun"},
+		{Output{DayFormat: "long", Locale: "es"}, "lunes"},
+		{Output{DayFormat: "long", Locale: "de"}, "Montag"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.output.DayName(monday); got != tt.want {
+				t.Errorf("DayName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	base := `
+season:
+  start_date: "2026-04-25"
+  end_date: "2026-05-31"
+divisions:
+  - name: A
+    teams: [T1, T2]
+fields:
+  - name: F1
+time_slots:
+  weekday: ["17:45"]
+output:
+`
+	t.Run("unknown day_format rejected", func(t *testing.T) {
+		if _, err := LoadFromBytes([]byte(base + "  day_format: medium\n")); err == nil || !strings.Contains(err.Error(), "day_format must be short or long") {
+			t.Errorf("error = %v, want a day_format error", err)
+		}
+	})
+
+	t.Run("unknown locale rejected", func(t *testing.T) {
+		if _, err := LoadFromBytes([]byte(base + "  locale: xx\n")); err == nil || !strings.Contains(err.Error(), "locale must be one of de, en, es, fr, pt") {
+			t.Errorf("error = %v, want a locale error", err)
+		}
+	})
+}
+
+func TestSeasonLocation(t *testing.T) {
+	t.Run("defaults to local time", func(t *testing.T) {
+		if got := (Season{}).Location(); got != time.Local {
+			t.Errorf("Location() = %v, want Local", got)
+		}
+	})
+
+	t.Run("time_zone", func(t *testing.T) {
+		if got := (Season{TimeZone: "America/New_York"}).Location().String(); got != "America/New_York" {
+			t.Errorf("Location() = %q, want America/New_York", got)
+		}
+	})
+}
+
+func TestReservationBlocks(t *testing.T) {
+	tests := []struct {
+		name string
+		r    Reservation
+		time string
+		want bool
+	}{
+		{"full day", Reservation{}, "12:30", true},
+		{"listed time", Reservation{Times: []string{"17:45"}}, "17:45", true},
+		{"unlisted time", Reservation{Times: []string{"17:45"}}, "12:30", false},
+		{"before until", Reservation{Until: "16:00"}, "14:45", true},
+		{"at until", Reservation{Until: "16:00"}, "16:00", false},
+		{"after until", Reservation{Until: "16:00"}, "17:00", false},
+		{"before from", Reservation{From: "16:00"}, "12:30", false},
+		{"at from", Reservation{From: "16:00"}, "16:00", true},
+		{"single-digit hour", Reservation{Until: "10:00"}, "9:30", true},
+		{"until and from leave a window", Reservation{Until: "12:00", From: "16:00"}, "14:45", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.r.Blocks(tt.time); got != tt.want {
+				t.Errorf("Blocks(%q) = %v, want %v", tt.time, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("until must be a time", func(t *testing.T) {
+		yaml := `
+season:
+  start_date: "2026-04-25"
+  end_date: "2026-05-31"
+divisions:
+  - name: A
+    teams: [T1, T2]
+fields:
+  - name: F1
+    reservations:
+      - date: "2026-05-02"
+        until: "4pm"
+time_slots:
+  weekday: ["17:45"]
+`
+		if _, err := LoadFromBytes([]byte(yaml)); err == nil {
+			t.Error("expected error for until that isn't a time")
+		}
+	})
+}
+
+func TestFieldTooClose(t *testing.T) {
+	f := Field{Name: "Symonds Field", MinMinutesBetweenGames: 30}
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"12:30", "14:45", true},  // 15 minutes after a 2-hour game
+		{"14:45", "12:30", true},  // order doesn't matter
+		{"12:30", "15:00", false}, // exactly 30 minutes
+		{"12:30", "17:00", false},
+		{"12:30", "later", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" and "+tt.b, func(t *testing.T) {
+			if got := f.TooClose(tt.a, tt.b, (TimeSlots{}).GameLength()); got != tt.want {
+				t.Errorf("TooClose(%s, %s) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("no buffer", func(t *testing.T) {
+		if (Field{}).TooClose("12:30", "12:45", time.Hour) {
+			t.Error("a field without min_minutes_between_games is never too close")
+		}
+	})
+
+	t.Run("game_minutes", func(t *testing.T) {
+		if got := (TimeSlots{GameMinutes: 90}).GameLength(); got != 90*time.Minute {
+			t.Errorf("GameLength() = %v, want 1h30m", got)
+		}
+		if f.TooClose("12:30", "14:45", 90*time.Minute) {
+			t.Error("a 90-minute game at 12:30 leaves 45 minutes before 14:45")
+		}
+	})
+}
+
+func TestTimeSlotsOverlap(t *testing.T) {
+	tests := []struct {
+		name    string
+		minutes int
+		a, b    string
+		want    bool
+	}{
+		{"back to back", 0, "12:30", "14:30", false},
+		{"first runs into second", 0, "12:30", "14:00", true},
+		{"order doesn't matter", 0, "14:00", "12:30", true},
+		{"shorter games fit", 90, "12:30", "14:00", false},
+		{"unparseable time", 0, "12:30", "TBD", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := TimeSlots{GameMinutes: tt.minutes}
+			if got := ts.Overlap(tt.a, tt.b); got != tt.want {
+				t.Errorf("Overlap(%s, %s) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAllTeams(t *testing.T) {
+	cfg, err := LoadFromBytes([]byte(testConfigYAML))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	teams := cfg.AllTeams()
+	if len(teams) != 10 {
+		t.Errorf("AllTeams() = %d teams, want 10", len(teams))
+	}
+}
+
+func TestHolidayDates(t *testing.T) {
+	yaml := `
+season:
+  start_date: "2026-04-25"
+  end_date: "2026-05-31"
+divisions:
+  - name: A
+    teams: [T1, T2]
+fields:
+  - name: F1
+time_slots:
+  weekday: ["17:45"]
+  holiday_dates:
+    - "2026-05-04"
+    - date: "2026-05-25"
+      as: saturday
+`
+	cfg, err := LoadFromBytes([]byte(yaml))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	t.Run("bare date defaults to sunday", func(t *testing.T) {
+		h := cfg.TimeSlots.HolidayDates[0]
+		if h.Date.Time != mustDate("2026-05-04") {
+			t.Errorf("date = %v, want 2026-05-04", h.Date.Time)
+		}
+		if h.Template() != "sunday" {
+			t.Errorf("template = %q, want sunday", h.Template())
+		}
+	})
+
+	t.Run("mapping form sets template", func(t *testing.T) {
+		h := cfg.TimeSlots.HolidayDates[1]
+		if h.Date.Time != mustDate("2026-05-25") {
+			t.Errorf("date = %v, want 2026-05-25", h.Date.Time)
+		}
+		if h.Template() != "saturday" {
+			t.Errorf("template = %q, want saturday", h.Template())
+		}
+	})
+
+	t.Run("unknown template rejected", func(t *testing.T) {
+		bad := strings.Replace(yaml, "as: saturday", "as: friday", 1)
+		if _, err := LoadFromBytes([]byte(bad)); err == nil {
+			t.Error("expected error for unknown holiday template")
+		}
+	})
+}
+
+func TestLatestStart(t *testing.T) {
+	yaml := `
+season:
+  start_date: "2026-04-25"
+  end_date: "2026-05-31"
+divisions:
+  - name: A
+    teams: [T1, T2]
+fields:
+  - name: F1
+time_slots:
+  weekday: ["16:00", "17:45", "19:30"]
+  saturday: ["12:30", "17:00", "19:30"]
+  latest_start:
+    monday: "17:45"
+    Thursday: "17:00"
+`
+	cfg, err := LoadFromBytes([]byte(yaml))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		date string
+		want []string
+	}{
+		{"2026-05-04", []string{"16:00", "17:45"}},          // Monday: cutoff itself still starts
+		{"2026-05-05", []string{"16:00", "17:45", "19:30"}}, // Tuesday: no cutoff
+		{"2026-05-07", []string{"16:00"}},                   // Thursday, named in any case
+		{"2026-05-02", []string{"12:30", "17:00", "19:30"}}, // Saturday: no cutoff
+	}
+	for _, tt := range tests {
+		t.Run(tt.date, func(t *testing.T) {
+			if got := cfg.TimesForDay(mustDate(tt.date)); !slices.Equal(got, tt.want) {
+				t.Errorf("TimesForDay = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	errs := []struct{ from, to, want string }{
+		{`monday: "17:45"`, `monday: "6pm"`, `latest_start for monday "6pm" must be a time`},
+		{`monday: "17:45"`, `someday: "17:45"`, `"someday" is not a day of the week`},
+		{`Thursday: "17:00"`, `Monday: "17:00"`, "monday is listed twice"},
+	}
+	for _, tt := range errs {
+		t.Run(tt.want, func(t *testing.T) {
+			bad := strings.Replace(yaml, tt.from, tt.to, 1)
+			if _, err := LoadFromBytes([]byte(bad)); err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestFieldTimeSlots(t *testing.T) {
+	yaml := `
+season:
+  start_date: "2026-04-25"
+  end_date: "2026-05-31"
+divisions:
+  - name: A
+    teams: [T1, T2]
+fields:
+  - name: F1
+  - name: Kids
+    time_slots:
+      weekday: []
+      saturday: ["9:00", "11:00"]
+time_slots:
+  weekday: ["17:45"]
+  saturday: ["12:30", "17:00"]
+  sunday: ["17:00"]
+`
+	cfg, err := LoadFromBytes([]byte(yaml))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		date, field string
+		want        []string
+	}{
+		{"2026-05-02", "Kids", []string{"9:00", "11:00"}},               // Saturday: its own list
+		{"2026-05-04", "Kids", nil},                                     // Monday: weekday: [] means none
+		{"2026-05-03", "Kids", []string{"17:00"}},                       // Sunday: left out, so the league's
+		{"2026-05-02", "F1", []string{"12:30", "17:00"}},                // no override
+		{"2026-05-02", "", []string{"9:00", "11:00", "12:30", "17:00"}}, // any field, in clock order
+	}
+	for _, tt := range tests {
+		t.Run(tt.date+" "+tt.field, func(t *testing.T) {
+			got := cfg.TimesForDay(mustDate(tt.date))
+			if tt.field != "" {
+				got = cfg.FieldTimesForDay(tt.field, mustDate(tt.date))
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("times = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("times must parse", func(t *testing.T) {
+		bad := strings.Replace(yaml, `"11:00"`, `"11am"`, 1)
+		if _, err := LoadFromBytes([]byte(bad)); err == nil || !strings.Contains(err.Error(), `field "Kids": time_slots: "11am" must be a time`) {
+			t.Errorf("error = %v, want a time_slots error for Kids", err)
+		}
+	})
+
+	t.Run("CompareTimes orders by the clock", func(t *testing.T) {
+		if CompareTimes("9:00", "12:30") >= 0 || CompareTimes("17:00", "09:00") <= 0 || CompareTimes("12:30", "12:30") != 0 {
+			t.Error("CompareTimes should order 9:00 < 12:30 < 17:00")
+		}
+	})
+}
+
+func TestTimeSlotLabels(t *testing.T) {
+	yaml := `
+season:
+  start_date: "2026-04-25"
+  end_date: "2026-05-31"
+divisions:
+  - name: A
+    teams: [T1, T2]
+fields:
+  - name: F1
+time_slots:
+  weekday: ["17:45"]
+  saturday: ["12:30", "14:45"]
+  labels:
+    Saturday:
+      "12:30": Game 1
+      "14:45": Game 2
+`
+	cfg, err := LoadFromBytes([]byte(yaml))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		date, time, want string
+	}{
+		{"2026-05-02", "12:30", "Game 1"},
+		{"2026-05-02", "14:45", "Game 2"},
+		{"2026-05-02", "17:00", ""},
+		{"2026-05-04", "17:45", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.date+" "+tt.time, func(t *testing.T) {
+			if got := cfg.TimeSlots.Label(mustDate(tt.date), tt.time); got != tt.want {
+				t.Errorf("Label = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	errs := []struct{ from, to, want string }{
+		{`"14:45": Game 2`, `"2:45pm": Game 2`, `"2:45pm" must be a time`},
+		{`"14:45": Game 2`, `"14:45": ""`, "14:45 has an empty label"},
+		{"Saturday:", "Caturday:", `"Caturday" is not a day of the week`},
+	}
+	for _, tt := range errs {
+		t.Run(tt.want, func(t *testing.T) {
+			bad := strings.Replace(yaml, tt.from, tt.to, 1)
+			if _, err := LoadFromBytes([]byte(bad)); err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRematchDays(t *testing.T) {
+	competitive, rec := 21, 7
+	cfg := &Config{
+		Divisions: []Division{
+			{Name: "Majors", Teams: []string{"Angels", "Astros"}, MinDaysBetweenSameMatchup: &competitive},
+			{Name: "Minors", Teams: []string{"Cubs", "Padres"}, MinDaysBetweenSameMatchup: &rec},
+			{Name: "Rookies", Teams: []string{"Royals", "Pirates"}},
+		},
+		Guidelines: Guidelines{MinDaysBetweenSameMatchup: 14},
+	}
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"Angels", "Astros", 21},
+		{"Cubs", "Padres", 7},
+		{"Royals", "Pirates", 14},
+		{"Angels", "Cubs", 21},
+		{"Cubs", "Royals", 14},
+		{"Nobody", "Else", 14},
+	}
+	for _, tt := range tests {
+		if got := cfg.RematchDays(tt.a, tt.b); got != tt.want {
+			t.Errorf("RematchDays(%s, %s) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestOnlyDivisions(t *testing.T) {
+	cfg, err := LoadFromBytes([]byte(`
+season:
+  start_date: "2026-04-25"
+  end_date: "2026-05-31"
+divisions:
+  - name: American
+    teams: [Angels, Astros]
+  - name: National
+    teams: [Cubs, Padres]
+  - name: Minors
+    teams: [Rays, Twins]
+fields:
+  - name: F1
+time_slots:
+  weekday: ["17:45"]
+teams:
+  - name: Angels
+    rating: 5
+  - name: Cubs
+    rating: 3
+playoffs:
+  seeds: [Cubs, Angels, Astros, Padres, Rays, Twins]
+guidelines:
+  opponent_groups:
+    - name: Travel
+      teams: [Cubs, Padres]
+    - name: Mixed
+      teams: [Angels, Cubs]
+  family_links:
+    - [Angels, Rays]
+    - [Astros, Padres]
+rules:
+  matchup_blackouts:
+    - {home: Angels, away: Astros, date: "2026-05-05"}
+    - {home: Angels, away: Cubs, date: "2026-05-06"}
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	t.Run("keeps named divisions in config order", func(t *testing.T) {
+		only, err := cfg.OnlyDivisions([]string{"Minors", "American"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := only.AllTeams(); !slices.Equal(got, []string{"Angels", "Astros", "Rays", "Twins"}) {
+			t.Errorf("teams = %v, want Angels, Astros, Rays, Twins", got)
+		}
+		if len(only.Teams) != 1 || only.Teams[0].Name != "Angels" {
+			t.Errorf("team settings = %+v, want only Angels", only.Teams)
+		}
+		if got := only.Playoffs.Seeds; !slices.Equal(got, []string{"Angels", "Astros", "Rays", "Twins"}) {
+			t.Errorf("seeds = %v, want Angels, Astros, Rays, Twins", got)
+		}
+		groups := only.Guidelines.OpponentGroups
+		if len(groups) != 1 || groups[0].Name != "Mixed" || !slices.Equal(groups[0].Teams, []string{"Angels"}) {
+			t.Errorf("opponent groups = %+v, want Mixed with Angels only", groups)
+		}
+		if links := only.Guidelines.FamilyLinks; len(links) != 1 || !slices.Equal(links[0], []string{"Angels", "Rays"}) {
+			t.Errorf("family links = %v, want only [Angels Rays]", links)
+		}
+		if m := only.Rules.MatchupBlackouts; len(m) != 1 || m[0].Away != "Astros" {
+			t.Errorf("matchup blackouts = %+v, want only Angels vs Astros", m)
+		}
+		if len(cfg.Divisions) != 3 || len(cfg.Teams) != 2 || len(cfg.Guidelines.OpponentGroups[1].Teams) != 2 {
+			t.Error("OnlyDivisions changed the original config")
+		}
+	})
+
+	t.Run("Relabel renames teams everywhere", func(t *testing.T) {
+		cfg := *cfg
+		cfg.Teams = append([]Team(nil), cfg.Teams...)
+		cfg.Teams[0].Coach, cfg.Teams[0].Email = "Pat", "pat@example.com"
+		blind := cfg.Relabel(map[string]string{"Angels": "Team 2", "Cubs": "Team 1"})
+		if got := blind.AllTeams(); !slices.Equal(got, []string{"Team 2", "Astros", "Team 1", "Padres", "Rays", "Twins"}) {
+			t.Errorf("teams = %v", got)
+		}
+		if blind.Divisions[0].Name != "American" {
+			t.Errorf("division name = %q, want American", blind.Divisions[0].Name)
+		}
+		if got := blind.Team("Team 2"); got.Rating != 5 || got.Coach != "" || got.Email != "" {
+			t.Errorf("Team 2 settings = %+v, want rating 5 and no contact", got)
+		}
+		if got := blind.Playoffs.Seeds; !slices.Equal(got, []string{"Team 1", "Team 2", "Astros", "Padres", "Rays", "Twins"}) {
+			t.Errorf("seeds = %v", got)
+		}
+		if got := blind.Guidelines.OpponentGroups[1].Teams; !slices.Equal(got, []string{"Team 2", "Team 1"}) {
+			t.Errorf("Mixed group = %v, want [Team 2 Team 1]", got)
+		}
+		if got := blind.Guidelines.FamilyLinks[0]; !slices.Equal(got, []string{"Team 2", "Rays"}) {
+			t.Errorf("family link = %v, want [Team 2 Rays]", got)
+		}
+		if m := blind.Rules.MatchupBlackouts[1]; m.Home != "Team 2" || m.Away != "Team 1" {
+			t.Errorf("matchup blackout = %+v, want Team 2 vs Team 1", m)
+		}
+		if cfg.Divisions[0].Teams[0] != "Angels" || cfg.Teams[0].Coach != "Pat" || cfg.Rules.MatchupBlackouts[1].Home != "Angels" {
+			t.Error("Relabel changed the original config")
+		}
+	})
+
+	t.Run("unknown division rejected", func(t *testing.T) {
+		if _, err := cfg.OnlyDivisions([]string{"American", "Majors"}); err == nil || !strings.Contains(err.Error(), "Majors") {
+			t.Errorf("error = %v, want unknown division Majors", err)
+		}
+	})
+}
+
+func TestWarnings(t *testing.T) {
+	base := `
+season:
+  start_date: "2026-04-25"
+  end_date: "2026-05-31"
+divisions:
+  - name: A
+    teams: [T1, T2]
+fields:
+  - name: F1
+    reservations:
+%s
+time_slots:
+  weekday: ["17:45"]
+  saturday: ["12:30", "14:45", "17:00"]
+  sunday: ["17:00"]
+  holiday_dates:
+    - date: "2026-05-25"
+`
+	tests := []struct {
+		name        string
+		reservation string
+		want        string
+	}{
+		{"weekday time on a Saturday", `      - date: "2026-05-16"
+        times: ["17:45"]`, `field "F1": reservation on 2026-05-16 lists 17:45, but that day's slots start at 12:30, 14:45, 17:00; it blocks nothing`},
+		{"weekday time on a holiday", `      - date: "2026-05-25"
+        times: ["17:45"]`, "reservation on 2026-05-25 lists 17:45, but that day's slots start at 17:00"},
+		{"range of weekend days", `      - start_date: "2026-05-16"
+        end_date: "2026-05-17"
+        times: ["17:45"]`, "reservation from 2026-05-16 to 2026-05-17 lists 17:45, but no slot on those dates starts then"},
+		{"matching time", `      - date: "2026-05-16"
+        times: ["17:00"]`, ""},
+		{"range with one matching day", `      - start_date: "2026-05-16"
+        end_date: "2026-05-18"
+        times: ["17:45"]`, ""},
+		{"full day", `      - date: "2026-05-16"`, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := LoadFromBytes([]byte(fmt.Sprintf(base, tt.reservation)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			warnings := cfg.Warnings()
+			if tt.want == "" {
+				if len(warnings) != 0 {
+					t.Errorf("Warnings() = %q, want none", warnings)
+				}
+				return
+			}
+			if len(warnings) != 1 || !strings.Contains(warnings[0], tt.want) {
+				t.Errorf("Warnings() = %q, want one containing %q", warnings, tt.want)
+			}
+		})
 	}
 }
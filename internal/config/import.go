@@ -0,0 +1,498 @@
+package config
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ImportReservations returns a copy of the config with the field
+// reservations read from path added to the ones under fields. Files ending
+// in .ics are read as an iCalendar export, with each event's LOCATION naming
+// the field and its SUMMARY the reason; anything else is read as CSV with a
+// header row naming the columns field, date (or start_date/end_date),
+// times, until, from, and reason. Field names match case-insensitively.
+func (c *Config) ImportReservations(path string) (*Config, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading reservations: %w", err)
+	}
+	defer f.Close()
+
+	var imported []importedReservation
+	if strings.EqualFold(filepath.Ext(path), ".ics") {
+		imported, err = c.readICSReservations(f)
+	} else {
+		imported, err = readCSVReservations(f)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	out := *c
+	out.Fields = slices.Clone(c.Fields)
+	for _, ir := range imported {
+		i := slices.IndexFunc(out.Fields, func(f Field) bool { return strings.EqualFold(f.Name, ir.field) })
+		if i < 0 {
+			return nil, fmt.Errorf("%s: %s: unknown field %q", path, ir.source, ir.field)
+		}
+		// Clip before appending so the original config's slice is untouched.
+		out.Fields[i].Reservations = append(slices.Clip(out.Fields[i].Reservations), ir.Reservation)
+	}
+
+	if err := out.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &out, nil
+}
+
+// importedReservation is a reservation read from a file, with the field it
+// belongs to and where in the file it came from for error messages.
+type importedReservation struct {
+	Reservation
+	field  string
+	source string // e.g. "line 4" or "event \"Tournament\""
+}
+
+// readCSVReservations reads one reservation per row. Columns are found by
+// header name, so they can come in any order and unknown columns are
+// ignored. Times may be separated by semicolons or spaces.
+func readCSVReservations(r io.Reader) ([]importedReservation, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	col := make(map[string]int)
+	aliases := map[string]string{"start": "start_date", "end": "end_date", "time": "times"}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if alias, ok := aliases[name]; ok {
+			name = alias
+		}
+		col[name] = i
+	}
+	if _, ok := col["field"]; !ok {
+		return nil, fmt.Errorf("header row has no field column")
+	}
+
+	var out []importedReservation
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := cr.FieldPos(0)
+		get := func(name string) string {
+			if i, ok := col[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		ir := importedReservation{
+			field:  get("field"),
+			source: fmt.Sprintf("line %d", line),
+			Reservation: Reservation{
+				Until:  get("until"),
+				From:   get("from"),
+				Reason: get("reason"),
+				Times:  strings.FieldsFunc(get("times"), func(r rune) bool { return r == ';' || r == ' ' }),
+			},
+		}
+		if ir.field == "" {
+			return nil, fmt.Errorf("line %d: field is required", line)
+		}
+		for _, d := range []struct {
+			key string
+			dst **Date
+		}{{"date", &ir.Date}, {"start_date", &ir.StartDate}, {"end_date", &ir.EndDate}} {
+			v := get(d.key)
+			if v == "" {
+				continue
+			}
+			t, err := time.Parse("2006-01-02", v)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid %s %q, want YYYY-MM-DD", line, d.key, v)
+			}
+			*d.dst = &Date{Time: t}
+		}
+		out = append(out, ir)
+	}
+	return out, nil
+}
+
+// readICSReservations reads one reservation per VEVENT. All-day events
+// block every slot on their dates (DTEND is exclusive, as iCalendar
+// defines it). Timed events block the configured time slots whose games
+// would overlap the booking, converting UTC and TZID times to the season's
+// time zone; days the booking covers entirely are blocked outright.
+// Recurring events block each occurrence.
+func (c *Config) readICSReservations(r io.Reader) ([]importedReservation, error) {
+	var out []importedReservation
+	var event map[string]icsProperty
+	for _, line := range unfoldICS(r) {
+		switch {
+		case strings.EqualFold(line, "BEGIN:VEVENT"):
+			event = make(map[string]icsProperty)
+		case strings.EqualFold(line, "END:VEVENT"):
+			if event == nil {
+				continue
+			}
+			rs, err := c.eventReservations(event)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, rs...)
+			event = nil
+		case event != nil:
+			p, ok := parseICSProperty(line)
+			if !ok {
+				continue
+			}
+			if prev, seen := event[p.name]; seen && p.name == "EXDATE" {
+				p.value = prev.value + "," + p.value // EXDATE may repeat
+			}
+			event[p.name] = p
+		}
+	}
+	return out, nil
+}
+
+// icsProperty is one content line, e.g. DTSTART;TZID=America/New_York:20260518T160000.
+type icsProperty struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+func parseICSProperty(line string) (icsProperty, bool) {
+	head, value, ok := strings.Cut(line, ":")
+	if !ok {
+		return icsProperty{}, false
+	}
+	parts := strings.Split(head, ";")
+	p := icsProperty{name: strings.ToUpper(parts[0]), params: make(map[string]string), value: value}
+	for _, param := range parts[1:] {
+		k, v, _ := strings.Cut(param, "=")
+		p.params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+	}
+	return p, true
+}
+
+// unfoldICS splits r into content lines, joining continuation lines (those
+// starting with a space or tab) onto the line before them.
+func unfoldICS(r io.Reader) []string {
+	var lines []string
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := strings.TrimRight(sc.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+var icsTextEscapes = strings.NewReplacer(`\,`, ",", `\;`, ";", `\n`, " ", `\N`, " ", `\\`, `\`)
+
+func (c *Config) eventReservations(event map[string]icsProperty) ([]importedReservation, error) {
+	summary := icsTextEscapes.Replace(event["SUMMARY"].value)
+	source := fmt.Sprintf("event %q", summary)
+	field := icsTextEscapes.Replace(event["LOCATION"].value)
+	if field == "" {
+		return nil, fmt.Errorf("%s has no LOCATION naming the field", source)
+	}
+	start, ok := event["DTSTART"]
+	if !ok {
+		return nil, fmt.Errorf("%s has no DTSTART", source)
+	}
+	begin, allDay, err := c.icsTime(start)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", source, err)
+	}
+
+	starts, err := c.occurrences(event, begin)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", source, err)
+	}
+
+	base := importedReservation{field: field, source: source, Reservation: Reservation{Reason: summary}}
+	var out []importedReservation
+	if allDay {
+		last := begin
+		if end, ok := event["DTEND"]; ok {
+			t, _, err := c.icsTime(end)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", source, err)
+			}
+			if t.After(begin) {
+				last = t.AddDate(0, 0, -1)
+			}
+		}
+		span := last.Sub(begin)
+		for _, start := range starts {
+			ir := base
+			if span == 0 {
+				ir.Date = &Date{Time: start}
+			} else {
+				ir.StartDate, ir.EndDate = &Date{Time: start}, &Date{Time: start.Add(span)}
+			}
+			out = append(out, ir)
+		}
+		return out, nil
+	}
+
+	finish := begin.Add(c.TimeSlots.GameLength())
+	if end, ok := event["DTEND"]; ok {
+		if finish, _, err = c.icsTime(end); err != nil {
+			return nil, fmt.Errorf("%s: %w", source, err)
+		}
+	}
+	length := finish.Sub(begin)
+	for _, start := range starts {
+		finish := start.Add(length)
+		for day := dateOf(start); day.Before(finish); day = day.AddDate(0, 0, 1) {
+			from, to := later(start, day), earlier(finish, day.AddDate(0, 0, 1))
+			ir := base
+			ir.Date = &Date{Time: day}
+			if from.After(day) || to.Before(day.AddDate(0, 0, 1)) {
+				ir.Times = c.overlappingTimes(field, day, from, to)
+				if len(ir.Times) == 0 {
+					continue // the booking misses every slot that day
+				}
+			}
+			out = append(out, ir)
+		}
+	}
+	return out, nil
+}
+
+// icsWeekdays maps RRULE BYDAY codes to days, in the default week order
+// starting Monday.
+var icsWeekdays = map[string]time.Weekday{
+	"MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday, "TH": time.Thursday,
+	"FR": time.Friday, "SA": time.Saturday, "SU": time.Sunday,
+}
+
+// occurrences returns the start of each occurrence of an event beginning
+// at begin: just begin, or every date its RRULE produces, less EXDATEs.
+// DAILY and WEEKLY rules with INTERVAL, COUNT, UNTIL, and BYDAY are
+// expanded up to the season's last date; anything else is an error rather
+// than a booking quietly cut to its first occurrence.
+func (c *Config) occurrences(event map[string]icsProperty, begin time.Time) ([]time.Time, error) {
+	rule, ok := event["RRULE"]
+	if !ok {
+		return []time.Time{begin}, nil
+	}
+
+	freq, interval, count := "", 1, 0
+	var until *time.Time
+	var untilDate bool
+	var days []int // days after Monday
+	for _, part := range strings.Split(rule.value, ";") {
+		k, v, _ := strings.Cut(part, "=")
+		switch strings.ToUpper(k) {
+		case "FREQ":
+			freq = strings.ToUpper(v)
+		case "INTERVAL":
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("RRULE: invalid INTERVAL %q", v)
+			}
+			interval = n
+		case "COUNT":
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("RRULE: invalid COUNT %q", v)
+			}
+			count = n
+		case "UNTIL":
+			t, allDay, err := c.icsTime(icsProperty{name: "RRULE UNTIL", value: v})
+			if err != nil {
+				return nil, err
+			}
+			until, untilDate = &t, allDay
+		case "BYDAY":
+			for _, code := range strings.Split(strings.ToUpper(v), ",") {
+				day, ok := icsWeekdays[code]
+				if !ok {
+					return nil, fmt.Errorf("RRULE: BYDAY %q isn't supported; list plain days like MO,WE", code)
+				}
+				days = append(days, (int(day)+6)%7)
+			}
+		case "WKST":
+		default:
+			return nil, fmt.Errorf("RRULE: %s isn't supported; use FREQ, INTERVAL, COUNT, UNTIL, and BYDAY", k)
+		}
+	}
+	if freq != "DAILY" && freq != "WEEKLY" {
+		return nil, fmt.Errorf("RRULE: FREQ=%s isn't supported; use DAILY or WEEKLY", freq)
+	}
+	if len(days) > 0 && freq != "WEEKLY" {
+		return nil, fmt.Errorf("RRULE: BYDAY needs FREQ=WEEKLY")
+	}
+
+	excluded := make(map[time.Time]bool)
+	if ex, ok := event["EXDATE"]; ok {
+		for _, v := range strings.Split(ex.value, ",") {
+			t, allDay, err := c.icsTime(icsProperty{name: "EXDATE", params: ex.params, value: v})
+			if err != nil {
+				return nil, err
+			}
+			if allDay {
+				t = t.Add(begin.Sub(dateOf(begin)))
+			}
+			excluded[t] = true
+		}
+	}
+
+	last := c.Season.EndDate.Time
+	if c.Season.OverflowEndDate != nil {
+		last = c.Season.OverflowEndDate.Time
+	}
+	// done reports whether start is past the rule's end or the season's.
+	done := func(start time.Time, n int) bool {
+		switch {
+		case count > 0 && n >= count:
+			return true
+		case until != nil && untilDate && dateOf(start).After(*until):
+			return true
+		case until != nil && !untilDate && start.After(*until):
+			return true
+		}
+		return dateOf(start).After(last)
+	}
+
+	var candidates func(period int) []time.Time
+	if freq == "DAILY" {
+		candidates = func(period int) []time.Time {
+			return []time.Time{begin.AddDate(0, 0, period*interval)}
+		}
+	} else {
+		if len(days) == 0 {
+			days = []int{(int(begin.Weekday()) + 6) % 7}
+		}
+		slices.Sort(days)
+		monday := dateOf(begin).AddDate(0, 0, -((int(begin.Weekday()) + 6) % 7))
+		clock := begin.Sub(dateOf(begin))
+		candidates = func(period int) []time.Time {
+			var out []time.Time
+			for _, d := range days {
+				out = append(out, monday.AddDate(0, 0, 7*period*interval+d).Add(clock))
+			}
+			return out
+		}
+	}
+
+	var starts []time.Time
+	n := 0
+	for period := 0; ; period++ {
+		for _, start := range candidates(period) {
+			if start.Before(begin) {
+				continue
+			}
+			if done(start, n) {
+				return starts, nil
+			}
+			n++
+			if !excluded[start] {
+				starts = append(starts, start)
+			}
+		}
+	}
+}
+
+// icsTime parses a DTSTART or DTEND value. Date-only values are all-day;
+// date-times are returned as wall-clock times in the season's time zone
+// but with a UTC location, matching how slot dates are stored. A TZID that
+// doesn't load is an error rather than a silent shift of the times.
+func (c *Config) icsTime(p icsProperty) (t time.Time, allDay bool, err error) {
+	if p.params["VALUE"] == "DATE" || len(p.value) == len("20060102") {
+		t, err = time.Parse("20060102", p.value)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid %s %q", p.name, p.value)
+		}
+		return t, true, nil
+	}
+
+	loc := c.Season.Location()
+	if tzid := p.params["TZID"]; tzid != "" {
+		l, err := time.LoadLocation(tzid)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("%s: unknown time zone TZID %q", p.name, tzid)
+		}
+		loc = l
+	}
+	value := p.value
+	if strings.HasSuffix(value, "Z") {
+		loc = time.UTC
+		value = strings.TrimSuffix(value, "Z")
+	}
+	t, err = time.ParseInLocation("20060102T150405", value, loc)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid %s %q", p.name, p.value)
+	}
+	wall := t.In(c.Season.Location())
+	return time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), 0, time.UTC), false, nil
+}
+
+// overlappingTimes returns the field's slot times on day whose games would
+// be on the field at some point between from and to. field matches
+// case-insensitively; the league's times apply to unknown fields.
+func (c *Config) overlappingTimes(field string, day, from, to time.Time) []string {
+	if i := slices.IndexFunc(c.Fields, func(f Field) bool { return strings.EqualFold(f.Name, field) }); i >= 0 {
+		field = c.Fields[i].Name
+	}
+	var times []string
+	for _, s := range c.FieldTimesForDay(field, day) {
+		t, err := time.Parse("15:04", s)
+		if err != nil || slices.Contains(times, s) {
+			continue
+		}
+		gameStart := day.Add(time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute)
+		if gameStart.Before(to) && gameStart.Add(c.TimeSlots.GameLength()).After(from) {
+			times = append(times, s)
+		}
+	}
+	slices.SortFunc(times, CompareTimes)
+	return times
+}
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func later(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func earlier(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
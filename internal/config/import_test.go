@@ -0,0 +1,174 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestImportReservations(t *testing.T) {
+	load := func(t *testing.T) *Config {
+		t.Helper()
+		cfg, err := LoadFromBytes([]byte(testConfigYAML))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return cfg
+	}
+	write := func(t *testing.T, name, content string) string {
+		t.Helper()
+		path := filepath.Join(t.TempDir(), name)
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	field := func(cfg *Config, name string) Field {
+		for _, f := range cfg.Fields {
+			if f.Name == name {
+				return f
+			}
+		}
+		return Field{}
+	}
+
+	t.Run("csv", func(t *testing.T) {
+		cfg := load(t)
+		path := write(t, "bookings.csv", `Field,Reason,Date,Start,End,Times
+symonds field,Town tournament,,2026-05-18,2026-05-19,
+Washington Park,Softball,2026-05-20,,,17:45
+Moscariello Ballpark,"Varsity, JV",2026-05-21,,,12:30;17:00
+`)
+		got, err := cfg.ImportReservations(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		symonds := field(got, "Symonds Field").Reservations
+		if len(symonds) != 1 || !symonds[0].StartDate.Time.Equal(mustDate("2026-05-18")) ||
+			!symonds[0].EndDate.Time.Equal(mustDate("2026-05-19")) || !symonds[0].FullDay() {
+			t.Errorf("Symonds Field reservations = %+v, want a full-day 05/18-05/19 range", symonds)
+		}
+		wp := field(got, "Washington Park").Reservations
+		if len(wp) != 1 || !slices.Equal(wp[0].Times, []string{"17:45"}) || wp[0].Reason != "Softball" {
+			t.Errorf("Washington Park reservations = %+v, want Softball at 17:45", wp)
+		}
+		mosc := field(got, "Moscariello Ballpark").Reservations
+		if len(mosc) != 2 || !slices.Equal(mosc[1].Times, []string{"12:30", "17:00"}) || mosc[1].Reason != "Varsity, JV" {
+			t.Errorf("Moscariello Ballpark reservations = %+v, want the YAML one plus Varsity, JV", mosc)
+		}
+		if n := len(field(cfg, "Moscariello Ballpark").Reservations); n != 1 {
+			t.Errorf("original config has %d Moscariello reservations, want 1", n)
+		}
+	})
+
+	t.Run("ics", func(t *testing.T) {
+		cfg := load(t)
+		path := write(t, "bookings.ics", "BEGIN:VCALENDAR\r\n"+
+			"BEGIN:VEVENT\r\n"+
+			"SUMMARY:Memorial Day\r\n  tournament\r\n"+
+			"LOCATION:Symonds Field\r\n"+
+			"DTSTART;VALUE=DATE:20260523\r\n"+
+			"DTEND;VALUE=DATE:20260525\r\n"+
+			"END:VEVENT\r\n"+
+			"BEGIN:VEVENT\r\n"+
+			"SUMMARY:Clinic\r\n"+
+			"LOCATION:Washington Park\r\n"+
+			"DTSTART:20260523T140000\r\n"+
+			"DTEND:20260523T160000\r\n"+
+			"END:VEVENT\r\n"+
+			"END:VCALENDAR\r\n")
+		got, err := cfg.ImportReservations(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		symonds := field(got, "Symonds Field").Reservations
+		if len(symonds) != 1 || symonds[0].Reason != "Memorial Day tournament" ||
+			!symonds[0].StartDate.Time.Equal(mustDate("2026-05-23")) || !symonds[0].EndDate.Time.Equal(mustDate("2026-05-24")) {
+			t.Errorf("Symonds Field reservations = %+v, want Memorial Day tournament 05/23-05/24", symonds)
+		}
+		// 12:30 and 14:45 games would still be on the field during a 2-4pm
+		// clinic; a 17:00 game would not.
+		wp := field(got, "Washington Park").Reservations
+		if len(wp) != 1 || !wp[0].Date.Time.Equal(mustDate("2026-05-23")) || !slices.Equal(wp[0].Times, []string{"12:30", "14:45"}) {
+			t.Errorf("Washington Park reservations = %+v, want 05/23 at 12:30 and 14:45", wp)
+		}
+	})
+
+	t.Run("ics timed booking blocks only that day's times", func(t *testing.T) {
+		path := write(t, "bookings.ics", "BEGIN:VEVENT\nSUMMARY:Camp\nLOCATION:symonds field\n"+
+			"DTSTART:20260518T160000\nDTEND:20260518T190000\nEND:VEVENT\n")
+		got, err := load(t).ImportReservations(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Monday offers only 17:45; Saturday and Sunday's 17:00 isn't a slot.
+		symonds := field(got, "Symonds Field").Reservations
+		if len(symonds) != 1 || !slices.Equal(symonds[0].Times, []string{"17:45"}) {
+			t.Errorf("Symonds Field reservations = %+v, want 05/18 at 17:45", symonds)
+		}
+		if w := got.Warnings(); len(w) != 0 {
+			t.Errorf("Warnings() = %q, want none", w)
+		}
+	})
+
+	t.Run("ics recurring events", func(t *testing.T) {
+		dates := func(rs []Reservation) []string {
+			var out []string
+			for _, r := range rs {
+				out = append(out, r.Date.Time.Format("01/02"))
+			}
+			return out
+		}
+		tests := []struct {
+			name, rule string
+			want       []string
+		}{
+			{"weekly by day with count", "RRULE:FREQ=WEEKLY;BYDAY=MO,WE;COUNT=4\n",
+				[]string{"05/18", "05/20", "05/25", "05/27"}},
+			{"exdate", "RRULE:FREQ=DAILY;COUNT=3\nEXDATE:20260519T160000\n",
+				[]string{"05/18", "05/20"}},
+			{"until", "RRULE:FREQ=DAILY;INTERVAL=2;UNTIL=20260522\n",
+				[]string{"05/18", "05/20", "05/22"}},
+			{"open-ended stops at the season's end", "RRULE:FREQ=WEEKLY\n",
+				[]string{"05/18", "05/25"}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				path := write(t, "bookings.ics", "BEGIN:VEVENT\nSUMMARY:Camp\nLOCATION:Symonds Field\n"+
+					"DTSTART:20260518T160000\nDTEND:20260518T190000\n"+tt.rule+"END:VEVENT\n")
+				got, err := load(t).ImportReservations(path)
+				if err != nil {
+					t.Fatal(err)
+				}
+				// A holiday Monday (05/25) offers Sunday's 17:00; the rest 17:45.
+				if d := dates(field(got, "Symonds Field").Reservations); !slices.Equal(d, tt.want) {
+					t.Errorf("reservation dates = %v, want %v", d, tt.want)
+				}
+			})
+		}
+	})
+
+	errorTests := []struct {
+		name, file, content, want string
+	}{
+		{"unknown field", "b.csv", "field,date\nRiverside,2026-05-18\n", `line 2: unknown field "Riverside"`},
+		{"no field column", "b.csv", "date,reason\n2026-05-18,x\n", "no field column"},
+		{"bad date", "b.csv", "field,date\nSymonds Field,5/18/2026\n", `line 2: invalid date "5/18/2026"`},
+		{"no date", "b.csv", "field,reason\nSymonds Field,x\n", "reservation must have either 'date'"},
+		{"event without location", "b.ics", "BEGIN:VEVENT\nSUMMARY:Camp\nDTSTART;VALUE=DATE:20260518\nEND:VEVENT\n", `event "Camp" has no LOCATION`},
+		{"unknown tzid", "b.ics", "BEGIN:VEVENT\nSUMMARY:Camp\nLOCATION:Symonds Field\nDTSTART;TZID=Eastern Standard Time:20260518T160000\nEND:VEVENT\n", `event "Camp": DTSTART: unknown time zone TZID "Eastern Standard Time"`},
+		{"unsupported rrule", "b.ics", "BEGIN:VEVENT\nSUMMARY:Camp\nLOCATION:Symonds Field\nDTSTART:20260518T160000\nRRULE:FREQ=MONTHLY\nEND:VEVENT\n", `event "Camp": RRULE: FREQ=MONTHLY isn't supported`},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := load(t).ImportReservations(write(t, tt.file, tt.content))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
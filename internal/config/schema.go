@@ -0,0 +1,71 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+)
+
+var (
+	dateType    = reflect.TypeOf(Date{})
+	holidayType = reflect.TypeOf(Holiday{})
+)
+
+// JSONSchema returns a JSON Schema describing the config file, derived from
+// the Config struct's yaml tags so it stays in sync as fields are added.
+func JSONSchema() map[string]any {
+	schema := schemaFor(reflect.TypeOf(Config{}))
+	schema["$schema"] = "https://json-schema.org/draft/2020-12/schema"
+	schema["title"] = "RBRL season configuration"
+	return schema
+}
+
+func schemaFor(t reflect.Type) map[string]any {
+	switch t {
+	case dateType:
+		return map[string]any{"type": "string", "format": "date"}
+	case holidayType:
+		// A holiday is either a bare date or a {date, as} mapping
+		return map[string]any{"oneOf": []any{
+			schemaFor(dateType),
+			structSchema(t),
+		}}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return schemaFor(t.Elem())
+	case reflect.Struct:
+		return structSchema(t)
+	case reflect.Slice:
+		return map[string]any{"type": "array", "items": schemaFor(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": schemaFor(t.Elem())}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	default:
+		return map[string]any{}
+	}
+}
+
+func structSchema(t reflect.Type) map[string]any {
+	props := make(map[string]any)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if name == "" || name == "-" || !field.IsExported() {
+			continue
+		}
+		props[name] = schemaFor(field.Type)
+	}
+	return map[string]any{
+		"type":                 "object",
+		"properties":           props,
+		"additionalProperties": false,
+	}
+}
@@ -0,0 +1,76 @@
+package config
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestJSONSchema(t *testing.T) {
+	schema := JSONSchema()
+
+	t.Run("marshals to JSON", func(t *testing.T) {
+		if _, err := json.Marshal(schema); err != nil {
+			t.Fatalf("json.Marshal error: %v", err)
+		}
+	})
+
+	t.Run("dates are strings in date format", func(t *testing.T) {
+		season := schema["properties"].(map[string]any)["season"].(map[string]any)
+		start := season["properties"].(map[string]any)["start_date"].(map[string]any)
+		if start["type"] != "string" || start["format"] != "date" {
+			t.Errorf("start_date schema = %v, want date string", start)
+		}
+	})
+
+	t.Run("holiday accepts date or mapping", func(t *testing.T) {
+		ts := schema["properties"].(map[string]any)["time_slots"].(map[string]any)
+		holidays := ts["properties"].(map[string]any)["holiday_dates"].(map[string]any)
+		items := holidays["items"].(map[string]any)
+		if _, ok := items["oneOf"]; !ok {
+			t.Errorf("holiday_dates items = %v, want oneOf", items)
+		}
+	})
+
+	t.Run("covers every key in the test config", func(t *testing.T) {
+		var doc map[string]any
+		if err := yaml.Unmarshal([]byte(testConfigYAML), &doc); err != nil {
+			t.Fatalf("yaml error: %v", err)
+		}
+		checkKeysInSchema(t, "", doc, schema)
+	})
+}
+
+// checkKeysInSchema walks a decoded YAML document and fails for any mapping
+// key the schema does not describe.
+func checkKeysInSchema(t *testing.T, path string, doc any, schema map[string]any) {
+	t.Helper()
+	if oneOf, ok := schema["oneOf"].([]any); ok {
+		for _, alt := range oneOf {
+			if alt.(map[string]any)["type"] == "object" {
+				schema = alt.(map[string]any)
+			}
+		}
+	}
+	switch v := doc.(type) {
+	case map[string]any:
+		props, _ := schema["properties"].(map[string]any)
+		for key, child := range v {
+			sub, ok := props[key]
+			if !ok {
+				t.Errorf("schema missing %s", strings.TrimPrefix(path+"."+key, "."))
+				continue
+			}
+			checkKeysInSchema(t, path+"."+key, child, sub.(map[string]any))
+		}
+	case []any:
+		items, _ := schema["items"].(map[string]any)
+		for _, child := range v {
+			if _, ok := child.(map[string]any); ok {
+				checkKeysInSchema(t, path+"[]", child, items)
+			}
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package excel
+
+import (
+	"slices"
+	"time"
+
+	"github.com/derekprior/rbrl/internal/config"
+)
+
+// AuditReport compares a schedule's master sheet with a config, to catch a
+// config and a workbook from different seasons.
+type AuditReport struct {
+	Games int // games on the master sheet
+
+	UnknownTeams []string // teams in the schedule but in no division, sorted
+	MissingTeams []string // config teams with no games in the schedule
+
+	// UnknownFields are master sheet field columns that match no configured
+	// field; UnusedFields are configured fields with no column. Both at
+	// once usually means a field was renamed.
+	UnknownFields []string
+	UnusedFields  []string
+
+	First, Last time.Time // earliest and latest game dates; zero without games
+	OutOfSeason int       // games before start_date or after the season ends
+}
+
+// OK reports whether the schedule and config agree.
+func (r *AuditReport) OK() bool {
+	return len(r.UnknownTeams) == 0 && len(r.MissingTeams) == 0 &&
+		len(r.UnknownFields) == 0 && len(r.UnusedFields) == 0 && r.OutOfSeason == 0
+}
+
+// Audit reads the master schedule at path (a workbook or CSV, as
+// ReadMasterRows accepts) and checks its teams, field columns, and dates
+// against cfg. The season ends at overflow_end_date when one is set.
+func Audit(path string, cfg *config.Config) (*AuditReport, error) {
+	rows, err := ReadMasterRows(path)
+	if err != nil {
+		return nil, err
+	}
+	games := readGamesFromMaster(rows, nil)
+	report := &AuditReport{Games: len(games)}
+
+	inConfig := make(map[string]bool)
+	for _, team := range cfg.AllTeams() {
+		inConfig[team] = true
+	}
+	inSchedule := make(map[string]bool)
+	seasonEnd := cfg.Season.EndDate.Time
+	if cfg.Season.OverflowEndDate != nil {
+		seasonEnd = cfg.Season.OverflowEndDate.Time
+	}
+	for _, g := range games {
+		for _, team := range []string{g.Home, g.Away} {
+			if !inConfig[team] && !inSchedule[team] {
+				report.UnknownTeams = append(report.UnknownTeams, team)
+			}
+			inSchedule[team] = true
+		}
+		if report.First.IsZero() || g.Date.Before(report.First) {
+			report.First = g.Date
+		}
+		if g.Date.After(report.Last) {
+			report.Last = g.Date
+		}
+		if g.Date.Before(cfg.Season.StartDate.Time) || g.Date.After(seasonEnd) {
+			report.OutOfSeason++
+		}
+	}
+	slices.Sort(report.UnknownTeams)
+	for _, team := range cfg.AllTeams() {
+		if !inSchedule[team] {
+			report.MissingTeams = append(report.MissingTeams, team)
+		}
+	}
+
+	var fieldNames []string
+	for _, f := range cfg.Fields {
+		fieldNames = append(fieldNames, f.Name)
+	}
+	columns := make(map[string]bool)
+	for _, header := range rows[0][min(3, len(rows[0])):] {
+		if header != "" && !ExtraColumn(header) {
+			columns[header] = true
+		}
+	}
+	// A column may carry the field's full name when another field shared
+	// its first word in the season the schedule came from.
+	configured := make(map[string]bool)
+	for _, name := range fieldNames {
+		col := FieldColumnName(name, fieldNames)
+		configured[col], configured[name] = true, true
+		if !columns[col] && !columns[name] {
+			report.UnusedFields = append(report.UnusedFields, name)
+		}
+	}
+	for _, header := range rows[0][min(3, len(rows[0])):] {
+		if columns[header] && !configured[header] {
+			report.UnknownFields = append(report.UnknownFields, header)
+		}
+	}
+	return report, nil
+}
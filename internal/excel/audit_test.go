@@ -0,0 +1,59 @@
+package excel
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/schedule"
+)
+
+func TestAudit(t *testing.T) {
+	cfg, result := testData()
+	f, err := Generate(cfg, result, schedule.GenerateSlots(cfg), schedule.GenerateBlackoutSlots(cfg))
+	if err != nil {
+		t.Fatalf("Generate() error: %v", err)
+	}
+	path := t.TempDir() + "/prior.xlsx"
+	if err := f.SaveAs(path); err != nil {
+		t.Fatalf("SaveAs error: %v", err)
+	}
+
+	t.Run("matching config", func(t *testing.T) {
+		report, err := Audit(path, cfg)
+		if err != nil {
+			t.Fatalf("Audit() error: %v", err)
+		}
+		if !report.OK() || report.Games != 2 {
+			t.Errorf("report = %+v, want 2 games and no problems", report)
+		}
+	})
+
+	t.Run("drifted config", func(t *testing.T) {
+		next := *cfg
+		next.Season.StartDate = date(2026, 5, 1)
+		next.Divisions = []config.Division{
+			{Name: "American", Teams: []string{"Angels", "Astros", "Royals"}},
+			{Name: "National", Teams: []string{"Cubs"}},
+		}
+		next.Fields = []config.Field{{Name: "Field A"}, {Name: "Lincoln Park"}}
+		report, err := Audit(path, &next)
+		if err != nil {
+			t.Fatalf("Audit() error: %v", err)
+		}
+		want := &AuditReport{
+			Games:         2,
+			UnknownTeams:  []string{"Padres"},
+			MissingTeams:  []string{"Royals"},
+			UnknownFields: []string{"Field B"},
+			UnusedFields:  []string{"Lincoln Park"},
+			First:         time.Date(2026, 4, 25, 0, 0, 0, 0, time.UTC),
+			Last:          time.Date(2026, 4, 25, 0, 0, 0, 0, time.UTC),
+			OutOfSeason:   2,
+		}
+		if !reflect.DeepEqual(report, want) {
+			t.Errorf("report = %+v, want %+v", report, want)
+		}
+	})
+}
@@ -1,12 +1,20 @@
 package excel
 
 import (
+	"encoding/csv"
 	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/derekprior/rbrl/internal/config"
 	"github.com/derekprior/rbrl/internal/schedule"
+	"github.com/derekprior/rbrl/internal/strategy"
 	"github.com/xuri/excelize/v2"
 )
 
@@ -15,7 +23,7 @@ func Generate(cfg *config.Config, result *schedule.Result, slots []schedule.Slot
 	f := excelize.NewFile()
 
 	// Set default font for the workbook
-	f.SetDefaultFont("Arial")
+	f.SetDefaultFont(cfg.Style.Family())
 
 	if _, err := writeMasterSheet(f, cfg, result, slots, blackouts); err != nil {
 		return nil, fmt.Errorf("writing master sheet: %w", err)
@@ -31,12 +39,33 @@ func Generate(cfg *config.Config, result *schedule.Result, slots []schedule.Slot
 		games = append(games, gameEntry{
 			Date:  a.Slot.Date,
 			Time:  a.Slot.Time,
-			Field: fieldColumnName(a.Slot.Field, fieldNames),
+			Field: FieldColumnName(a.Slot.Field, fieldNames),
 			Home:  a.Game.Home,
 			Away:  a.Game.Away,
+			Note:  a.Game.Note,
 		})
 	}
 
+	if cfg.Output.Grid {
+		if err := writeGridSheet(f, cfg, games); err != nil {
+			return nil, fmt.Errorf("writing grid sheet: %w", err)
+		}
+	}
+
+	if cfg.HasContacts() {
+		if err := writeContactsSheet(f, cfg, games); err != nil {
+			return nil, fmt.Errorf("writing contacts sheet: %w", err)
+		}
+	}
+
+	if err := writeSummarySheet(f, cfg, games); err != nil {
+		return nil, fmt.Errorf("writing summary sheet: %w", err)
+	}
+
+	if err := writeLegendSheet(f, cfg); err != nil {
+		return nil, fmt.Errorf("writing legend sheet: %w", err)
+	}
+
 	if err := writeTeamSheets(f, cfg, games); err != nil {
 		return nil, fmt.Errorf("writing team sheets: %w", err)
 	}
@@ -45,8 +74,59 @@ func Generate(cfg *config.Config, result *schedule.Result, slots []schedule.Slot
 	return f, nil
 }
 
+// SaveODS saves the workbook as an OpenDocument spreadsheet. excelize only
+// writes Office Open XML, so the workbook is saved as xlsx in a temporary
+// directory and converted with LibreOffice, which must be on PATH. How much
+// styling survives is up to LibreOffice's xlsx import.
+func SaveODS(f *excelize.File, path string) error {
+	soffice, err := findLibreOffice()
+	if err != nil {
+		return err
+	}
+
+	dir, err := os.MkdirTemp("", "rbrl-ods")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	base := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	xlsx := filepath.Join(dir, base+".xlsx")
+	if err := f.SaveAs(xlsx); err != nil {
+		return fmt.Errorf("saving file: %w", err)
+	}
+
+	cmd := exec.Command(soffice, "--headless", "--convert-to", "ods", "--outdir", dir, xlsx)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("converting to ods: %w: %s", err, strings.TrimSpace(string(out)))
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, base+".ods"))
+	if err != nil {
+		return fmt.Errorf("converting to ods: %w", err)
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// RequireLibreOffice reports an error when SaveODS can't run, so callers
+// can fail before the work of building a workbook.
+func RequireLibreOffice() error {
+	_, err := findLibreOffice()
+	return err
+}
+
+func findLibreOffice() (string, error) {
+	for _, name := range []string{"soffice", "libreoffice"} {
+		if path, err := exec.LookPath(name); err == nil {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("ods output needs LibreOffice, but neither soffice nor libreoffice is on PATH; install it or use --format xlsx")
+}
+
 // UpdateTeamSheets reads the master schedule from an existing xlsx file,
-// regenerates all per-team sheets with static values, and saves the file.
+// regenerates all per-team sheets (and the grid, if enabled) with static
+// values, and saves the file.
 func UpdateTeamSheets(path string, cfg *config.Config) error {
 	f, err := excelize.OpenFile(path)
 	if err != nil {
@@ -54,16 +134,47 @@ func UpdateTeamSheets(path string, cfg *config.Config) error {
 	}
 	defer f.Close()
 
-	games, err := readGamesFromMaster(f)
+	rows, err := masterRows(f)
 	if err != nil {
 		return err
 	}
+	notes, err := masterNotes(f)
+	if err != nil {
+		return err
+	}
+	games := readGamesFromMaster(rows, notes)
 
 	// Delete existing team sheets
 	for _, team := range cfg.AllTeams() {
 		f.DeleteSheet(team)
 	}
 
+	if cfg.Output.Grid {
+		f.DeleteSheet(gridSheet)
+		if err := writeGridSheet(f, cfg, games); err != nil {
+			return err
+		}
+	}
+
+	// Rebuilt from the config every time, and dropped once no team has
+	// contact details left.
+	f.DeleteSheet(contactsSheet)
+	if cfg.HasContacts() {
+		if err := writeContactsSheet(f, cfg, games); err != nil {
+			return err
+		}
+	}
+
+	f.DeleteSheet(summarySheet)
+	if err := writeSummarySheet(f, cfg, games); err != nil {
+		return err
+	}
+
+	f.DeleteSheet(legendSheet)
+	if err := writeLegendSheet(f, cfg); err != nil {
+		return err
+	}
+
 	if err := writeTeamSheets(f, cfg, games); err != nil {
 		return err
 	}
@@ -71,32 +182,44 @@ func UpdateTeamSheets(path string, cfg *config.Config) error {
 	return f.SaveAs(path)
 }
 
-func fieldColumnName(name string, allNames []string) string {
-	first := name
-	for i, c := range name {
-		if c == ' ' {
-			first = name[:i]
-			break
-		}
+// ReadAssignments reads the games on the master sheet of an existing
+// workbook, or a CSV export of it (see ReadMasterRows). Field column headers
+// are mapped back to configured field names, and a workbook's game notes
+// come back from their cell comments.
+func ReadAssignments(path string, cfg *config.Config) ([]schedule.Assignment, error) {
+	rows, notes, err := readMaster(path)
+	if err != nil {
+		return nil, err
 	}
-	// Check if first word is unique
-	count := 0
-	for _, n := range allNames {
-		word := n
-		for i, c := range n {
-			if c == ' ' {
-				word = n[:i]
-				break
-			}
-		}
-		if word == first {
-			count++
-		}
+	games := readGamesFromMaster(rows, notes)
+
+	var fieldNames []string
+	for _, field := range cfg.Fields {
+		fieldNames = append(fieldNames, field.Name)
 	}
-	if count > 1 {
-		return name
+	fullName := make(map[string]string)
+	for _, name := range fieldNames {
+		fullName[FieldColumnName(name, fieldNames)] = name
 	}
-	return first
+
+	var assignments []schedule.Assignment
+	for _, g := range games {
+		field := g.Field
+		if name, ok := fullName[field]; ok {
+			field = name
+		}
+		assignments = append(assignments, schedule.Assignment{
+			Game: strategy.Game{Home: g.Home, Away: g.Away, Note: g.Note},
+			Slot: schedule.Slot{Date: g.Date, Time: g.Time, Field: field},
+		})
+	}
+	return assignments, nil
+}
+
+// FieldColumnName returns the master sheet column header for a field: its
+// first word when that is unique among all field names, else the full name.
+func FieldColumnName(name string, allNames []string) string {
+	return config.FieldColumnName(name, allNames)
 }
 
 func writeMasterSheet(f *excelize.File, cfg *config.Config, result *schedule.Result, slots []schedule.Slot, blackouts []schedule.BlackoutSlot) (int, error) {
@@ -110,35 +233,36 @@ func writeMasterSheet(f *excelize.File, cfg *config.Config, result *schedule.Res
 	}
 	fieldCols := make([]string, len(fieldNames))
 	for i, name := range fieldNames {
-		fieldCols[i] = fieldColumnName(name, fieldNames)
+		fieldCols[i] = FieldColumnName(name, fieldNames)
 	}
 
-	// Headers: Date, Day, Time, <field1>, <field2>, ...
+	// Headers: Date, Day, Time, <field1>, <field2>, ..., [Round], [Slot]
 	headers := []string{"Date", "Day", "Time"}
 	headers = append(headers, fieldCols...)
+	roundCol := 0
+	if cfg.Output.Rounds {
+		headers = append(headers, "Round")
+		roundCol = len(headers)
+	}
+	labelCol := 0
+	if len(cfg.TimeSlots.Labels) > 0 {
+		headers = append(headers, "Slot")
+		labelCol = len(headers)
+	}
 	for i, h := range headers {
 		f.SetCellValue(sheet, cellRef(i+1, 1), h)
 	}
 
-	headerStyle, _ := f.NewStyle(&excelize.Style{
-		Font:      &excelize.Font{Bold: true, Color: "#FFFFFF", Size: 16, Family: "Arial"},
-		Fill:      excelize.Fill{Type: "pattern", Pattern: 1, Color: []string{"#4472C4"}},
-		Alignment: &excelize.Alignment{Horizontal: "center"},
-	})
+	headerStyle := newHeaderStyle(f, cfg.Style)
 	if headerStyle != 0 {
 		for i := range headers {
 			f.SetCellStyle(sheet, cellRef(i+1, 1), cellRef(i+1, 1), headerStyle)
 		}
 	}
 
-	cellStyle, _ := f.NewStyle(&excelize.Style{
-		Font: &excelize.Font{Size: 16, Family: "Arial"},
-	})
+	cellStyle := newCellStyle(f, cfg.Style)
 
-	fieldCellStyle, _ := f.NewStyle(&excelize.Style{
-		Font:      &excelize.Font{Size: 16, Family: "Arial"},
-		Alignment: &excelize.Alignment{Horizontal: "center"},
-	})
+	fieldCellStyle := newCenteredCellStyle(f, cfg.Style)
 
 	// Build field name -> column index (0-based into field list)
 	fieldIndex := make(map[string]int)
@@ -157,10 +281,19 @@ func writeMasterSheet(f *excelize.File, cfg *config.Config, result *schedule.Res
 		assignmentMap[slotKey{a.Slot.Date, a.Slot.Time, a.Slot.Field}] = a
 	}
 
-	// Build blackout lookup: (date, time, field) -> reason
+	// Build blackout lookup: (date, time, field) -> reason. When several
+	// blackouts cover a slot the first in config order wins, or with
+	// combine_blackout_reasons each distinct reason is listed.
 	blackoutMap := make(map[slotKey]string)
 	for _, b := range blackouts {
-		blackoutMap[slotKey{b.Date, b.Time, b.Field}] = b.Reason
+		sk := slotKey{b.Date, b.Time, b.Field}
+		reason, ok := blackoutMap[sk]
+		switch {
+		case !ok:
+			blackoutMap[sk] = b.Reason
+		case cfg.Output.CombineBlackoutReasons && !slices.Contains(strings.Split(reason, " / "), b.Reason):
+			blackoutMap[sk] = reason + " / " + b.Reason
+		}
 	}
 
 	// Collect all unique (date, time) pairs from both slots and blackouts
@@ -184,79 +317,170 @@ func writeMasterSheet(f *excelize.File, cfg *config.Config, result *schedule.Res
 			timeSlots = append(timeSlots, ts)
 		}
 	}
+	// Games placed outside the generated slots (e.g., merged from another
+	// workbook) still need a row.
+	for _, a := range result.Assignments {
+		ts := timeSlot{a.Slot.Date, a.Slot.Time}
+		if !seen[ts] {
+			seen[ts] = true
+			timeSlots = append(timeSlots, ts)
+		}
+	}
 
 	sort.Slice(timeSlots, func(i, j int) bool {
 		if !timeSlots[i].date.Equal(timeSlots[j].date) {
 			return timeSlots[i].date.Before(timeSlots[j].date)
 		}
-		return timeSlots[i].time < timeSlots[j].time
+		return config.CompareTimes(timeSlots[i].time, timeSlots[j].time) < 0
 	})
 
 	for i, ts := range timeSlots {
 		row := i + 2
 		f.SetCellValue(sheet, cellRef(1, row), ts.date.Format("01/02/2006"))
-		f.SetCellValue(sheet, cellRef(2, row), ts.date.Format("Mon"))
+		f.SetCellValue(sheet, cellRef(2, row), cfg.Output.DayName(ts.date))
 		f.SetCellValue(sheet, cellRef(3, row), ts.time)
 
+		var rounds []int
 		for fi, fname := range fieldNames {
 			col := fi + 4 // 1-indexed, after Date/Day/Time
 			sk := slotKey{ts.date, ts.time, fname}
 
 			if a, ok := assignmentMap[sk]; ok {
 				f.SetCellValue(sheet, cellRef(col, row), fmt.Sprintf("%s @ %s", a.Game.Away, a.Game.Home))
+				if a.Game.Note != "" {
+					if err := addNote(f, sheet, cellRef(col, row), a.Game.Note); err != nil {
+						return 0, err
+					}
+				}
+				if a.Game.Round > 0 && !slices.Contains(rounds, a.Game.Round) {
+					rounds = append(rounds, a.Game.Round)
+				}
 			} else if reason, ok := blackoutMap[sk]; ok {
 				f.SetCellValue(sheet, cellRef(col, row), reason)
+			} else if notOffered(cfg, fname, ts.date, ts.time) {
+				f.SetCellValue(sheet, cellRef(col, row), NotOffered)
 			}
 		}
+		if roundCol > 0 && len(rounds) > 0 {
+			f.SetCellValue(sheet, cellRef(roundCol, row), formatRounds(rounds))
+		}
+		if label := cfg.TimeSlots.Label(ts.date, ts.time); labelCol > 0 && label != "" {
+			f.SetCellValue(sheet, cellRef(labelCol, row), label)
+		}
 
 		if cellStyle != 0 {
 			for col := 1; col <= 3; col++ {
 				f.SetCellStyle(sheet, cellRef(col, row), cellRef(col, row), cellStyle)
 			}
-			for col := 4; col <= len(headers); col++ {
+			for col := 4; col <= len(headers); col++ { // field columns, then Round and Slot
 				f.SetCellStyle(sheet, cellRef(col, row), cellRef(col, row), fieldCellStyle)
 			}
 		}
 	}
 
-	// Set column widths (sized for Arial 16)
-	f.SetColWidth(sheet, "A", "A", 18)
-	f.SetColWidth(sheet, "B", "B", 8)
-	f.SetColWidth(sheet, "C", "C", 10)
+	// Set column widths (sized for the base font)
+	f.SetColWidth(sheet, "A", "A", colWidth(cfg.Style, 18))
+	f.SetColWidth(sheet, "B", "B", colWidth(cfg.Style, dayColWidth(cfg)))
+	f.SetColWidth(sheet, "C", "C", colWidth(cfg.Style, 10))
+	for _, c := range []int{roundCol, labelCol} {
+		if c > 0 {
+			col := colLetter(c)
+			f.SetColWidth(sheet, col, col, colWidth(cfg.Style, 10))
+		}
+	}
 	for i := range fieldNames {
 		col := colLetter(i + 4)
-		f.SetColWidth(sheet, col, col, 30)
+		f.SetColWidth(sheet, col, col, colWidth(cfg.Style, 30))
 	}
 
-	// Conditional formatting: non-game cells in field columns get light red
+	// Conditional formatting: blackout and reservation cells (text that isn't
+	// a game) get light red; open slots optionally get light green
 	lastRow := len(timeSlots) + 1
-	redFill, _ := f.NewConditionalStyle(&excelize.Style{
-		Fill: excelize.Fill{Type: "pattern", Pattern: 1, Color: []string{"FFC7CE"}},
-		Font: &excelize.Font{Size: 16, Family: "Arial"},
-	})
+	var rules []excelize.ConditionalFormatOptions
+	if cfg.Style.BlackoutsHighlighted() {
+		redFill, _ := f.NewConditionalStyle(&excelize.Style{
+			Fill: excelize.Fill{Type: "pattern", Pattern: 1, Color: []string{"FFC7CE"}},
+			Font: &excelize.Font{Size: cfg.Style.Size(), Family: cfg.Style.Family()},
+		})
+		criteria := `AND(%[1]s<>"",ISERROR(FIND(" @ ",%[1]s)))`
+		if fieldTimeSlots(cfg) {
+			criteria = `AND(%[1]s<>"",%[1]s<>"` + NotOffered + `",ISERROR(FIND(" @ ",%[1]s)))`
+		}
+		rules = append(rules, excelize.ConditionalFormatOptions{
+			Type:     "formula",
+			Criteria: criteria,
+			Format:   &redFill,
+		})
+	}
+	if cfg.Style.HighlightEmpty {
+		greenFill, _ := f.NewConditionalStyle(&excelize.Style{
+			Fill: excelize.Fill{Type: "pattern", Pattern: 1, Color: []string{"C6EFCE"}},
+			Font: &excelize.Font{Size: cfg.Style.Size(), Family: cfg.Style.Family()},
+		})
+		rules = append(rules, excelize.ConditionalFormatOptions{
+			Type:     "formula",
+			Criteria: `%[1]s=""`,
+			Format:   &greenFill,
+		})
+	}
+	if len(rules) == 0 {
+		return lastRow, nil
+	}
 	for i := range fieldNames {
 		col := colLetter(i + 4)
 		cellRange := fmt.Sprintf("%s2:%s%d", col, col, lastRow)
 		topCell := fmt.Sprintf("%s2", col)
-		formula := fmt.Sprintf(`AND(%s<>"",ISERROR(FIND(" @ ",%s)))`, topCell, topCell)
-		f.SetConditionalFormat(sheet, cellRange, []excelize.ConditionalFormatOptions{
-			{
-				Type:     "formula",
-				Criteria: formula,
-				Format:   &redFill,
-			},
-		})
+		opts := make([]excelize.ConditionalFormatOptions, len(rules))
+		for j, r := range rules {
+			r.Criteria = fmt.Sprintf(r.Criteria, topCell)
+			opts[j] = r
+		}
+		f.SetConditionalFormat(sheet, cellRange, opts)
 	}
 
 	return lastRow, nil
 }
 
+// NotOffered marks a master sheet cell whose field has no slot at that
+// row's time, because another field's time_slots added the row.
+const NotOffered = "—"
+
+// notOffered reports whether the row at hhmm on d exists for another field
+// but field doesn't offer that time.
+func notOffered(cfg *config.Config, field string, d time.Time, hhmm string) bool {
+	return slices.Contains(cfg.TimesForDay(d), hhmm) && !slices.Contains(cfg.FieldTimesForDay(field, d), hhmm)
+}
+
+// fieldTimeSlots reports whether any field overrides time_slots, so rows
+// may hold cells marked NotOffered.
+func fieldTimeSlots(cfg *config.Config) bool {
+	return slices.ContainsFunc(cfg.Fields, func(f config.Field) bool { return f.TimeSlots != nil })
+}
+
+// ExtraColumn reports whether a master sheet header past Time is one of the
+// optional Round and Slot columns rather than a field.
+func ExtraColumn(header string) bool {
+	return slices.Contains(config.ExtraColumns, header)
+}
+
+// formatRounds lists the rounds played in a master sheet row, lowest
+// first: "3", or "3, 5" when games from different rounds share a time.
+func formatRounds(rounds []int) string {
+	slices.Sort(rounds)
+	parts := make([]string, len(rounds))
+	for i, r := range rounds {
+		parts[i] = strconv.Itoa(r)
+	}
+	return strings.Join(parts, ", ")
+}
+
 type gameEntry struct {
 	Date  time.Time
 	Time  string
 	Field string
 	Home  string
 	Away  string
+	Note  string
 }
 
 func writeTeamSheets(f *excelize.File, cfg *config.Config, games []gameEntry) error {
@@ -265,32 +489,31 @@ func writeTeamSheets(f *excelize.File, cfg *config.Config, games []gameEntry) er
 		if !games[i].Date.Equal(games[j].Date) {
 			return games[i].Date.Before(games[j].Date)
 		}
-		return games[i].Time < games[j].Time
+		return config.CompareTimes(games[i].Time, games[j].Time) < 0
 	})
 
+	hasNotes := slices.ContainsFunc(games, func(g gameEntry) bool { return g.Note != "" })
+
 	for _, team := range cfg.AllTeams() {
 		sheet := team
 		f.NewSheet(sheet)
 
 		headers := []string{"Date", "Day", "Time", "Field", "Opponent", "Home/Away", "Game"}
+		if hasNotes {
+			headers = append(headers, "Note")
+		}
 		for i, h := range headers {
 			f.SetCellValue(sheet, cellRef(i+1, 1), h)
 		}
 
-		headerStyle, _ := f.NewStyle(&excelize.Style{
-			Font:      &excelize.Font{Bold: true, Color: "#FFFFFF", Size: 16, Family: "Arial"},
-			Fill:      excelize.Fill{Type: "pattern", Pattern: 1, Color: []string{"#4472C4"}},
-			Alignment: &excelize.Alignment{Horizontal: "center"},
-		})
+		headerStyle := newHeaderStyle(f, cfg.Style)
 		if headerStyle != 0 {
 			for i := range headers {
 				f.SetCellStyle(sheet, cellRef(i+1, 1), cellRef(i+1, 1), headerStyle)
 			}
 		}
 
-		cellStyle, _ := f.NewStyle(&excelize.Style{
-			Font: &excelize.Font{Size: 16, Family: "Arial"},
-		})
+		cellStyle := newCellStyle(f, cfg.Style)
 
 		row := 2
 		for _, g := range games {
@@ -306,15 +529,18 @@ func writeTeamSheets(f *excelize.File, cfg *config.Config, games []gameEntry) er
 			}
 
 			f.SetCellValue(sheet, cellRef(1, row), g.Date.Format("01/02/2006"))
-			f.SetCellValue(sheet, cellRef(2, row), g.Date.Format("Mon"))
+			f.SetCellValue(sheet, cellRef(2, row), cfg.Output.DayName(g.Date))
 			f.SetCellValue(sheet, cellRef(3, row), g.Time)
 			f.SetCellValue(sheet, cellRef(4, row), g.Field)
 			f.SetCellValue(sheet, cellRef(5, row), opponent)
 			f.SetCellValue(sheet, cellRef(6, row), ha)
 			f.SetCellValue(sheet, cellRef(7, row), fmt.Sprintf("%s @ %s", g.Away, g.Home))
+			if hasNotes {
+				f.SetCellValue(sheet, cellRef(8, row), g.Note)
+			}
 
 			if cellStyle != 0 {
-				for col := 1; col <= 7; col++ {
+				for col := 1; col <= len(headers); col++ {
 					f.SetCellStyle(sheet, cellRef(col, row), cellRef(col, row), cellStyle)
 				}
 			}
@@ -322,25 +548,460 @@ func writeTeamSheets(f *excelize.File, cfg *config.Config, games []gameEntry) er
 		}
 
 		// Set column widths
-		widths := map[string]float64{"A": 18, "B": 8, "C": 10, "D": 28, "E": 16, "F": 14, "G": 28}
+		widths := map[string]float64{"A": 18, "B": dayColWidth(cfg), "C": 10, "D": 28, "E": 16, "F": 14, "G": 28}
+		if hasNotes {
+			widths["H"] = 36
+		}
 		for col, w := range widths {
-			f.SetColWidth(sheet, col, col, w)
+			f.SetColWidth(sheet, col, col, colWidth(cfg.Style, w))
 		}
 	}
 
 	return nil
 }
 
-func readGamesFromMaster(f *excelize.File) ([]gameEntry, error) {
+const gridSheet = "Grid"
+
+// writeGridSheet writes a compact season-at-a-glance view: one row per team,
+// one column per game date, each cell holding the opponent's abbreviation
+// (both opponents, "CUB / PAD", on a day the team plays twice).
+func writeGridSheet(f *excelize.File, cfg *config.Config, games []gameEntry) error {
+	sheet := gridSheet
+	if _, err := f.NewSheet(sheet); err != nil {
+		return err
+	}
+
+	teams := cfg.AllTeams()
+	abbrevs := teamAbbreviations(teams)
+
+	seen := make(map[time.Time]bool)
+	var dates []time.Time
+	for _, g := range games {
+		if !seen[g.Date] {
+			seen[g.Date] = true
+			dates = append(dates, g.Date)
+		}
+	}
+	sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+	dateCol := make(map[time.Time]int)
+	for i, d := range dates {
+		dateCol[d] = i + 2
+	}
+
+	headerStyle := newHeaderStyle(f, cfg.Style)
+	cellStyle := newCellStyle(f, cfg.Style)
+	centeredStyle := newCenteredCellStyle(f, cfg.Style)
+
+	f.SetCellValue(sheet, cellRef(1, 1), "Team")
+	for i, d := range dates {
+		f.SetCellValue(sheet, cellRef(i+2, 1), d.Format("01/02"))
+	}
+	if headerStyle != 0 {
+		f.SetCellStyle(sheet, cellRef(1, 1), cellRef(len(dates)+1, 1), headerStyle)
+	}
+
+	teamRow := make(map[string]int)
+	for i, team := range teams {
+		row := i + 2
+		teamRow[team] = row
+		f.SetCellValue(sheet, cellRef(1, row), team)
+		if cellStyle != 0 {
+			f.SetCellStyle(sheet, cellRef(1, row), cellRef(1, row), cellStyle)
+		}
+		if centeredStyle != 0 && len(dates) > 0 {
+			f.SetCellStyle(sheet, cellRef(2, row), cellRef(len(dates)+1, row), centeredStyle)
+		}
+	}
+
+	// A team playing twice in a day lists both opponents in its cell
+	opponents := make(map[string][]string) // cell -> opponent abbreviations
+	var cells []string
+	for _, g := range games {
+		col := dateCol[g.Date]
+		for _, side := range [][2]string{{g.Home, g.Away}, {g.Away, g.Home}} {
+			row, ok := teamRow[side[0]]
+			if !ok {
+				continue
+			}
+			cell := cellRef(col, row)
+			if opponents[cell] == nil {
+				cells = append(cells, cell)
+			}
+			opponents[cell] = append(opponents[cell], abbrevs[side[1]])
+		}
+	}
+	for _, cell := range cells {
+		f.SetCellValue(sheet, cell, strings.Join(opponents[cell], " / "))
+	}
+
+	f.SetColWidth(sheet, "A", "A", colWidth(cfg.Style, 18))
+	if len(dates) > 0 {
+		f.SetColWidth(sheet, "B", colLetter(len(dates)+1), colWidth(cfg.Style, 9))
+	}
+	return nil
+}
+
+const contactsSheet = "Contacts"
+
+// writeContactsSheet lists each team's coach, email, and game count, one
+// row per team, for mail-merging schedules out to coaches.
+func writeContactsSheet(f *excelize.File, cfg *config.Config, games []gameEntry) error {
+	sheet := contactsSheet
+	if _, err := f.NewSheet(sheet); err != nil {
+		return err
+	}
+
+	counts := make(map[string]int)
+	for _, g := range games {
+		counts[g.Home]++
+		counts[g.Away]++
+	}
+
+	headers := []string{"Team", "Coach", "Email", "Games"}
+	for i, h := range headers {
+		f.SetCellValue(sheet, cellRef(i+1, 1), h)
+	}
+	if headerStyle := newHeaderStyle(f, cfg.Style); headerStyle != 0 {
+		f.SetCellStyle(sheet, cellRef(1, 1), cellRef(len(headers), 1), headerStyle)
+	}
+
+	cellStyle := newCellStyle(f, cfg.Style)
+	for i, team := range cfg.AllTeams() {
+		row := i + 2
+		t := cfg.Team(team)
+		f.SetCellValue(sheet, cellRef(1, row), team)
+		f.SetCellValue(sheet, cellRef(2, row), t.Coach)
+		f.SetCellValue(sheet, cellRef(3, row), t.Email)
+		f.SetCellValue(sheet, cellRef(4, row), counts[team])
+		if cellStyle != 0 {
+			f.SetCellStyle(sheet, cellRef(1, row), cellRef(len(headers), row), cellStyle)
+		}
+	}
+
+	widths := map[string]float64{"A": 18, "B": 24, "C": 32, "D": 8}
+	for col, w := range widths {
+		f.SetColWidth(sheet, col, col, colWidth(cfg.Style, w))
+	}
+	return nil
+}
+
+const summarySheet = "Summary"
+
+// writeSummarySheet lists each team's game counts along with its longest
+// homestand and road trip, one row per team.
+func writeSummarySheet(f *excelize.File, cfg *config.Config, games []gameEntry) error {
+	sheet := summarySheet
+	if _, err := f.NewSheet(sheet); err != nil {
+		return err
+	}
+
+	ordered := slices.Clone(games)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if !ordered[i].Date.Equal(ordered[j].Date) {
+			return ordered[i].Date.Before(ordered[j].Date)
+		}
+		return config.CompareTimes(ordered[i].Time, ordered[j].Time) < 0
+	})
+	type counts struct {
+		games, home, away, sat, sun int
+		homes                       []bool
+	}
+	byTeam := make(map[string]*counts)
+	for _, team := range cfg.AllTeams() {
+		byTeam[team] = &counts{}
+	}
+	for _, g := range ordered {
+		for _, team := range []string{g.Home, g.Away} {
+			c, ok := byTeam[team]
+			if !ok {
+				continue
+			}
+			c.games++
+			if team == g.Home {
+				c.home++
+			} else {
+				c.away++
+			}
+			c.homes = append(c.homes, team == g.Home)
+			switch g.Date.Weekday() {
+			case time.Saturday:
+				c.sat++
+			case time.Sunday:
+				c.sun++
+			}
+		}
+	}
+
+	headers := []string{"Team", "Games", "Home", "Away", "Saturday", "Sunday", "Longest homestand", "Longest road trip"}
+	for i, h := range headers {
+		f.SetCellValue(sheet, cellRef(i+1, 1), h)
+	}
+	if headerStyle := newHeaderStyle(f, cfg.Style); headerStyle != 0 {
+		f.SetCellStyle(sheet, cellRef(1, 1), cellRef(len(headers), 1), headerStyle)
+	}
+
+	cellStyle := newCellStyle(f, cfg.Style)
+	for i, team := range cfg.AllTeams() {
+		row := i + 2
+		c := byTeam[team]
+		homestand, roadTrip := schedule.HomeAwayStreaks(c.homes)
+		for col, v := range []any{team, c.games, c.home, c.away, c.sat, c.sun, homestand, roadTrip} {
+			f.SetCellValue(sheet, cellRef(col+1, row), v)
+		}
+		if cellStyle != 0 {
+			f.SetCellStyle(sheet, cellRef(1, row), cellRef(len(headers), row), cellStyle)
+		}
+	}
+
+	f.SetColWidth(sheet, "A", "A", colWidth(cfg.Style, 18))
+	f.SetColWidth(sheet, "B", "F", colWidth(cfg.Style, 9))
+	f.SetColWidth(sheet, "G", "H", colWidth(cfg.Style, 18))
+	return nil
+}
+
+const legendSheet = "Legend"
+
+// writeLegendSheet explains the workbook to whoever inherits it: what the
+// master sheet's colors and cells mean, the full name behind each field
+// column, and, with the grid on, each team's abbreviation.
+func writeLegendSheet(f *excelize.File, cfg *config.Config) error {
+	sheet := legendSheet
+	if _, err := f.NewSheet(sheet); err != nil {
+		return err
+	}
+
+	headerStyle := newHeaderStyle(f, cfg.Style)
+	cellStyle := newCellStyle(f, cfg.Style)
+	row := 0
+	section := func(a, b string) {
+		row++
+		if row > 1 {
+			row++ // blank line between sections
+		}
+		f.SetCellValue(sheet, cellRef(1, row), a)
+		f.SetCellValue(sheet, cellRef(2, row), b)
+		if headerStyle != 0 {
+			f.SetCellStyle(sheet, cellRef(1, row), cellRef(2, row), headerStyle)
+		}
+	}
+	line := func(a, b string, fill string) {
+		row++
+		f.SetCellValue(sheet, cellRef(1, row), a)
+		f.SetCellValue(sheet, cellRef(2, row), b)
+		if cellStyle != 0 {
+			f.SetCellStyle(sheet, cellRef(1, row), cellRef(2, row), cellStyle)
+		}
+		if fill != "" {
+			filled, _ := f.NewStyle(&excelize.Style{
+				Font: &excelize.Font{Size: cfg.Style.Size(), Family: cfg.Style.Family()},
+				Fill: excelize.Fill{Type: "pattern", Pattern: 1, Color: []string{fill}},
+			})
+			f.SetCellStyle(sheet, cellRef(1, row), cellRef(1, row), filled)
+		}
+	}
+
+	section("Master Schedule", "Meaning")
+	line("Away @ Home", "A game: the visiting team, then the home team", "")
+	if cfg.Style.BlackoutsHighlighted() {
+		line("Red cell", "Blackout or field reservation; the text gives the reason", "FFC7CE")
+	} else {
+		line("Other text", "Blackout or field reservation; the text gives the reason", "")
+	}
+	if cfg.Style.HighlightEmpty {
+		line("Green cell", "Open slot with no game", "C6EFCE")
+	} else {
+		line("Empty cell", "Open slot with no game", "")
+	}
+	if fieldTimeSlots(cfg) {
+		line(NotOffered, "The field has no slot at that time", "")
+	}
+	if cfg.Output.Rounds {
+		line("Round", "Bracket rounds played in that row", "")
+	}
+	if len(cfg.TimeSlots.Labels) > 0 {
+		line("Slot", "The name posted at the field for that row's start time", "")
+	}
+
+	var fieldNames []string
+	for _, field := range cfg.Fields {
+		fieldNames = append(fieldNames, field.Name)
+	}
+	section("Field column", "Field")
+	for _, name := range fieldNames {
+		line(FieldColumnName(name, fieldNames), name, "")
+	}
+
+	if cfg.Output.Grid {
+		teams := cfg.AllTeams()
+		abbrevs := teamAbbreviations(teams)
+		section("Grid code", "Team")
+		for _, team := range teams {
+			line(abbrevs[team], team, "")
+		}
+	}
+
+	f.SetColWidth(sheet, "A", "A", colWidth(cfg.Style, 20))
+	f.SetColWidth(sheet, "B", "B", colWidth(cfg.Style, 60))
+	return nil
+}
+
+// teamAbbreviations returns a short uppercase code for each team: the
+// shortest prefix of at least three letters that no other team shares.
+// Spaces are skipped, so "Red Sox" next to "Red" becomes REDS, and names are
+// cut by letter, never inside a multibyte character.
+func teamAbbreviations(teams []string) map[string]string {
+	letters := make(map[string][]rune)
+	for _, team := range teams {
+		letters[team] = []rune(strings.ToUpper(strings.ReplaceAll(team, " ", "")))
+	}
+	abbrevs := make(map[string]string)
+	for _, team := range teams {
+		code := letters[team]
+		n := 3
+		for ; n < len(code); n++ {
+			unique := true
+			for _, other := range teams {
+				if o := letters[other]; other != team && len(o) >= n && string(o[:n]) == string(code[:n]) {
+					unique = false
+					break
+				}
+			}
+			if unique {
+				break
+			}
+		}
+		abbrevs[team] = string(code[:min(n, len(code))])
+	}
+	return abbrevs
+}
+
+// IsCSV reports whether path names a CSV export of the master sheet rather
+// than a workbook.
+func IsCSV(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".csv")
+}
+
+// ReadMasterRows returns the rows of a master schedule, header first. Paths
+// ending in .csv are read as a CSV export of the master sheet, such as a
+// Google Sheets download, with the same Date, Day, Time, and field columns;
+// dates may be written 5/4/2026 or 2026-05-04 and are returned as 05/04/2026,
+// and times may be written 17:45:00 or 5:45 PM and are returned as 17:45,
+// like the workbook's. Anything else is opened as a workbook.
+func ReadMasterRows(path string) ([][]string, error) {
+	rows, _, err := readMaster(path)
+	return rows, err
+}
+
+// readMaster reads the master schedule's rows, as ReadMasterRows does, along
+// with its game notes keyed by cell reference. CSV exports carry no notes.
+func readMaster(path string) ([][]string, map[string]string, error) {
+	if IsCSV(path) {
+		rows, err := readMasterCSV(path)
+		return rows, nil, err
+	}
+
+	f, err := excelize.OpenFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("opening file: %w", err)
+	}
+	defer f.Close()
+	rows, err := masterRows(f)
+	if err != nil {
+		return nil, nil, err
+	}
+	notes, err := masterNotes(f)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rows, notes, nil
+}
+
+// noteAuthor is the author rbrl writes on game note comments.
+const noteAuthor = "rbrl"
+
+// addNote attaches a game's note to its cell as a comment, which keeps
+// the master grid to one game per cell.
+func addNote(f *excelize.File, sheet, cell, note string) error {
+	return f.AddComment(sheet, excelize.Comment{
+		Cell:      cell,
+		Author:    noteAuthor,
+		Paragraph: []excelize.RichTextRun{{Text: note}},
+	})
+}
+
+// masterNotes returns the text of each comment on the master sheet, keyed
+// by cell reference.
+func masterNotes(f *excelize.File) (map[string]string, error) {
+	comments, err := f.GetComments("Master Schedule")
+	if err != nil {
+		return nil, fmt.Errorf("reading Master Schedule comments: %w", err)
+	}
+	notes := make(map[string]string)
+	for _, c := range comments {
+		text := c.Text
+		for _, run := range c.Paragraph {
+			text += run.Text
+		}
+		notes[c.Cell] = strings.TrimSpace(text)
+	}
+	return notes, nil
+}
+
+func masterRows(f *excelize.File) ([][]string, error) {
 	rows, err := f.GetRows("Master Schedule")
 	if err != nil {
 		return nil, fmt.Errorf("reading Master Schedule: %w", err)
 	}
-
 	if len(rows) == 0 {
 		return nil, fmt.Errorf("Master Schedule is empty")
 	}
+	return rows, nil
+}
+
+func readMasterCSV(path string) ([][]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening file: %w", err)
+	}
+	defer file.Close()
+
+	r := csv.NewReader(file)
+	r.FieldsPerRecord = -1
+	rows, err := r.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", filepath.Base(path), err)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%s is empty", filepath.Base(path))
+	}
 
+	rows[0][0] = strings.TrimPrefix(rows[0][0], "\ufeff") // spreadsheet exports may start with a BOM
+	for _, row := range rows[1:] {
+		if len(row) == 0 {
+			continue
+		}
+		for _, layout := range []string{"1/2/2006", "2006-01-02"} {
+			if d, err := time.Parse(layout, strings.TrimSpace(row[0])); err == nil {
+				row[0] = d.Format("01/02/2006")
+				break
+			}
+		}
+		if len(row) < 3 {
+			continue
+		}
+		for _, layout := range []string{"15:04", "15:04:05", "3:04 PM", "3:04:05 PM", "3:04PM"} {
+			if t, err := time.Parse(layout, strings.ToUpper(strings.TrimSpace(row[2]))); err == nil {
+				row[2] = t.Format("15:04")
+				break
+			}
+		}
+	}
+	return rows, nil
+}
+
+// readGamesFromMaster parses the games in master rows, header first. notes
+// holds game notes by cell reference and may be nil.
+func readGamesFromMaster(rows [][]string, notes map[string]string) []gameEntry {
 	header := rows[0]
 	var games []gameEntry
 	for i, row := range rows {
@@ -352,7 +1013,7 @@ func readGamesFromMaster(f *excelize.File) ([]gameEntry, error) {
 			continue
 		}
 		for fi := 3; fi < len(header) && fi < len(row); fi++ {
-			if row[fi] == "" {
+			if row[fi] == "" || ExtraColumn(header[fi]) {
 				continue
 			}
 			away, home, ok := parseGameCell(row[fi])
@@ -365,10 +1026,11 @@ func readGamesFromMaster(f *excelize.File) ([]gameEntry, error) {
 				Field: header[fi],
 				Home:  home,
 				Away:  away,
+				Note:  notes[cellRef(fi+1, i+1)],
 			})
 		}
 	}
-	return games, nil
+	return games
 }
 
 func parseGameCell(cell string) (away, home string, ok bool) {
@@ -380,6 +1042,44 @@ func parseGameCell(cell string) (away, home string, ok bool) {
 	return "", "", false
 }
 
+func newHeaderStyle(f *excelize.File, st config.Style) int {
+	style, _ := f.NewStyle(&excelize.Style{
+		Font:      &excelize.Font{Bold: true, Color: "#FFFFFF", Size: st.Size(), Family: st.Family()},
+		Fill:      excelize.Fill{Type: "pattern", Pattern: 1, Color: []string{st.Fill()}},
+		Alignment: &excelize.Alignment{Horizontal: "center"},
+	})
+	return style
+}
+
+func newCellStyle(f *excelize.File, st config.Style) int {
+	style, _ := f.NewStyle(&excelize.Style{
+		Font: &excelize.Font{Size: st.Size(), Family: st.Family()},
+	})
+	return style
+}
+
+func newCenteredCellStyle(f *excelize.File, st config.Style) int {
+	style, _ := f.NewStyle(&excelize.Style{
+		Font:      &excelize.Font{Size: st.Size(), Family: st.Family()},
+		Alignment: &excelize.Alignment{Horizontal: "center"},
+	})
+	return style
+}
+
+// colWidth scales a column width chosen for the default 16pt font to the
+// configured font size.
+func colWidth(st config.Style, width float64) float64 {
+	return width * st.Size() / 16
+}
+
+// dayColWidth returns the Day column's width, wider for long day names.
+func dayColWidth(cfg *config.Config) float64 {
+	if cfg.Output.DayFormat == "long" {
+		return 20
+	}
+	return 8
+}
+
 func cellRef(col, row int) string {
 	return fmt.Sprintf("%s%d", colLetter(col), row)
 }
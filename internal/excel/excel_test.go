@@ -1,8 +1,13 @@
 package excel
 
 import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"github.com/derekprior/rbrl/internal/config"
 	"github.com/derekprior/rbrl/internal/schedule"
@@ -55,7 +60,7 @@ func testData() (*config.Config, *schedule.Result) {
 				Slot: schedule.Slot{Date: time.Date(2026, 4, 25, 0, 0, 0, 0, time.UTC), Time: "12:30", Field: "Field B"},
 			},
 		},
-		Warnings: []string{"test warning"},
+		Warnings: []schedule.Warning{{Message: "test warning"}},
 	}
 
 	return cfg, result
@@ -232,3 +237,692 @@ func TestUpdateTeamSheets(t *testing.T) {
 		t.Errorf("Astros G2 after update = %q, want Padres @ Astros", val)
 	}
 }
+
+func TestGameNotes(t *testing.T) {
+	cfg, result := testData()
+	result.Assignments[0].Game.Note = "Opening Day Ceremony"
+	f, err := Generate(cfg, result, schedule.GenerateSlots(cfg), schedule.GenerateBlackoutSlots(cfg))
+	if err != nil {
+		t.Fatalf("Generate() error: %v", err)
+	}
+	path := t.TempDir() + "/test.xlsx"
+	if err := f.SaveAs(path); err != nil {
+		t.Fatalf("SaveAs error: %v", err)
+	}
+
+	t.Run("master sheet comment", func(t *testing.T) {
+		notes, err := masterNotes(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(notes) != 1 || notes["D2"] != "Opening Day Ceremony" {
+			t.Errorf("notes = %v, want the note on D2", notes)
+		}
+	})
+
+	t.Run("team sheet column", func(t *testing.T) {
+		if got, _ := f.GetCellValue("Angels", "H1"); got != "Note" {
+			t.Errorf("H1 = %q, want Note", got)
+		}
+		if got, _ := f.GetCellValue("Angels", "H2"); got != "Opening Day Ceremony" {
+			t.Errorf("H2 = %q, want the note", got)
+		}
+		if got, _ := f.GetCellValue("Astros", "H2"); got != "" {
+			t.Errorf("Astros H2 = %q, want no note", got)
+		}
+	})
+
+	t.Run("read back and kept by UpdateTeamSheets", func(t *testing.T) {
+		assignments, err := ReadAssignments(path, cfg)
+		if err != nil {
+			t.Fatalf("ReadAssignments() error: %v", err)
+		}
+		var notes []string
+		for _, a := range assignments {
+			if a.Game.Note != "" {
+				notes = append(notes, a.Game.Away+" @ "+a.Game.Home+": "+a.Game.Note)
+			}
+		}
+		if !slices.Equal(notes, []string{"Cubs @ Angels: Opening Day Ceremony"}) {
+			t.Errorf("notes = %v", notes)
+		}
+
+		if err := UpdateTeamSheets(path, cfg); err != nil {
+			t.Fatalf("UpdateTeamSheets() error: %v", err)
+		}
+		f2, err := excelize.OpenFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f2.Close()
+		if got, _ := f2.GetCellValue("Angels", "H2"); got != "Opening Day Ceremony" {
+			t.Errorf("Angels H2 after update = %q, want the note", got)
+		}
+	})
+
+	t.Run("no note column without notes", func(t *testing.T) {
+		cfg, result := testData()
+		f, err := Generate(cfg, result, schedule.GenerateSlots(cfg), schedule.GenerateBlackoutSlots(cfg))
+		if err != nil {
+			t.Fatalf("Generate() error: %v", err)
+		}
+		if got, _ := f.GetCellValue("Angels", "H1"); got != "" {
+			t.Errorf("H1 = %q, want no Note column", got)
+		}
+	})
+}
+
+func TestGridSheet(t *testing.T) {
+	cfg, result := testData()
+	slots := schedule.GenerateSlots(cfg)
+	blackouts := schedule.GenerateBlackoutSlots(cfg)
+
+	t.Run("omitted by default", func(t *testing.T) {
+		f, err := Generate(cfg, result, slots, blackouts)
+		if err != nil {
+			t.Fatalf("Generate() error: %v", err)
+		}
+		if idx, _ := f.GetSheetIndex("Grid"); idx >= 0 {
+			t.Error("Grid sheet should not be generated unless enabled")
+		}
+	})
+
+	cfg.Output.Grid = true
+	f, err := Generate(cfg, result, slots, blackouts)
+	if err != nil {
+		t.Fatalf("Generate() error: %v", err)
+	}
+
+	t.Run("has one column per game date", func(t *testing.T) {
+		val, _ := f.GetCellValue("Grid", "B1")
+		if val != "04/25" {
+			t.Errorf("B1 = %q, want 04/25", val)
+		}
+		val, _ = f.GetCellValue("Grid", "C1")
+		if val != "" {
+			t.Errorf("C1 = %q, want empty (only one game date)", val)
+		}
+	})
+
+	t.Run("cells hold opponent abbreviations", func(t *testing.T) {
+		want := map[string]string{"A2": "Angels", "B2": "CUB", "B3": "PAD", "B4": "ANG", "B5": "AST"}
+		for cell, v := range want {
+			got, _ := f.GetCellValue("Grid", cell)
+			if got != v {
+				t.Errorf("%s = %q, want %q", cell, got, v)
+			}
+		}
+	})
+
+	t.Run("doubleheaders list both opponents", func(t *testing.T) {
+		cfg.TimeSlots.Saturday = []string{"12:30", "14:45"}
+		twice := *result
+		twice.Assignments = append(slices.Clone(result.Assignments), schedule.Assignment{
+			Game: strategy.Game{Home: "Angels", Away: "Padres", Label: "Game 3"},
+			Slot: schedule.Slot{Date: time.Date(2026, 4, 25, 0, 0, 0, 0, time.UTC), Time: "14:45", Field: "Field A"},
+		})
+		f, err := Generate(cfg, &twice, schedule.GenerateSlots(cfg), blackouts)
+		if err != nil {
+			t.Fatalf("Generate() error: %v", err)
+		}
+		want := map[string]string{"B2": "CUB / PAD", "B4": "ANG", "B5": "AST / ANG"}
+		for cell, v := range want {
+			if got, _ := f.GetCellValue("Grid", cell); got != v {
+				t.Errorf("%s = %q, want %q", cell, got, v)
+			}
+		}
+	})
+}
+
+func TestDayColumn(t *testing.T) {
+	cfg, result := testData()
+	slots := schedule.GenerateSlots(cfg)
+	blackouts := schedule.GenerateBlackoutSlots(cfg)
+
+	tests := []struct {
+		output config.Output
+		want   string
+	}{
+		{config.Output{}, "Sat"},
+		{config.Output{DayFormat: "long"}, "Saturday"},
+		{config.Output{DayFormat: "long", Locale: "fr"}, "samedi"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			cfg.Output = tt.output
+			f, err := Generate(cfg, result, slots, blackouts)
+			if err != nil {
+				t.Fatalf("Generate() error: %v", err)
+			}
+			if got, _ := f.GetCellValue("Master Schedule", "B2"); got != tt.want {
+				t.Errorf("master B2 = %q, want %q", got, tt.want)
+			}
+			if got, _ := f.GetCellValue("Angels", "B2"); got != tt.want {
+				t.Errorf("Angels B2 = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSummarySheet(t *testing.T) {
+	cfg, result := testData()
+	result.Assignments = append(result.Assignments,
+		schedule.Assignment{
+			Game: strategy.Game{Home: "Angels", Away: "Padres", Label: "Game 3"},
+			Slot: schedule.Slot{Date: time.Date(2026, 4, 27, 0, 0, 0, 0, time.UTC), Time: "17:45", Field: "Field A"},
+		},
+		schedule.Assignment{
+			Game: strategy.Game{Home: "Astros", Away: "Cubs", Label: "Game 4"},
+			Slot: schedule.Slot{Date: time.Date(2026, 4, 26, 0, 0, 0, 0, time.UTC), Time: "17:00", Field: "Field A"},
+		},
+	)
+	f, err := Generate(cfg, result, schedule.GenerateSlots(cfg), schedule.GenerateBlackoutSlots(cfg))
+	if err != nil {
+		t.Fatalf("Generate() error: %v", err)
+	}
+
+	want := map[string][]string{
+		"1": {"Team", "Games", "Home", "Away", "Saturday", "Sunday", "Longest homestand", "Longest road trip"},
+		"2": {"Angels", "2", "2", "0", "1", "0", "2", "0"},
+		"4": {"Cubs", "2", "0", "2", "1", "1", "0", "2"},
+	}
+	for row, cells := range want {
+		for i, v := range cells {
+			cell := colLetter(i+1) + row
+			if got, _ := f.GetCellValue("Summary", cell); got != v {
+				t.Errorf("%s = %q, want %q", cell, got, v)
+			}
+		}
+	}
+}
+
+func TestLegendSheet(t *testing.T) {
+	cfg, result := testData()
+	f, err := Generate(cfg, result, schedule.GenerateSlots(cfg), schedule.GenerateBlackoutSlots(cfg))
+	if err != nil {
+		t.Fatalf("Generate() error: %v", err)
+	}
+	if idx, _ := f.GetSheetIndex(legendSheet); idx < 0 {
+		t.Fatal("expected a Legend sheet")
+	}
+
+	cfg.Fields = []config.Field{{Name: "Moscariello Ballpark"}, {Name: "Washington Park"}}
+	cfg.Style.HighlightEmpty = true
+	cfg.Output.Grid = true
+	f = excelize.NewFile()
+	if err := writeLegendSheet(f, cfg); err != nil {
+		t.Fatalf("writeLegendSheet() error: %v", err)
+	}
+	want := map[string]string{
+		"A2":  "Away @ Home",
+		"A3":  "Red cell",
+		"A4":  "Green cell",
+		"A6":  "Field column",
+		"A7":  "Moscariello",
+		"B7":  "Moscariello Ballpark",
+		"A8":  "Washington",
+		"B8":  "Washington Park",
+		"A10": "Grid code",
+		"A11": "ANG",
+		"B11": "Angels",
+	}
+	for cell, v := range want {
+		if got, _ := f.GetCellValue(legendSheet, cell); got != v {
+			t.Errorf("%s = %q, want %q", cell, got, v)
+		}
+	}
+}
+
+func TestRoundColumn(t *testing.T) {
+	cfg, result := testData()
+	slots := schedule.GenerateSlots(cfg)
+	blackouts := schedule.GenerateBlackoutSlots(cfg)
+	result.Assignments[0].Game.Round = 3
+	result.Assignments[1].Game.Round = 1
+
+	t.Run("omitted by default", func(t *testing.T) {
+		f, err := Generate(cfg, result, slots, blackouts)
+		if err != nil {
+			t.Fatalf("Generate() error: %v", err)
+		}
+		if val, _ := f.GetCellValue("Master Schedule", "F1"); val != "" {
+			t.Errorf("F1 = %q, want no Round column", val)
+		}
+	})
+
+	cfg.Output.Rounds = true
+	f, err := Generate(cfg, result, slots, blackouts)
+	if err != nil {
+		t.Fatalf("Generate() error: %v", err)
+	}
+
+	t.Run("lists each row's rounds after the fields", func(t *testing.T) {
+		for cell, want := range map[string]string{"F1": "Round", "F2": "1, 3", "F3": ""} {
+			if got, _ := f.GetCellValue("Master Schedule", cell); got != want {
+				t.Errorf("%s = %q, want %q", cell, got, want)
+			}
+		}
+	})
+
+	t.Run("is not read back as a field", func(t *testing.T) {
+		rows, err := masterRows(f)
+		if err != nil {
+			t.Fatalf("masterRows() error: %v", err)
+		}
+		if games := readGamesFromMaster(rows, nil); len(games) != 2 {
+			t.Errorf("read %d games, want 2", len(games))
+		}
+	})
+}
+
+func TestSlotLabels(t *testing.T) {
+	cfg, result := testData()
+	slots := schedule.GenerateSlots(cfg)
+	blackouts := schedule.GenerateBlackoutSlots(cfg)
+	cfg.TimeSlots.Labels = map[string]map[string]string{"saturday": {"12:30": "Game 1"}}
+	f, err := Generate(cfg, result, slots, blackouts)
+	if err != nil {
+		t.Fatalf("Generate() error: %v", err)
+	}
+
+	t.Run("names labeled times after the fields", func(t *testing.T) {
+		for cell, want := range map[string]string{"F1": "Slot", "F2": "Game 1", "F3": "", "C2": "12:30"} {
+			if got, _ := f.GetCellValue("Master Schedule", cell); got != want {
+				t.Errorf("%s = %q, want %q", cell, got, want)
+			}
+		}
+	})
+
+	t.Run("is not read back as a field", func(t *testing.T) {
+		rows, err := masterRows(f)
+		if err != nil {
+			t.Fatalf("masterRows() error: %v", err)
+		}
+		games := readGamesFromMaster(rows, nil)
+		if len(games) != 2 {
+			t.Fatalf("read %d games, want 2", len(games))
+		}
+		if games[0].Time != "12:30" {
+			t.Errorf("read time %q, want 12:30", games[0].Time)
+		}
+	})
+
+	t.Run("skips labels that look like games", func(t *testing.T) {
+		rows := [][]string{
+			{"Date", "Day", "Time", "Field A", "Slot", "Round"},
+			{"04/25/2026", "Saturday", "12:30", "Cubs @ Angels", "Away @ Home", "Cubs @ Angels"},
+		}
+		games := readGamesFromMaster(rows, nil)
+		if len(games) != 1 || games[0].Field != "Field A" {
+			t.Errorf("games = %+v, want only the Field A game", games)
+		}
+	})
+}
+
+func TestFieldTimeSlotRows(t *testing.T) {
+	cfg, result := testData()
+	cfg.Fields[1].TimeSlots = &config.FieldTimeSlots{Saturday: []string{"9:00"}}
+	f, err := Generate(cfg, result, schedule.GenerateSlots(cfg), schedule.GenerateBlackoutSlots(cfg))
+	if err != nil {
+		t.Fatalf("Generate() error: %v", err)
+	}
+
+	// Saturday 04/25: Field B's 9:00 row comes first, then the league's 12:30.
+	for cell, want := range map[string]string{"C2": "9:00", "D2": NotOffered, "E2": "", "C3": "12:30"} {
+		if got, _ := f.GetCellValue("Master Schedule", cell); got != want {
+			t.Errorf("%s = %q, want %q", cell, got, want)
+		}
+	}
+	rows, err := masterRows(f)
+	if err != nil {
+		t.Fatalf("masterRows() error: %v", err)
+	}
+	if games := readGamesFromMaster(rows, nil); len(games) != len(result.Assignments) {
+		t.Errorf("read %d games, want %d", len(games), len(result.Assignments))
+	}
+}
+
+func TestContactsSheet(t *testing.T) {
+	cfg, result := testData()
+	slots := schedule.GenerateSlots(cfg)
+	blackouts := schedule.GenerateBlackoutSlots(cfg)
+
+	t.Run("omitted without contact info", func(t *testing.T) {
+		f, err := Generate(cfg, result, slots, blackouts)
+		if err != nil {
+			t.Fatalf("Generate() error: %v", err)
+		}
+		if idx, _ := f.GetSheetIndex("Contacts"); idx >= 0 {
+			t.Error("Contacts sheet should not be generated without coaches or emails")
+		}
+	})
+
+	cfg.Teams = []config.Team{
+		{Name: "Angels", Coach: "Pat Doyle", Email: "pat@example.com"},
+		{Name: "Padres", Email: "padres@example.com"},
+	}
+	f, err := Generate(cfg, result, slots, blackouts)
+	if err != nil {
+		t.Fatalf("Generate() error: %v", err)
+	}
+
+	rows, err := f.GetRows("Contacts")
+	if err != nil {
+		t.Fatalf("GetRows() error: %v", err)
+	}
+	want := [][]string{
+		{"Team", "Coach", "Email", "Games"},
+		{"Angels", "Pat Doyle", "pat@example.com", "1"},
+		{"Astros", "", "", "1"},
+		{"Cubs", "", "", "1"},
+		{"Padres", "", "padres@example.com", "1"},
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("rows = %v, want %v", rows, want)
+	}
+	for i := range want {
+		if !slices.Equal(padRow(rows[i], 4), want[i]) {
+			t.Errorf("row %d = %v, want %v", i+1, rows[i], want[i])
+		}
+	}
+
+	t.Run("UpdateTeamSheets follows config changes", func(t *testing.T) {
+		path := t.TempDir() + "/test.xlsx"
+		if err := f.SaveAs(path); err != nil {
+			t.Fatalf("SaveAs error: %v", err)
+		}
+		changed := *cfg
+		changed.Teams = []config.Team{{Name: "Angels", Coach: "Sam Reyes", Email: "sam@example.com"}}
+		if err := UpdateTeamSheets(path, &changed); err != nil {
+			t.Fatalf("UpdateTeamSheets() error: %v", err)
+		}
+		f2, err := excelize.OpenFile(path)
+		if err != nil {
+			t.Fatalf("OpenFile error: %v", err)
+		}
+		if coach, _ := f2.GetCellValue("Contacts", "B2"); coach != "Sam Reyes" {
+			t.Errorf("Angels coach after update = %q, want Sam Reyes", coach)
+		}
+		if email, _ := f2.GetCellValue("Contacts", "C5"); email != "" {
+			t.Errorf("Padres email after update = %q, want none", email)
+		}
+		f2.Close()
+
+		changed.Teams = nil
+		if err := UpdateTeamSheets(path, &changed); err != nil {
+			t.Fatalf("UpdateTeamSheets() error: %v", err)
+		}
+		f3, err := excelize.OpenFile(path)
+		if err != nil {
+			t.Fatalf("OpenFile error: %v", err)
+		}
+		defer f3.Close()
+		if idx, _ := f3.GetSheetIndex("Contacts"); idx >= 0 {
+			t.Error("Contacts sheet should be dropped once the config has no contacts")
+		}
+	})
+}
+
+// padRow extends a row read back with GetRows, which drops trailing empty
+// cells, to n columns.
+func padRow(row []string, n int) []string {
+	for len(row) < n {
+		row = append(row, "")
+	}
+	return row
+}
+
+func TestStyleOverrides(t *testing.T) {
+	cfg, result := testData()
+	cfg.Style = config.Style{FontFamily: "Calibri", FontSize: 11, HeaderFill: "#2E7D32"}
+	slots := schedule.GenerateSlots(cfg)
+	blackouts := schedule.GenerateBlackoutSlots(cfg)
+
+	f, err := Generate(cfg, result, slots, blackouts)
+	if err != nil {
+		t.Fatalf("Generate() error: %v", err)
+	}
+
+	for _, sheet := range []string{"Master Schedule", "Angels"} {
+		t.Run(sheet, func(t *testing.T) {
+			id, _ := f.GetCellStyle(sheet, "A1")
+			header, err := f.GetStyle(id)
+			if err != nil {
+				t.Fatalf("GetStyle() error: %v", err)
+			}
+			if header.Font.Family != "Calibri" || header.Font.Size != 11 {
+				t.Errorf("header font = %s %g, want Calibri 11", header.Font.Family, header.Font.Size)
+			}
+			if len(header.Fill.Color) == 0 || header.Fill.Color[0] != "2E7D32" {
+				t.Errorf("header fill = %v, want 2E7D32", header.Fill.Color)
+			}
+
+			id, _ = f.GetCellStyle(sheet, "A2")
+			cell, err := f.GetStyle(id)
+			if err != nil {
+				t.Fatalf("GetStyle() error: %v", err)
+			}
+			if cell.Font.Family != "Calibri" || cell.Font.Size != 11 {
+				t.Errorf("cell font = %s %g, want Calibri 11", cell.Font.Family, cell.Font.Size)
+			}
+
+			width, _ := f.GetColWidth(sheet, "A")
+			if want := 18 * 11.0 / 16; width != want {
+				t.Errorf("column A width = %g, want %g", width, want)
+			}
+		})
+	}
+}
+
+func TestHighlights(t *testing.T) {
+	off := false
+	tests := []struct {
+		name     string
+		style    config.Style
+		criteria []string
+	}{
+		{"blackouts only by default", config.Style{}, []string{`AND(D2<>"",ISERROR(FIND(" @ ",D2)))`}},
+		{"empty slots too", config.Style{HighlightEmpty: true}, []string{`AND(D2<>"",ISERROR(FIND(" @ ",D2)))`, `D2=""`}},
+		{"nothing", config.Style{HighlightBlackouts: &off}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, result := testData()
+			cfg.Style = tt.style
+			f, err := Generate(cfg, result, schedule.GenerateSlots(cfg), schedule.GenerateBlackoutSlots(cfg))
+			if err != nil {
+				t.Fatalf("Generate() error: %v", err)
+			}
+			formats, err := f.GetConditionalFormats("Master Schedule")
+			if err != nil {
+				t.Fatalf("GetConditionalFormats() error: %v", err)
+			}
+			var criteria []string
+			for ref, opts := range formats {
+				if !strings.HasPrefix(ref, "D2:") {
+					continue
+				}
+				for _, o := range opts {
+					criteria = append(criteria, o.Criteria)
+				}
+			}
+			if !slices.Equal(criteria, tt.criteria) {
+				t.Errorf("column D criteria = %q, want %q", criteria, tt.criteria)
+			}
+		})
+	}
+}
+
+func TestOverlappingBlackoutReasons(t *testing.T) {
+	tests := []struct {
+		name    string
+		combine bool
+		want    string
+	}{
+		{"first in config order", false, "Varsity"},
+		{"combined", true, "Varsity / Tournament"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, result := testData()
+			cfg.Output.CombineBlackoutReasons = tt.combine
+			cfg.Fields[0].Reservations = []config.Reservation{
+				{Date: &config.Date{Time: date(2026, 5, 2).Time}, Reason: "Varsity"},
+				{StartDate: &config.Date{Time: date(2026, 5, 1).Time}, EndDate: &config.Date{Time: date(2026, 5, 3).Time}, Reason: "Tournament"},
+				{Date: &config.Date{Time: date(2026, 5, 2).Time}, Times: []string{"12:30"}, Reason: "Tournament"},
+			}
+			// Every run must show the same reason, whatever the sort does.
+			for range 5 {
+				f, err := Generate(cfg, result, schedule.GenerateSlots(cfg), schedule.GenerateBlackoutSlots(cfg))
+				if err != nil {
+					t.Fatalf("Generate() error: %v", err)
+				}
+				rows, err := f.GetRows("Master Schedule")
+				if err != nil {
+					t.Fatal(err)
+				}
+				var got string
+				for _, row := range rows {
+					if len(row) > 3 && row[0] == "05/02/2026" {
+						got = row[3]
+					}
+				}
+				if got != tt.want {
+					t.Fatalf("Field A on 05/02 = %q, want %q", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestSaveODS(t *testing.T) {
+	cfg, result := testData()
+	f, err := Generate(cfg, result, schedule.GenerateSlots(cfg), nil)
+	if err != nil {
+		t.Fatalf("Generate() error: %v", err)
+	}
+
+	t.Run("requires LibreOffice", func(t *testing.T) {
+		t.Setenv("PATH", t.TempDir())
+		err := SaveODS(f, filepath.Join(t.TempDir(), "schedule.ods"))
+		if err == nil || !strings.Contains(err.Error(), "soffice nor libreoffice is on PATH") {
+			t.Errorf("SaveODS() error = %v, want LibreOffice hint", err)
+		}
+		if err := RequireLibreOffice(); err == nil {
+			t.Error("RequireLibreOffice() = nil, want an error")
+		}
+	})
+
+	t.Run("converts through soffice", func(t *testing.T) {
+		bin := t.TempDir()
+		// Stand-in for soffice: copies <outdir>/<name>.xlsx to <name>.ods
+		script := "#!/bin/sh\nwhile [ $# -gt 1 ]; do [ \"$1\" = --outdir ] && dir=$2; shift; done\ncp \"$1\" \"$dir/$(basename \"$1\" .xlsx).ods\"\n"
+		if err := os.WriteFile(filepath.Join(bin, "soffice"), []byte(script), 0755); err != nil {
+			t.Fatal(err)
+		}
+		t.Setenv("PATH", bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+		out := filepath.Join(t.TempDir(), "schedule.ods")
+		if err := SaveODS(f, out); err != nil {
+			t.Fatalf("SaveODS() error: %v", err)
+		}
+		if info, err := os.Stat(out); err != nil || info.Size() == 0 {
+			t.Errorf("expected %s to be written: %v", out, err)
+		}
+	})
+}
+
+func TestTeamAbbreviations(t *testing.T) {
+	got := teamAbbreviations([]string{"Angels", "Astros", "Athletics", "Mariners", "Marlins", "Cubs"})
+	want := map[string]string{
+		"Angels": "ANG", "Astros": "AST", "Athletics": "ATH",
+		"Mariners": "MARI", "Marlins": "MARL", "Cubs": "CUB",
+	}
+	for team, w := range want {
+		if got[team] != w {
+			t.Errorf("abbreviation for %s = %q, want %q", team, got[team], w)
+		}
+	}
+
+	t.Run("multibyte names and names that prefix others", func(t *testing.T) {
+		got := teamAbbreviations([]string{"Éclairs", "Étoiles", "Red", "Red Sox"})
+		want := map[string]string{"Éclairs": "ÉCL", "Étoiles": "ÉTO", "Red": "RED", "Red Sox": "REDS"}
+		for team, w := range want {
+			if got[team] != w || !utf8.ValidString(got[team]) {
+				t.Errorf("abbreviation for %s = %q, want %q", team, got[team], w)
+			}
+		}
+	})
+}
+
+func TestReadAssignments(t *testing.T) {
+	cfg, result := testData()
+	slots := schedule.GenerateSlots(cfg)
+	blackouts := schedule.GenerateBlackoutSlots(cfg)
+
+	f, err := Generate(cfg, result, slots, blackouts)
+	if err != nil {
+		t.Fatalf("Generate() error: %v", err)
+	}
+	path := t.TempDir() + "/test.xlsx"
+	if err := f.SaveAs(path); err != nil {
+		t.Fatalf("SaveAs error: %v", err)
+	}
+
+	assignments, err := ReadAssignments(path, cfg)
+	if err != nil {
+		t.Fatalf("ReadAssignments() error: %v", err)
+	}
+	if len(assignments) != len(result.Assignments) {
+		t.Fatalf("read %d assignments, want %d", len(assignments), len(result.Assignments))
+	}
+	for _, want := range result.Assignments {
+		found := false
+		for _, got := range assignments {
+			if got.Slot == want.Slot && got.Game.Home == want.Game.Home && got.Game.Away == want.Game.Away {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("missing %s @ %s on %s at %s", want.Game.Away, want.Game.Home,
+				want.Slot.Date.Format("01/02"), want.Slot.Field)
+		}
+	}
+}
+
+func TestReadAssignmentsCSV(t *testing.T) {
+	cfg, _ := testData()
+	path := filepath.Join(t.TempDir(), "master.csv")
+	content := "\ufeffDate,Day,Time,Field A,Field B\n" +
+		"4/25/2026,Sat,12:30,Cubs @ Angels,Padres @ Astros\n" +
+		"2026-05-10,Sun,17:00,Mother's Day,Mother's Day\n" +
+		"05/11/2026,Mon,5:45 PM,,Angels @ Cubs\n" +
+		"05/12/2026,Tue,17:45:00,Astros @ Padres,\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	assignments, err := ReadAssignments(path, cfg)
+	if err != nil {
+		t.Fatalf("ReadAssignments() error: %v", err)
+	}
+	want := []schedule.Assignment{
+		{Game: strategy.Game{Home: "Angels", Away: "Cubs"}, Slot: schedule.Slot{Date: date(2026, 4, 25).Time, Time: "12:30", Field: "Field A"}},
+		{Game: strategy.Game{Home: "Astros", Away: "Padres"}, Slot: schedule.Slot{Date: date(2026, 4, 25).Time, Time: "12:30", Field: "Field B"}},
+		{Game: strategy.Game{Home: "Cubs", Away: "Angels"}, Slot: schedule.Slot{Date: date(2026, 5, 11).Time, Time: "17:45", Field: "Field B"}},
+		{Game: strategy.Game{Home: "Padres", Away: "Astros"}, Slot: schedule.Slot{Date: date(2026, 5, 12).Time, Time: "17:45", Field: "Field A"}},
+	}
+	if !slices.EqualFunc(assignments, want, func(a, b schedule.Assignment) bool {
+		return a.Slot == b.Slot && a.Game.Home == b.Game.Home && a.Game.Away == b.Game.Away
+	}) {
+		t.Errorf("ReadAssignments() = %+v, want %+v", assignments, want)
+	}
+
+	rows, err := ReadMasterRows(path)
+	if err != nil {
+		t.Fatalf("ReadMasterRows() error: %v", err)
+	}
+	if rows[0][0] != "Date" || rows[2][0] != "05/10/2026" {
+		t.Errorf("rows start %q and %q, want the BOM dropped and dates as 01/02/2006", rows[0][0], rows[2][0])
+	}
+}
@@ -0,0 +1,94 @@
+// Package export writes schedules in formats other tools can read directly.
+package export
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/schedule"
+	_ "modernc.org/sqlite" // pure-Go driver, registered as "sqlite"
+)
+
+const sqliteSchema = `
+CREATE TABLE games (
+	date     TEXT NOT NULL, -- YYYY-MM-DD
+	time     TEXT NOT NULL, -- HH:MM
+	field    TEXT NOT NULL,
+	home     TEXT NOT NULL,
+	away     TEXT NOT NULL,
+	division TEXT,          -- NULL for inter-division games
+	label    TEXT,
+	note     TEXT           -- NULL unless the game has a note
+);
+CREATE TABLE blackouts (
+	date   TEXT NOT NULL,
+	time   TEXT NOT NULL,
+	field  TEXT NOT NULL,
+	reason TEXT NOT NULL
+);
+`
+
+// WriteSQLite writes the assignments and blackouts to a new SQLite database
+// at path, replacing any file already there. Games get a games row with the
+// division both teams share; blackouts get a blackouts row per slot.
+func WriteSQLite(path string, cfg *config.Config, assignments []schedule.Assignment, blackouts []schedule.BlackoutSlot) error {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("replacing %s: %w", path, err)
+	}
+
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return fmt.Errorf("opening database: %w", err)
+	}
+	defer db.Close()
+
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(sqliteSchema); err != nil {
+		return fmt.Errorf("creating tables: %w", err)
+	}
+
+	divisionOf := make(map[string]string)
+	for _, div := range cfg.Divisions {
+		for _, team := range div.Teams {
+			divisionOf[team] = div.Name
+		}
+	}
+
+	for _, a := range assignments {
+		var division, label, note sql.NullString
+		if d := divisionOf[a.Game.Home]; d != "" && d == divisionOf[a.Game.Away] {
+			division = sql.NullString{String: d, Valid: true}
+		}
+		if a.Game.Label != "" {
+			label = sql.NullString{String: a.Game.Label, Valid: true}
+		}
+		if a.Game.Note != "" {
+			note = sql.NullString{String: a.Game.Note, Valid: true}
+		}
+		_, err := tx.Exec(`INSERT INTO games (date, time, field, home, away, division, label, note) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			a.Slot.Date.Format("2006-01-02"), a.Slot.Time, a.Slot.Field, a.Game.Home, a.Game.Away, division, label, note)
+		if err != nil {
+			return fmt.Errorf("writing game %s @ %s: %w", a.Game.Away, a.Game.Home, err)
+		}
+	}
+
+	for _, b := range blackouts {
+		_, err := tx.Exec(`INSERT INTO blackouts (date, time, field, reason) VALUES (?, ?, ?, ?)`,
+			b.Date.Format("2006-01-02"), b.Time, b.Field, b.Reason)
+		if err != nil {
+			return fmt.Errorf("writing blackout: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("saving database: %w", err)
+	}
+	return nil
+}
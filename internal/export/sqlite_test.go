@@ -0,0 +1,94 @@
+package export
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/schedule"
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+func mustDate(s string) time.Time {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestWriteSQLite(t *testing.T) {
+	cfg := &config.Config{
+		Divisions: []config.Division{
+			{Name: "American", Teams: []string{"Angels", "Astros"}},
+			{Name: "National", Teams: []string{"Cubs"}},
+		},
+	}
+	assignments := []schedule.Assignment{
+		{Game: strategy.Game{Home: "Angels", Away: "Astros", Label: "Game 1"}, Slot: schedule.Slot{Date: mustDate("2026-04-25"), Time: "12:30", Field: "Symonds Field"}},
+		{Game: strategy.Game{Home: "Cubs", Away: "Angels", Note: "Sponsor Night"}, Slot: schedule.Slot{Date: mustDate("2026-04-27"), Time: "17:45", Field: "Washington Park"}},
+	}
+	blackouts := []schedule.BlackoutSlot{
+		{Date: mustDate("2026-05-10"), Time: "17:00", Field: "Symonds Field", Reason: "Mother's Day"},
+	}
+
+	path := filepath.Join(t.TempDir(), "season.db")
+	if err := os.WriteFile(path, []byte("stale"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteSQLite(path, cfg, assignments, blackouts); err != nil {
+		t.Fatalf("WriteSQLite() error: %v", err)
+	}
+
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	t.Run("games round-trip", func(t *testing.T) {
+		rows, err := db.Query(`SELECT date, time, field, home, away, division, label, note FROM games ORDER BY date`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer rows.Close()
+
+		type row struct {
+			date, time, field, home, away string
+			division, label, note         sql.NullString
+		}
+		var got []row
+		for rows.Next() {
+			var r row
+			if err := rows.Scan(&r.date, &r.time, &r.field, &r.home, &r.away, &r.division, &r.label, &r.note); err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, r)
+		}
+		want := []row{
+			{"2026-04-25", "12:30", "Symonds Field", "Angels", "Astros", sql.NullString{String: "American", Valid: true}, sql.NullString{String: "Game 1", Valid: true}, sql.NullString{}},
+			{"2026-04-27", "17:45", "Washington Park", "Cubs", "Angels", sql.NullString{}, sql.NullString{}, sql.NullString{String: "Sponsor Night", Valid: true}},
+		}
+		if len(got) != len(want) {
+			t.Fatalf("games = %d rows, want %d", len(got), len(want))
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("games row %d = %+v, want %+v", i, got[i], want[i])
+			}
+		}
+	})
+
+	t.Run("blackouts round-trip", func(t *testing.T) {
+		var date, tm, field, reason string
+		if err := db.QueryRow(`SELECT date, time, field, reason FROM blackouts`).Scan(&date, &tm, &field, &reason); err != nil {
+			t.Fatal(err)
+		}
+		if date != "2026-05-10" || tm != "17:00" || field != "Symonds Field" || reason != "Mother's Day" {
+			t.Errorf("blackout = %s %s %s %q", date, tm, field, reason)
+		}
+	})
+}
@@ -0,0 +1,50 @@
+package schedule
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+// SlotStatus reports whether game could be played at (date, t, field) given
+// the result's assignments. It checks blackout dates, field reservations,
+// the configured slot times, slot usage, and the scheduler's hard
+// constraints. Pass a zero Game to ask only whether the slot itself is free.
+// When the slot is unavailable, reason says why.
+func (r *Result) SlotStatus(cfg *config.Config, date time.Time, t, field string, game strategy.Game) (available bool, reason string) {
+	slot := Slot{Date: date, Time: t, Field: field}
+
+	for _, b := range GenerateBlackoutSlots(cfg) {
+		if b.Date.Equal(date) && b.Time == t && b.Field == field {
+			return false, "blacked out: " + b.Reason
+		}
+	}
+
+	known := false
+	for _, s := range append(GenerateSlots(cfg), GenerateOverflowSlots(cfg)...) {
+		if s == slot {
+			known = true
+			break
+		}
+	}
+	if !known {
+		return false, "not a game slot in this season"
+	}
+
+	for _, a := range r.Assignments {
+		if a.Slot.Date.Equal(date) && a.Slot.Time == t && a.Slot.Field == field {
+			return false, fmt.Sprintf("%s (%s @ %s)", rejectSlotUsed, a.Game.Away, a.Game.Home)
+		}
+	}
+
+	s := newScheduler(cfg, nil, nil, nil)
+	for _, a := range r.Assignments {
+		s.assign(a.Game, a.Slot)
+	}
+	if rejection, ok := s.hardConstraintCheck(game, slot); !ok {
+		return false, rejection.String()
+	}
+	return true, ""
+}
@@ -0,0 +1,56 @@
+package schedule
+
+import (
+	"testing"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+func TestSlotStatus(t *testing.T) {
+	cfg := schedulerTestConfig()
+	cfg.Fields[1].Reservations = []config.Reservation{
+		{Date: &config.Date{Time: mustDate("2026-05-02")}, Times: []string{"12:30"}, Reason: "Town event"},
+	}
+	sat := mustDate("2026-05-02")
+	result := &Result{Assignments: []Assignment{
+		{Game: strategy.Game{Home: "Angels", Away: "Astros"}, Slot: Slot{Date: sat, Time: "12:30", Field: "Moscariello Ballpark"}},
+		{Game: strategy.Game{Home: "Cubs", Away: "Padres"}, Slot: Slot{Date: sat, Time: "14:45", Field: "Moscariello Ballpark"}},
+		{Game: strategy.Game{Home: "Royals", Away: "Mariners"}, Slot: Slot{Date: sat, Time: "14:45", Field: "Symonds Field"}},
+	}}
+
+	tests := []struct {
+		name      string
+		date      string
+		time      string
+		field     string
+		game      strategy.Game
+		available bool
+		reason    string
+	}{
+		{"open slot", "2026-05-02", "17:00", "Washington Park", strategy.Game{Home: "Athletics", Away: "Phillies"}, true, ""},
+		{"open slot without a game", "2026-05-02", "17:00", "Symonds Field", strategy.Game{}, true, ""},
+		{"blackout date", "2026-05-10", "17:00", "Symonds Field", strategy.Game{}, false, "blacked out: Mother's Day"},
+		{"field reservation", "2026-05-02", "12:30", "Symonds Field", strategy.Game{}, false, "blacked out: Town event"},
+		{"time not offered that day", "2026-05-04", "12:30", "Symonds Field", strategy.Game{}, false, "not a game slot in this season"},
+		{"unknown field", "2026-05-02", "17:00", "Fenway Park", strategy.Game{}, false, "not a game slot in this season"},
+		{"outside the season", "2026-06-06", "12:30", "Symonds Field", strategy.Game{}, false, "not a game slot in this season"},
+		{"slot taken", "2026-05-02", "12:30", "Moscariello Ballpark", strategy.Game{}, false, "slot already taken (Astros @ Angels)"},
+		{"timeslot full", "2026-05-02", "14:45", "Washington Park", strategy.Game{}, false, rejectTimeslotCap.String()},
+		{"team already plays that day", "2026-05-02", "17:00", "Washington Park", strategy.Game{Home: "Angels", Away: "Cubs"}, false, rejectDoublePlay.String()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			available, reason := result.SlotStatus(cfg, mustDate(tt.date), tt.time, tt.field, tt.game)
+			if available != tt.available || reason != tt.reason {
+				t.Errorf("SlotStatus() = (%v, %q), want (%v, %q)", available, reason, tt.available, tt.reason)
+			}
+		})
+	}
+
+	t.Run("does not change the result", func(t *testing.T) {
+		if len(result.Assignments) != 3 {
+			t.Errorf("Assignments = %d, want 3", len(result.Assignments))
+		}
+	})
+}
@@ -0,0 +1,185 @@
+package schedule
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/derekprior/rbrl/internal/config"
+)
+
+// criticalPathLimit caps how many entries each critical path section lists.
+const criticalPathLimit = 3
+
+// criticalPath explains why a failed attempt ran out of room: which teams
+// have the least slack, which dates turned away the most games, and which
+// occupied slots would place the most unscheduled games if freed.
+type criticalPath struct {
+	teams []teamSlack
+	dates []dateContention
+	slots []slotRelief
+}
+
+type teamSlack struct {
+	team  string
+	games int // unscheduled games involving the team
+	open  int // open slots that fit at least one of those games
+}
+
+type dateContention struct {
+	date       time.Time
+	rejections int
+}
+
+type slotRelief struct {
+	slot Slot
+	fits int // unscheduled games that could take the slot
+}
+
+// criticalPath derives the critical path from the attempt's rejection
+// tallies and the slots still open to its unscheduled games.
+func (s *scheduler) criticalPath() criticalPath {
+	var cp criticalPath
+
+	cp.teams = s.teamSlack()
+	sort.Slice(cp.teams, func(i, j int) bool {
+		a, b := cp.teams[i], cp.teams[j]
+		if a.open != b.open {
+			return a.open < b.open
+		}
+		if a.games != b.games {
+			return a.games > b.games
+		}
+		return a.team < b.team
+	})
+
+	for d, n := range s.dateRejections {
+		cp.dates = append(cp.dates, dateContention{date: d, rejections: n})
+	}
+	sort.Slice(cp.dates, func(i, j int) bool {
+		a, b := cp.dates[i], cp.dates[j]
+		if a.rejections != b.rejections {
+			return a.rejections > b.rejections
+		}
+		return a.date.Before(b.date)
+	})
+
+	// Free each occupied slot in turn, as tryDisplace does, then put its
+	// game back in the same position so the attempt is left untouched.
+	for idx := range s.assignments {
+		victim := s.unassign(idx)
+		fits := 0
+		for _, g := range s.unscheduled {
+			if _, ok := s.hardConstraintCheck(g, victim.Slot); ok {
+				fits++
+			}
+		}
+		s.assign(victim.Game, victim.Slot)
+		copy(s.assignments[idx+1:], s.assignments[idx:len(s.assignments)-1])
+		s.assignments[idx] = victim
+
+		if fits > 0 {
+			cp.slots = append(cp.slots, slotRelief{slot: victim.Slot, fits: fits})
+		}
+	}
+	sort.Slice(cp.slots, func(i, j int) bool {
+		a, b := cp.slots[i].slot, cp.slots[j].slot
+		if cp.slots[i].fits != cp.slots[j].fits {
+			return cp.slots[i].fits > cp.slots[j].fits
+		}
+		if !a.Date.Equal(b.Date) {
+			return a.Date.Before(b.Date)
+		}
+		if c := config.CompareTimes(a.Time, b.Time); c != 0 {
+			return c < 0
+		}
+		return a.Field < b.Field
+	})
+
+	cp.teams = cp.teams[:min(len(cp.teams), criticalPathLimit)]
+	cp.dates = cp.dates[:min(len(cp.dates), criticalPathLimit)]
+	cp.slots = cp.slots[:min(len(cp.slots), criticalPathLimit)]
+	return cp
+}
+
+// teamSlack returns, for each team in an unscheduled game, how many games
+// it has left and how many open slots still pass the hard constraints for
+// at least one of them.
+func (s *scheduler) teamSlack() []teamSlack {
+	games := make(map[string]int)
+	open := make(map[string]map[slotKey]bool)
+	for _, g := range s.unscheduled {
+		for _, team := range []string{g.Home, g.Away} {
+			games[team]++
+			if open[team] == nil {
+				open[team] = make(map[slotKey]bool)
+			}
+		}
+		for _, slot := range s.slots {
+			sk := slotKey{slot.Date, slot.Time, slot.Field}
+			if s.usedSlots[sk] {
+				continue
+			}
+			if _, ok := s.hardConstraintCheck(g, slot); ok {
+				open[g.Home][sk] = true
+				open[g.Away][sk] = true
+			}
+		}
+	}
+
+	var slack []teamSlack
+	for team, n := range games {
+		slack = append(slack, teamSlack{team: team, games: n, open: len(open[team])})
+	}
+	return slack
+}
+
+// mostConstrainedTeam returns the team with the fewest legal slots left
+// relative to its unscheduled games, ties going to fewer open slots and
+// then name. ok is false when every team has a slot for each of its games,
+// so no single team explains the failure.
+func (s *scheduler) mostConstrainedTeam() (teamSlack, bool) {
+	var worst teamSlack
+	found := false
+	for _, t := range s.teamSlack() {
+		if t.open >= t.games {
+			continue
+		}
+		short, worstShort := t.games-t.open, worst.games-worst.open
+		if !found || short > worstShort ||
+			(short == worstShort && (t.open < worst.open || (t.open == worst.open && t.team < worst.team))) {
+			worst, found = t, true
+		}
+	}
+	return worst, found
+}
+
+// String formats the critical path for the failure message, or returns ""
+// when there is nothing to report.
+func (cp criticalPath) String() string {
+	if len(cp.teams) == 0 && len(cp.dates) == 0 && len(cp.slots) == 0 {
+		return ""
+	}
+
+	msg := "\n\nCritical path:"
+	if len(cp.teams) > 0 {
+		msg += "\n  Tightest teams (open slots that fit their unscheduled games):"
+		for _, t := range cp.teams {
+			msg += fmt.Sprintf("\n    • %s: %d open slots for %d unscheduled games", t.team, t.open, t.games)
+		}
+	}
+	if len(cp.dates) > 0 {
+		msg += "\n  Most contended dates (hard constraint rejections):"
+		for _, d := range cp.dates {
+			msg += fmt.Sprintf("\n    • %s: %d rejections", d.date.Format("Mon 01/02"), d.rejections)
+		}
+	}
+	if len(cp.slots) > 0 {
+		msg += "\n  Slots that would help most if freed:"
+		for _, r := range cp.slots {
+			msg += fmt.Sprintf("\n    • %s %s %s: fits %d unscheduled games",
+				r.slot.Date.Format("Mon 01/02"), r.slot.Time, r.slot.Field, r.fits)
+		}
+	}
+	return msg
+}
@@ -0,0 +1,132 @@
+package schedule
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+func criticalPathTestConfig() *config.Config {
+	return &config.Config{
+		Season: config.Season{StartDate: date(2026, 5, 2), EndDate: date(2026, 5, 3)},
+		Divisions: []config.Division{
+			{Name: "A", Teams: []string{"Angels", "Astros", "Cubs", "Padres"}},
+		},
+		Fields: []config.Field{{Name: "Symonds Field"}, {Name: "Washington Park"}},
+		TimeSlots: config.TimeSlots{
+			Saturday: []string{"12:30"},
+			Sunday:   []string{"12:30"},
+		},
+		Rules: config.Rules{
+			MaxGamesPerDayPerTeam: 1,
+			MaxConsecutiveDays:    2,
+			MaxGamesPerWeek:       3,
+			MaxGamesPerTimeslot:   2,
+		},
+	}
+}
+
+func TestCriticalPath(t *testing.T) {
+	t.Run("tightest teams have no open slots", func(t *testing.T) {
+		cfg := criticalPathTestConfig()
+		// Single round robin needs 3 game days; the weekend has 2
+		var games []strategy.Game
+		teams := cfg.AllTeams()
+		for i := range teams {
+			for j := i + 1; j < len(teams); j++ {
+				games = append(games, strategy.Game{Home: teams[i], Away: teams[j]})
+			}
+		}
+
+		_, err := Schedule(cfg, GenerateSlots(cfg), nil, games)
+		if err == nil {
+			t.Fatal("expected failure: 3 rounds cannot fit in 2 days")
+		}
+		for _, want := range []string{
+			"Most constrained team: ",
+			"have only 0 legal slots left for 1 remaining game\n",
+			"Critical path:",
+			"Tightest teams",
+			": 0 open slots for 1 unscheduled games",
+		} {
+			if !strings.Contains(err.Error(), want) {
+				t.Errorf("error missing %q:\n%s", want, err)
+			}
+		}
+	})
+
+	t.Run("freeing a slot at the timeslot cap helps", func(t *testing.T) {
+		cfg := criticalPathTestConfig()
+		cfg.TimeSlots.Sunday = nil
+		cfg.Rules.MaxGamesPerTimeslot = 1
+		games := []strategy.Game{
+			{Home: "Angels", Away: "Astros"},
+			{Home: "Cubs", Away: "Padres"},
+		}
+
+		s := newScheduler(cfg, GenerateSlots(cfg), nil, games)
+		s.trySchedule(games, rand.New(rand.NewSource(42)))
+		if len(s.unscheduled) != 1 {
+			t.Fatalf("unscheduled %d games, want 1", len(s.unscheduled))
+		}
+
+		before := append([]Assignment(nil), s.assignments...)
+		cp := s.criticalPath()
+		if len(cp.slots) != 1 || cp.slots[0].slot != before[0].Slot || cp.slots[0].fits != 1 {
+			t.Errorf("slots = %+v, want the occupied Saturday slot fitting 1 game", cp.slots)
+		}
+		if len(cp.dates) == 0 || !cp.dates[0].date.Equal(date(2026, 5, 2).Time) {
+			t.Errorf("dates = %+v, want Saturday most contended", cp.dates)
+		}
+		if !strings.Contains(cp.String(), "Slots that would help most if freed:") {
+			t.Errorf("String() missing freed slots:\n%s", cp)
+		}
+
+		if len(s.assignments) != len(before) {
+			t.Fatalf("criticalPath changed assignment count: %d, want %d", len(s.assignments), len(before))
+		}
+		for i := range before {
+			got, want := s.assignments[i], before[i]
+			if got.Game.Home != want.Game.Home || got.Game.Away != want.Game.Away || got.Slot != want.Slot {
+				t.Errorf("assignment %d changed: %+v, want %+v", i, got, want)
+			}
+		}
+	})
+
+	t.Run("empty when nothing is unscheduled", func(t *testing.T) {
+		if got := (criticalPath{}).String(); got != "" {
+			t.Errorf("String() = %q, want empty", got)
+		}
+	})
+}
+
+func TestMostConstrainedTeam(t *testing.T) {
+	cfg := criticalPathTestConfig()
+	s := newScheduler(cfg, GenerateSlots(cfg), nil, nil)
+	s.assign(strategy.Game{Home: "Angels", Away: "Astros"}, Slot{Date: date(2026, 5, 2).Time, Time: "12:30", Field: "Symonds Field"})
+	s.assign(strategy.Game{Home: "Cubs", Away: "Padres"}, Slot{Date: date(2026, 5, 3).Time, Time: "12:30", Field: "Symonds Field"})
+
+	t.Run("no team short of slots", func(t *testing.T) {
+		s.unscheduled = []strategy.Game{{Home: "Astros", Away: "Angels"}}
+		if got, ok := s.mostConstrainedTeam(); ok {
+			t.Errorf("mostConstrainedTeam() = %+v, want none", got)
+		}
+	})
+
+	t.Run("team furthest short wins", func(t *testing.T) {
+		// Angels only fit Sunday on Washington Park, and only against the
+		// Astros; the Cubs and Padres already play Sunday.
+		s.unscheduled = []strategy.Game{
+			{Home: "Astros", Away: "Angels"},
+			{Home: "Cubs", Away: "Angels"},
+			{Home: "Angels", Away: "Padres"},
+		}
+		got, ok := s.mostConstrainedTeam()
+		if !ok || got.team != "Angels" || got.open != 1 || got.games != 3 {
+			t.Errorf("mostConstrainedTeam() = %+v, %v, want Angels with 1 slot for 3 games", got, ok)
+		}
+	})
+}
@@ -0,0 +1,103 @@
+package schedule
+
+import (
+	"sort"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+// Candidate is one slot considered for a game when explaining its placement.
+type Candidate struct {
+	Slot      Slot
+	Score     float64 // scoreSlot value; only meaningful when Rejection is ""
+	Rejection string  // why the slot was illegal, or "" if the game could go there
+}
+
+// Explanation describes where one game landed and what else it could have
+// used. Scores are computed against the rest of the final schedule, so they
+// show how the slots compare now rather than replaying the greedy pass.
+type Explanation struct {
+	Game       strategy.Game
+	Assigned   *Slot   // nil if the game was not scheduled
+	Score      float64 // scoreSlot value at Assigned
+	Candidates []Candidate
+}
+
+// Explain rebuilds the schedule state from result and, for each game
+// between teams a and b (in either order), scores every slot with that game
+// removed. Candidates are sorted legal slots first, best score first.
+func Explain(cfg *config.Config, slots, overflowSlots []Slot, games []strategy.Game, result *Result, a, b string) []Explanation {
+	slots, _ = withoutHeld(cfg, slots)
+	s := newScheduler(cfg, slots, overflowSlots, games)
+	for _, asg := range result.Assignments {
+		s.assign(asg.Game, asg.Slot)
+	}
+
+	isMatchup := func(g strategy.Game) bool {
+		return (g.Home == a && g.Away == b) || (g.Home == b && g.Away == a)
+	}
+
+	var explanations []Explanation
+	placed := make(map[int]bool)
+	for _, game := range games {
+		if !isMatchup(game) {
+			continue
+		}
+
+		idx := -1
+		for i, asg := range s.assignments {
+			if !placed[i] && isMatchup(asg.Game) && asg.Game.Home == game.Home && asg.Game.Label == game.Label {
+				idx = i
+				break
+			}
+		}
+
+		e := Explanation{Game: game}
+		var victim Assignment
+		if idx >= 0 {
+			placed[idx] = true
+			victim = s.unassign(idx)
+			e.Assigned = &victim.Slot
+			e.Score = s.scoreSlot(game, victim.Slot)
+		}
+		e.Candidates = s.candidates(game, e.Assigned)
+		if idx >= 0 {
+			s.assign(victim.Game, victim.Slot)
+			last := len(s.assignments) - 1
+			copy(s.assignments[idx+1:], s.assignments[idx:last])
+			s.assignments[idx] = victim
+		}
+		explanations = append(explanations, e)
+	}
+	return explanations
+}
+
+// candidates scores every regular and overflow slot for the game, skipping
+// the one it is assigned to.
+func (s *scheduler) candidates(game strategy.Game, assigned *Slot) []Candidate {
+	var cands []Candidate
+	for _, slot := range append(append([]Slot{}, s.slots...), s.overflowSlots...) {
+		if assigned != nil && slot == *assigned {
+			continue
+		}
+		c := Candidate{Slot: slot}
+		if s.usedSlots[slotKey{slot.Date, slot.Time, slot.Field}] {
+			c.Rejection = rejectSlotUsed.String()
+		} else if reason, ok := s.hardConstraintCheck(game, slot); !ok {
+			c.Rejection = reason.String()
+		} else {
+			c.Score = s.scoreSlot(game, slot)
+		}
+		cands = append(cands, c)
+	}
+
+	sort.SliceStable(cands, func(i, j int) bool {
+		li, lj := cands[i].Rejection == "", cands[j].Rejection == ""
+		if li != lj {
+			return li
+		}
+		return li && cands[i].Score < cands[j].Score
+	})
+	return cands
+}
@@ -0,0 +1,68 @@
+package schedule
+
+import (
+	"testing"
+
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+func TestExplain(t *testing.T) {
+	cfg := schedulerTestConfig()
+	slots := GenerateSlots(cfg)
+	games := (&strategy.DivisionWeighted{}).GenerateMatchups(cfg.Divisions)
+	result, err := Schedule(cfg, slots, nil, games)
+	if err != nil {
+		t.Fatalf("Schedule() error: %v", err)
+	}
+	before := append([]Assignment(nil), result.Assignments...)
+
+	explanations := Explain(cfg, slots, nil, games, result, "Cubs", "Angels")
+
+	t.Run("one explanation per game in the matchup", func(t *testing.T) {
+		if len(explanations) != 1 {
+			t.Fatalf("explanations = %d, want 1 inter-division game", len(explanations))
+		}
+		if n := len(Explain(cfg, slots, nil, games, result, "Angels", "Astros")); n != 2 {
+			t.Errorf("intra-division explanations = %d, want 2", n)
+		}
+	})
+
+	e := explanations[0]
+	t.Run("assigned slot matches the schedule", func(t *testing.T) {
+		if e.Assigned == nil {
+			t.Fatal("expected the game to be scheduled")
+		}
+		for _, a := range result.Assignments {
+			if a.Game.Label == e.Game.Label && a.Slot != *e.Assigned {
+				t.Errorf("Assigned = %+v, want %+v", *e.Assigned, a.Slot)
+			}
+		}
+	})
+
+	t.Run("candidates list legal slots first, best first", func(t *testing.T) {
+		if len(e.Candidates) != len(slots)-1 {
+			t.Errorf("candidates = %d, want every other slot (%d)", len(e.Candidates), len(slots)-1)
+		}
+		seenRejected := false
+		for i, c := range e.Candidates {
+			if c.Rejection != "" {
+				seenRejected = true
+				continue
+			}
+			if seenRejected {
+				t.Fatalf("legal candidate %d listed after a rejected one", i)
+			}
+			if i > 0 && c.Score < e.Candidates[i-1].Score {
+				t.Errorf("candidate %d score %.2f below previous %.2f", i, c.Score, e.Candidates[i-1].Score)
+			}
+		}
+	})
+
+	t.Run("result is left untouched", func(t *testing.T) {
+		for i := range before {
+			if result.Assignments[i].Slot != before[i].Slot || result.Assignments[i].Game.Label != before[i].Game.Label {
+				t.Fatalf("assignment %d changed", i)
+			}
+		}
+	})
+}
@@ -0,0 +1,117 @@
+package schedule
+
+import (
+	"slices"
+	"time"
+
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+// FamilyLinkReport summarizes how well one family_links entry was honored.
+type FamilyLinkReport struct {
+	Teams     []string
+	Days      int // dates any of the teams play
+	Together  int // dates at least two of the teams play
+	SameField int // of those, dates two of the teams play on the same field
+}
+
+type familyKey struct {
+	team string
+	date time.Time
+}
+
+// familyBonus returns how much to lower a slot's score for putting game on
+// the same day as a linked team's game: more at the same field, and more
+// again when the games are back to back there. Each linked team counts once,
+// for its best game that day.
+func (s *scheduler) familyBonus(game strategy.Game, slot Slot) float64 {
+	bonus := 0.0
+	for _, team := range []string{game.Home, game.Away} {
+		for _, other := range s.linked[team] {
+			if other == game.Home || other == game.Away {
+				continue
+			}
+			best := 0.0
+			for _, o := range s.familySlots[familyKey{other, slot.Date}] {
+				b := 8.0
+				if o.Field == slot.Field {
+					b += 4
+					if s.backToBack(slot.Date, o.Time, slot.Time) {
+						b += 4
+					}
+				}
+				best = max(best, b)
+			}
+			bonus += best
+		}
+	}
+	return bonus
+}
+
+// backToBack reports whether a and b are neighboring slot times on d.
+func (s *scheduler) backToBack(d time.Time, a, b string) bool {
+	times := TimesForDay(s.cfg, d)
+	i, j := slices.Index(times, a), slices.Index(times, b)
+	return i >= 0 && j >= 0 && (i-j == 1 || j-i == 1)
+}
+
+// trackFamily records or forgets a game's slot for whichever of its teams
+// are in a family link.
+func (s *scheduler) trackFamily(game strategy.Game, slot Slot, add bool) {
+	for _, team := range []string{game.Home, game.Away} {
+		if len(s.linked[team]) == 0 {
+			continue
+		}
+		k := familyKey{team, slot.Date}
+		if add {
+			s.familySlots[k] = append(s.familySlots[k], slot)
+		} else if i := slices.Index(s.familySlots[k], slot); i >= 0 {
+			s.familySlots[k] = slices.Delete(s.familySlots[k], i, i+1)
+		}
+	}
+}
+
+// familyReports counts, for each family link in config order, the days its
+// teams play together.
+func (s *scheduler) familyReports() []FamilyLinkReport {
+	var reports []FamilyLinkReport
+	for _, link := range s.cfg.Guidelines.FamilyLinks {
+		r := FamilyLinkReport{Teams: link}
+		days := make(map[time.Time]bool)
+		for _, team := range link {
+			for _, d := range s.teamDates[team] {
+				days[d] = true
+			}
+		}
+		r.Days = len(days)
+		for d := range days {
+			playing := 0
+			fields := make(map[string]int)
+			for _, team := range link {
+				slots := s.familySlots[familyKey{team, d}]
+				if len(slots) > 0 {
+					playing++
+				}
+				seen := make(map[string]bool)
+				for _, slot := range slots {
+					if !seen[slot.Field] {
+						seen[slot.Field] = true
+						fields[slot.Field]++
+					}
+				}
+			}
+			if playing < 2 {
+				continue
+			}
+			r.Together++
+			for _, n := range fields {
+				if n > 1 {
+					r.SameField++
+					break
+				}
+			}
+		}
+		reports = append(reports, r)
+	}
+	return reports
+}
@@ -0,0 +1,96 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+func TestFamilyLinks(t *testing.T) {
+	cfg := schedulerTestConfig()
+	cfg.Guidelines.FamilyLinks = [][]string{{"Angels", "Cubs"}}
+	sat := mustDate("2026-05-02")
+
+	t.Run("scoreSlot prefers the linked team's day, field, and neighboring time", func(t *testing.T) {
+		s := newScheduler(cfg, nil, nil, nil)
+		s.assign(strategy.Game{Home: "Angels", Away: "Astros"}, Slot{Date: sat, Time: "12:30", Field: "Symonds Field"})
+		game := strategy.Game{Home: "Cubs", Away: "Padres"}
+		otherDay := s.scoreSlot(game, Slot{Date: mustDate("2026-05-09"), Time: "14:45", Field: "Symonds Field"})
+		otherField := s.scoreSlot(game, Slot{Date: sat, Time: "14:45", Field: "Washington Park"})
+		later := s.scoreSlot(game, Slot{Date: sat, Time: "17:00", Field: "Symonds Field"})
+		backToBack := s.scoreSlot(game, Slot{Date: sat, Time: "14:45", Field: "Symonds Field"})
+		if !(backToBack < later && later < otherField && otherField < otherDay) {
+			t.Errorf("scores: back to back %.2f, later %.2f, other field %.2f, other day %.2f; want increasing",
+				backToBack, later, otherField, otherDay)
+		}
+		if s.familyBonus(strategy.Game{Home: "Padres", Away: "Pirates"}, Slot{Date: sat, Time: "14:45", Field: "Symonds Field"}) != 0 {
+			t.Error("familyBonus for unlinked teams, want 0")
+		}
+	})
+
+	t.Run("familyReports counts shared days", func(t *testing.T) {
+		s := newScheduler(cfg, nil, nil, nil)
+		s.assign(strategy.Game{Home: "Angels", Away: "Astros"}, Slot{Date: sat, Time: "12:30", Field: "Symonds Field"})
+		s.assign(strategy.Game{Home: "Cubs", Away: "Padres"}, Slot{Date: sat, Time: "14:45", Field: "Symonds Field"})
+		s.assign(strategy.Game{Home: "Angels", Away: "Royals"}, Slot{Date: mustDate("2026-05-04"), Time: "17:45", Field: "Symonds Field"})
+		s.assign(strategy.Game{Home: "Cubs", Away: "Pirates"}, Slot{Date: mustDate("2026-05-04"), Time: "17:45", Field: "Washington Park"})
+		s.assign(strategy.Game{Home: "Cubs", Away: "Marlins"}, Slot{Date: mustDate("2026-05-06"), Time: "17:45", Field: "Symonds Field"})
+		s.unassign(len(s.assignments) - 1)
+		s.assign(strategy.Game{Home: "Cubs", Away: "Marlins"}, Slot{Date: mustDate("2026-05-07"), Time: "17:45", Field: "Symonds Field"})
+
+		reports := s.familyReports()
+		if len(reports) != 1 {
+			t.Fatalf("reports = %d, want 1", len(reports))
+		}
+		want := FamilyLinkReport{Days: 3, Together: 2, SameField: 1}
+		if r := reports[0]; r.Days != want.Days || r.Together != want.Together || r.SameField != want.SameField {
+			t.Errorf("report = %+v, want %+v", r, want)
+		}
+	})
+
+	t.Run("Schedule puts linked teams together more often", func(t *testing.T) {
+		together := func(result *Result) int {
+			days := make(map[time.Time]int)
+			for _, a := range result.Assignments {
+				for _, team := range []string{a.Game.Home, a.Game.Away} {
+					if team == "Angels" {
+						days[a.Slot.Date] |= 1
+					}
+					if team == "Cubs" {
+						days[a.Slot.Date] |= 2
+					}
+				}
+			}
+			n := 0
+			for _, both := range days {
+				if both == 3 {
+					n++
+				}
+			}
+			return n
+		}
+
+		games := (&strategy.DivisionWeighted{}).GenerateMatchups(cfg.Divisions)
+		linked, err := Schedule(cfg, GenerateSlots(cfg), nil, games)
+		if err != nil {
+			t.Fatalf("Schedule() error: %v", err)
+		}
+		plain := schedulerTestConfig()
+		unlinked, err := Schedule(plain, GenerateSlots(plain), nil, games)
+		if err != nil {
+			t.Fatalf("Schedule() error: %v", err)
+		}
+
+		if got := linked.FamilyLinks[0].Together; got != together(linked) {
+			t.Errorf("report says together on %d days, assignments show %d", got, together(linked))
+		}
+		if together(linked) <= together(unlinked) {
+			t.Errorf("linked teams together on %d days, want more than the %d without the link",
+				together(linked), together(unlinked))
+		}
+		if unlinked.FamilyLinks != nil {
+			t.Errorf("FamilyLinks = %v without family_links, want nil", unlinked.FamilyLinks)
+		}
+	})
+}
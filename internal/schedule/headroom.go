@@ -0,0 +1,81 @@
+package schedule
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+// SlotCapacity returns how many games slots can hold at once under the
+// league-wide caps: per (date, time), one game per physical location
+// (fields grouped by same_physical_location count once) up to
+// max_games_per_timeslot; per date, max_games_per_date. Team rules can only
+// lower it further.
+func SlotCapacity(cfg *config.Config, slots []Slot) int {
+	locations := make(map[timeKey]map[string]bool)
+	for _, slot := range slots {
+		tk := timeKey{slot.Date, slot.Time}
+		if locations[tk] == nil {
+			locations[tk] = make(map[string]bool)
+		}
+		locations[tk][location(cfg, slot.Field)] = true
+	}
+	perDate := make(map[time.Time]int)
+	for tk, locs := range locations {
+		n := len(locs)
+		if limit := cfg.Rules.MaxGamesPerTimeslot; limit > 0 {
+			n = min(n, limit)
+		}
+		perDate[tk.date] += n
+	}
+	capacity := 0
+	for _, n := range perDate {
+		if limit := cfg.Rules.MaxGamesPerDate; limit > 0 {
+			n = min(n, limit)
+		}
+		capacity += n
+	}
+	return capacity
+}
+
+// CheckHeadroom fails when rules.require_slot_headroom is set and the
+// regular and overflow slots, less any held open by reserve_open_slots,
+// can't hold that multiple of the games, so a season that might only just
+// fit is caught before any scheduling. It takes the same slots as Schedule;
+// callers run it first, since Schedule doesn't.
+func CheckHeadroom(cfg *config.Config, slots, overflowSlots []Slot, games []strategy.Game) error {
+	headroom := cfg.Rules.RequireSlotHeadroom
+	if headroom <= 0 {
+		return nil
+	}
+	slots, _ = withoutHeld(cfg, slots)
+	capacity := SlotCapacity(cfg, slots) + SlotCapacity(cfg, overflowSlots)
+	needed := int(math.Ceil(float64(len(games)) * headroom))
+	if capacity >= needed {
+		return nil
+	}
+	return fmt.Errorf("not enough slot headroom: %d games need room for %d at require_slot_headroom %g, "+
+		"but the open slots hold only %d under max_games_per_timeslot, max_games_per_date, and same_physical_location; "+
+		"add dates, times, or fields, or free up reserved slots", len(games), needed, headroom, capacity)
+}
+
+// location names the physical location of field: the name that sorts first
+// in its same_physical_location group, or the field itself.
+func location(cfg *config.Config, field string) string {
+	return slices.Min(append(cfg.Rules.SharedLocation(field), field))
+}
+
+// SharedLocationLoss returns how many fewer games slots can hold because
+// fields in a same_physical_location group can't host games at once.
+func SharedLocationLoss(cfg *config.Config, slots []Slot) int {
+	if len(cfg.Rules.SamePhysicalLocation) == 0 {
+		return 0
+	}
+	separate := *cfg
+	separate.Rules.SamePhysicalLocation = nil
+	return SlotCapacity(&separate, slots) - SlotCapacity(cfg, slots)
+}
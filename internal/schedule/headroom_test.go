@@ -0,0 +1,87 @@
+package schedule
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+func TestSlotCapacity(t *testing.T) {
+	day := mustDate("2026-05-02")
+	slots := []Slot{
+		{Date: day, Time: "12:30", Field: "A"},
+		{Date: day, Time: "12:30", Field: "B"},
+		{Date: day, Time: "12:30", Field: "C"},
+		{Date: day, Time: "14:45", Field: "A"},
+		{Date: day.AddDate(0, 0, 1), Time: "17:00", Field: "A"},
+	}
+
+	tests := []struct {
+		name            string
+		perTime, perDay int
+		want            int
+	}{
+		{"no caps", 0, 0, 5},
+		{"timeslot cap", 2, 0, 4},
+		{"date cap", 0, 2, 3},
+		{"both caps", 2, 2, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := schedulerTestConfig()
+			cfg.Rules.MaxGamesPerTimeslot = tt.perTime
+			cfg.Rules.MaxGamesPerDate = tt.perDay
+			if got := SlotCapacity(cfg, slots); got != tt.want {
+				t.Errorf("SlotCapacity() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSharedLocationCapacity(t *testing.T) {
+	day := mustDate("2026-05-02")
+	slots := []Slot{
+		{Date: day, Time: "12:30", Field: "Moscariello Ballpark"},
+		{Date: day, Time: "12:30", Field: "Symonds Field"},
+		{Date: day, Time: "12:30", Field: "Washington Park"},
+		{Date: day, Time: "14:45", Field: "Symonds Field"},
+	}
+	cfg := schedulerTestConfig()
+	cfg.Rules.MaxGamesPerTimeslot = 0
+	cfg.Rules.SamePhysicalLocation = [][]string{{"Symonds Field", "Washington Park"}}
+	if got := SlotCapacity(cfg, slots); got != 3 {
+		t.Errorf("SlotCapacity() = %d, want 3", got)
+	}
+	if got := SharedLocationLoss(cfg, slots); got != 1 {
+		t.Errorf("SharedLocationLoss() = %d, want 1", got)
+	}
+}
+
+func TestCheckHeadroom(t *testing.T) {
+	cfg := schedulerTestConfig()
+	slots := GenerateSlots(cfg)
+	games := (&strategy.DivisionWeighted{}).GenerateMatchups(cfg.Divisions)
+	ratio := float64(SlotCapacity(cfg, slots)) / float64(len(games))
+
+	t.Run("off by default", func(t *testing.T) {
+		if err := CheckHeadroom(cfg, nil, nil, games); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("enough slots", func(t *testing.T) {
+		cfg.Rules.RequireSlotHeadroom = ratio
+		if err := CheckHeadroom(cfg, slots, nil, games); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("too few slots fails before scheduling", func(t *testing.T) {
+		cfg.Rules.RequireSlotHeadroom = ratio + 0.5
+		err := CheckHeadroom(cfg, slots, nil, games)
+		if err == nil || !strings.Contains(err.Error(), "add dates, times, or fields") {
+			t.Fatalf("error = %v, want a headroom error suggesting more slots", err)
+		}
+	})
+}
@@ -0,0 +1,98 @@
+package schedule
+
+import (
+	"sort"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+// HomeAwayStreaks returns the longest run of home games (homestand) and of
+// away games (road trip) in a team's games, given in date order as true for
+// each home game.
+func HomeAwayStreaks(homes []bool) (homestand, roadTrip int) {
+	run := 0
+	for i, home := range homes {
+		if i > 0 && home == homes[i-1] {
+			run++
+		} else {
+			run = 1
+		}
+		if home {
+			homestand = max(homestand, run)
+		} else {
+			roadTrip = max(roadTrip, run)
+		}
+	}
+	return homestand, roadTrip
+}
+
+// homeAwayStreaks returns the team's longest homestand and road trip.
+func (s *scheduler) homeAwayStreaks(team string) (homestand, roadTrip int) {
+	var homes []bool
+	for _, a := range s.teamGamesInOrder(team) {
+		homes = append(homes, a.Game.Home == team)
+	}
+	return HomeAwayStreaks(homes)
+}
+
+// OpeningAwayStreak returns how many away games open a team's season, given
+// its games in date order as true for each home game.
+func OpeningAwayStreak(homes []bool) int {
+	for i, home := range homes {
+		if home {
+			return i
+		}
+	}
+	return len(homes)
+}
+
+// openingAwayStreak returns the away games that open the team's season.
+func (s *scheduler) openingAwayStreak(team string) int {
+	var homes []bool
+	for _, a := range s.teamGamesInOrder(team) {
+		homes = append(homes, a.Game.Home == team)
+	}
+	return OpeningAwayStreak(homes)
+}
+
+// openingAwayWith returns the team's opening away streak if it played a
+// game at slot, at home or away: 0 when a home game comes before the slot,
+// since then the game can't change how the season opens.
+func (s *scheduler) openingAwayWith(team string, slot Slot, home bool) int {
+	games := s.teamGamesInOrder(team)
+	i := sort.Search(len(games), func(i int) bool {
+		d := games[i].Slot.Date
+		return d.After(slot.Date) || (d.Equal(slot.Date) && config.CompareTimes(games[i].Slot.Time, slot.Time) >= 0)
+	})
+	for _, a := range games[:i] {
+		if a.Game.Home == team {
+			return 0
+		}
+	}
+	if home {
+		return i
+	}
+	run := i + 1
+	for _, a := range games[i:] {
+		if a.Game.Home == team {
+			break
+		}
+		run++
+	}
+	return run
+}
+
+// openingAwayOverCap returns how far past max_opening_away_streak the
+// game's teams would open their seasons on the road with it at slot. The
+// home team counts too: once its first home game is taken off to be moved,
+// as repair and displacement do, putting it back later can leave the away
+// games before it over the cap.
+func (s *scheduler) openingAwayOverCap(game strategy.Game, slot Slot) int {
+	limit := s.cfg.Guidelines.MaxOpeningAwayStreak
+	if limit <= 0 {
+		return 0
+	}
+	return max(s.openingAwayWith(game.Away, slot, false)-limit, 0) +
+		max(s.openingAwayWith(game.Home, slot, true)-limit, 0)
+}
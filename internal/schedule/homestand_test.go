@@ -0,0 +1,143 @@
+package schedule
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+func TestHomeAwayStreaks(t *testing.T) {
+	tests := []struct {
+		name                string
+		homes               []bool
+		homestand, roadTrip int
+	}{
+		{"no games", nil, 0, 0},
+		{"alternating", []bool{true, false, true, false}, 1, 1},
+		{"long road trip", []bool{true, false, false, false, true, true}, 2, 3},
+		{"all home", []bool{true, true, true}, 3, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			homestand, roadTrip := HomeAwayStreaks(tt.homes)
+			if homestand != tt.homestand || roadTrip != tt.roadTrip {
+				t.Errorf("streaks = %d home, %d away; want %d, %d", homestand, roadTrip, tt.homestand, tt.roadTrip)
+			}
+		})
+	}
+}
+
+func TestHomeAwayStreakMetrics(t *testing.T) {
+	s := newScheduler(schedulerTestConfig(), nil, nil, nil)
+	// Assigned out of date order; streaks follow the calendar.
+	s.assign(strategy.Game{Home: "Cubs", Away: "Angels"}, Slot{Date: mustDate("2026-05-06"), Time: "17:45", Field: "Symonds Field"})
+	s.assign(strategy.Game{Home: "Angels", Away: "Astros"}, Slot{Date: mustDate("2026-05-02"), Time: "12:30", Field: "Symonds Field"})
+	s.assign(strategy.Game{Home: "Padres", Away: "Angels"}, Slot{Date: mustDate("2026-05-09"), Time: "12:30", Field: "Symonds Field"})
+	s.assign(strategy.Game{Home: "Angels", Away: "Royals"}, Slot{Date: mustDate("2026-05-04"), Time: "17:45", Field: "Symonds Field"})
+
+	_, metrics := s.buildMetrics()
+	if m := metrics["Angels"]; m.LongestHomestand != 2 || m.LongestRoadTrip != 2 {
+		t.Errorf("Angels streaks = %d home, %d away; want 2, 2", m.LongestHomestand, m.LongestRoadTrip)
+	}
+	if m := metrics["Astros"]; m.LongestHomestand != 0 || m.LongestRoadTrip != 1 {
+		t.Errorf("Astros streaks = %d home, %d away; want 0, 1", m.LongestHomestand, m.LongestRoadTrip)
+	}
+}
+
+func TestOpeningAwayStreak(t *testing.T) {
+	tests := []struct {
+		name  string
+		homes []bool
+		want  int
+	}{
+		{"no games", nil, 0},
+		{"opens at home", []bool{true, false, false}, 0},
+		{"opens on the road", []bool{false, false, true, false, false, false}, 2},
+		{"all away", []bool{false, false, false}, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := OpeningAwayStreak(tt.homes); got != tt.want {
+				t.Errorf("OpeningAwayStreak = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScheduleMaxOpeningAwayStreak(t *testing.T) {
+	cfg := schedulerTestConfig()
+	cfg.Guidelines.MaxOpeningAwayStreak = 2
+	opening := func(s *scheduler) {
+		s.assign(strategy.Game{Home: "Cubs", Away: "Angels"}, Slot{Date: mustDate("2026-05-02"), Time: "12:30", Field: "Symonds Field"})
+		s.assign(strategy.Game{Home: "Padres", Away: "Angels"}, Slot{Date: mustDate("2026-05-06"), Time: "17:45", Field: "Symonds Field"})
+		s.assign(strategy.Game{Home: "Angels", Away: "Royals"}, Slot{Date: mustDate("2026-05-13"), Time: "17:45", Field: "Symonds Field"})
+	}
+	game := strategy.Game{Home: "Astros", Away: "Angels"}
+	early := Slot{Date: mustDate("2026-05-09"), Time: "12:30", Field: "Symonds Field"}
+	late := Slot{Date: mustDate("2026-05-16"), Time: "12:30", Field: "Symonds Field"}
+
+	t.Run("scoreSlot avoids a third opening road game", func(t *testing.T) {
+		s := newScheduler(cfg, nil, nil, nil)
+		opening(s)
+		if got := s.openingAwayWith("Angels", early, false); got != 3 {
+			t.Errorf("openingAwayWith(early) = %d, want 3", got)
+		}
+		if got := s.openingAwayWith("Angels", late, false); got != 0 {
+			t.Errorf("openingAwayWith(late) = %d, want 0", got)
+		}
+		if s.scoreSlot(game, early) <= s.scoreSlot(game, late) {
+			t.Error("expected extending the opening road trip to score worse")
+		}
+		if _, ok := s.hardConstraintCheck(game, early); !ok {
+			t.Error("expected the slot to be allowed while the cap is a guideline")
+		}
+	})
+
+	t.Run("hardConstraintCheck rejects it when enforced", func(t *testing.T) {
+		enforced := *cfg
+		enforced.Rules.EnforceMaxOpeningAwayStreak = true
+		s := newScheduler(&enforced, nil, nil, nil)
+		opening(s)
+		if reason, ok := s.hardConstraintCheck(game, early); ok || reason != rejectOpeningAwayStreak {
+			t.Errorf("hardConstraintCheck = (%v, %v), want rejectOpeningAwayStreak", reason, ok)
+		}
+		if _, ok := s.hardConstraintCheck(game, late); !ok {
+			t.Error("expected an away game after the first home game to be allowed")
+		}
+	})
+
+	t.Run("hardConstraintCheck rejects moving the first home game later", func(t *testing.T) {
+		enforced := *cfg
+		enforced.Rules.EnforceMaxOpeningAwayStreak = true
+		s := newScheduler(&enforced, nil, nil, nil)
+		opening(s)
+		s.unassign(len(s.assignments) - 1) // the Angels' 5/13 home game
+		s.assign(strategy.Game{Home: "Cubs", Away: "Angels"}, Slot{Date: mustDate("2026-05-09"), Time: "12:30", Field: "Symonds Field"})
+		home := strategy.Game{Home: "Angels", Away: "Royals"}
+		if reason, ok := s.hardConstraintCheck(home, late); ok || reason != rejectOpeningAwayStreak {
+			t.Errorf("hardConstraintCheck = (%v, %v), want rejectOpeningAwayStreak", reason, ok)
+		}
+		if _, ok := s.hardConstraintCheck(home, Slot{Date: mustDate("2026-05-05"), Time: "17:45", Field: "Symonds Field"}); !ok {
+			t.Error("expected a home game after two road games to be allowed")
+		}
+	})
+
+	t.Run("metrics report the streak and warn past the cap", func(t *testing.T) {
+		s := newScheduler(cfg, nil, nil, nil)
+		opening(s)
+		s.assign(game, early)
+		warnings, metrics := s.buildMetrics()
+		if got := metrics["Angels"].OpeningAwayStreak; got != 3 {
+			t.Errorf("Angels OpeningAwayStreak = %d, want 3", got)
+		}
+		if got := metrics["Royals"].OpeningAwayStreak; got != 1 {
+			t.Errorf("Royals OpeningAwayStreak = %d, want 1", got)
+		}
+		if !slices.ContainsFunc(warnings, func(w Warning) bool {
+			return w.Category == WarningOpeningAwayStreak && slices.Equal(w.Teams, []string{"Angels"})
+		}) {
+			t.Errorf("warnings = %v, want an opening-away-streak warning for the Angels", warnings)
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package schedule
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+// InteractiveScheduler builds a schedule one game at a time, for tools that
+// let a person place games by hand. It enforces the same hard rules as
+// Schedule and leaves the choice of slot to the caller.
+type InteractiveScheduler struct {
+	s     *scheduler
+	known map[slotKey]bool
+}
+
+// NewInteractiveScheduler returns an empty schedule over slots.
+func NewInteractiveScheduler(cfg *config.Config, slots []Slot) *InteractiveScheduler {
+	known := make(map[slotKey]bool, len(slots))
+	for _, slot := range slots {
+		known[slotKey{slot.Date, slot.Time, slot.Field}] = true
+	}
+	return &InteractiveScheduler{s: newScheduler(cfg, slots, nil, nil), known: known}
+}
+
+// AddGame returns the open slots the game could be placed in without
+// breaking a hard rule, best first by the guidelines. It doesn't place the
+// game; to move a game already placed, Remove it first.
+func (is *InteractiveScheduler) AddGame(game strategy.Game) []Slot {
+	var legal []Slot
+	for _, c := range is.s.candidates(game, nil) {
+		if c.Rejection == "" {
+			legal = append(legal, c.Slot)
+		}
+	}
+	return legal
+}
+
+// Place puts the game in slot, or explains why it can't go there.
+func (is *InteractiveScheduler) Place(game strategy.Game, slot Slot) error {
+	sk := slotKey{slot.Date, slot.Time, slot.Field}
+	if !is.known[sk] {
+		return fmt.Errorf("%s %s on %s is not an available slot", slot.Date.Format("Mon 01/02"), slot.Time, slot.Field)
+	}
+	if is.s.usedSlots[sk] {
+		return fmt.Errorf("%s @ %s can't go at %s %s on %s: %s",
+			game.Away, game.Home, slot.Date.Format("Mon 01/02"), slot.Time, slot.Field, rejectSlotUsed)
+	}
+	if reason, ok := is.s.hardConstraintCheck(game, slot); !ok {
+		return fmt.Errorf("%s @ %s can't go at %s %s on %s: %s",
+			game.Away, game.Home, slot.Date.Format("Mon 01/02"), slot.Time, slot.Field, reason)
+	}
+	is.s.assign(game, slot)
+	return nil
+}
+
+// Remove takes the game off the schedule, matching on its label when it
+// has one and on home and away otherwise. It reports whether the game was
+// placed.
+func (is *InteractiveScheduler) Remove(game strategy.Game) bool {
+	i := slices.IndexFunc(is.s.assignments, func(a Assignment) bool {
+		if game.Label != "" {
+			return a.Game.Label == game.Label
+		}
+		return a.Game.Home == game.Home && a.Game.Away == game.Away
+	})
+	if i < 0 {
+		return false
+	}
+	is.s.unassign(i)
+	return true
+}
+
+// Assignments returns the games placed so far, in the order they were
+// placed.
+func (is *InteractiveScheduler) Assignments() []Assignment {
+	return slices.Clone(is.s.assignments)
+}
@@ -0,0 +1,72 @@
+package schedule
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+func TestInteractiveScheduler(t *testing.T) {
+	cfg := schedulerTestConfig()
+	slots := GenerateSlots(cfg)
+	is := NewInteractiveScheduler(cfg, slots)
+
+	first := strategy.Game{Home: "Angels", Away: "Astros", Label: "Game 1"}
+	legal := is.AddGame(first)
+	if len(legal) != len(slots) {
+		t.Fatalf("AddGame() on an empty schedule = %d slots, want all %d", len(legal), len(slots))
+	}
+	slot := legal[0]
+	if err := is.Place(first, slot); err != nil {
+		t.Fatalf("Place() error: %v", err)
+	}
+
+	t.Run("legal slots exclude taken slots and hard-rule breaks", func(t *testing.T) {
+		second := strategy.Game{Home: "Angels", Away: "Royals", Label: "Game 2"}
+		legal := is.AddGame(second)
+		for _, s := range legal {
+			if s == slot {
+				t.Errorf("AddGame() offered %v, already taken", s)
+			}
+			if s.Date.Equal(slot.Date) {
+				t.Errorf("AddGame() offered %v, a second Angels game that day", s)
+			}
+		}
+		if len(legal) == 0 {
+			t.Error("AddGame() returned no slots")
+		}
+	})
+
+	t.Run("Place explains an illegal slot", func(t *testing.T) {
+		err := is.Place(strategy.Game{Home: "Cubs", Away: "Padres", Label: "Game 3"}, slot)
+		if err == nil || !strings.Contains(err.Error(), "slot already taken") {
+			t.Errorf("Place() in a taken slot = %v, want slot already taken", err)
+		}
+		other := slices.IndexFunc(slots, func(s Slot) bool { return s.Date.Equal(slot.Date) && s != slot })
+		err = is.Place(strategy.Game{Home: "Astros", Away: "Royals", Label: "Game 4"}, slots[other])
+		if err == nil {
+			t.Error("Place() of a second Astros game that day succeeded, want an error")
+		}
+		err = is.Place(strategy.Game{Home: "Cubs", Away: "Padres"}, Slot{Date: slot.Date, Time: "03:00", Field: slot.Field})
+		if err == nil || !strings.Contains(err.Error(), "not an available slot") {
+			t.Errorf("Place() in an unknown slot = %v, want not an available slot", err)
+		}
+	})
+
+	t.Run("Remove frees the slot", func(t *testing.T) {
+		if is.Remove(strategy.Game{Label: "Game 9"}) {
+			t.Error("Remove() of an unplaced game reported true")
+		}
+		if !is.Remove(first) {
+			t.Fatal("Remove() of a placed game reported false")
+		}
+		if n := len(is.Assignments()); n != 0 {
+			t.Errorf("%d games placed after Remove(), want 0", n)
+		}
+		if !slices.Contains(is.AddGame(first), slot) {
+			t.Error("slot not offered again after Remove()")
+		}
+	})
+}
@@ -0,0 +1,37 @@
+package schedule
+
+import "github.com/derekprior/rbrl/internal/strategy"
+
+// Conflict records a game that could not be merged because its slot was
+// already taken by a different game.
+type Conflict struct {
+	Slot     Slot
+	Kept     strategy.Game
+	Rejected strategy.Game
+}
+
+// Merge unions several sets of assignments. A game that appears in more than
+// one set at the same slot is kept once. When different games claim the same
+// (date, time, field), the first one wins and the others are returned as
+// conflicts.
+func Merge(sets ...[]Assignment) ([]Assignment, []Conflict) {
+	var merged []Assignment
+	var conflicts []Conflict
+	bySlot := make(map[slotKey]strategy.Game)
+
+	for _, set := range sets {
+		for _, a := range set {
+			sk := slotKey{a.Slot.Date, a.Slot.Time, a.Slot.Field}
+			if existing, ok := bySlot[sk]; ok {
+				if existing.Home != a.Game.Home || existing.Away != a.Game.Away {
+					conflicts = append(conflicts, Conflict{Slot: a.Slot, Kept: existing, Rejected: a.Game})
+				}
+				continue
+			}
+			bySlot[sk] = a.Game
+			merged = append(merged, a)
+		}
+	}
+
+	return merged, conflicts
+}
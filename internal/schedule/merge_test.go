@@ -0,0 +1,41 @@
+package schedule
+
+import (
+	"testing"
+
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+func TestMerge(t *testing.T) {
+	sat := Slot{Date: mustDate("2026-05-02"), Time: "12:30", Field: "Symonds Field"}
+	mon := Slot{Date: mustDate("2026-05-04"), Time: "17:45", Field: "Washington Park"}
+	tue := Slot{Date: mustDate("2026-05-05"), Time: "17:45", Field: "Washington Park"}
+
+	a := []Assignment{
+		{Game: strategy.Game{Home: "Angels", Away: "Cubs"}, Slot: sat},
+		{Game: strategy.Game{Home: "Astros", Away: "Padres"}, Slot: mon},
+	}
+	b := []Assignment{
+		{Game: strategy.Game{Home: "Angels", Away: "Cubs"}, Slot: sat},    // duplicate
+		{Game: strategy.Game{Home: "Royals", Away: "Marlins"}, Slot: mon}, // conflict
+		{Game: strategy.Game{Home: "Mariners", Away: "Pirates"}, Slot: tue},
+	}
+
+	merged, conflicts := Merge(a, b)
+
+	t.Run("duplicates kept once", func(t *testing.T) {
+		if len(merged) != 3 {
+			t.Errorf("merged = %d games, want 3", len(merged))
+		}
+	})
+
+	t.Run("slot conflicts reported", func(t *testing.T) {
+		if len(conflicts) != 1 {
+			t.Fatalf("conflicts = %d, want 1", len(conflicts))
+		}
+		c := conflicts[0]
+		if c.Slot != mon || c.Kept.Home != "Astros" || c.Rejected.Home != "Royals" {
+			t.Errorf("conflict = %+v, want Astros kept over Royals on 05/04", c)
+		}
+	})
+}
@@ -0,0 +1,62 @@
+package schedule
+
+import (
+	"sort"
+	"time"
+)
+
+// PaceGap is the widest spread in games played across teams at the end of
+// any week.
+type PaceGap struct {
+	Week     time.Time // Monday of the week
+	Min, Max int       // fewest and most games played through that week
+}
+
+// Spread returns Max - Min.
+func (g PaceGap) Spread() int { return g.Max - g.Min }
+
+// PaceSpread walks the season week by week and returns the week where the
+// gap between the teams with the most and fewest games played so far is
+// widest; ties go to the earliest week. Weeks after every team's last game
+// are skipped, since a finished team can't fall further behind.
+func PaceSpread(teams []string, assignments []Assignment) PaceGap {
+	if len(assignments) == 0 {
+		return PaceGap{}
+	}
+
+	sorted := append([]Assignment(nil), assignments...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Slot.Date.Before(sorted[j].Slot.Date)
+	})
+	played := make(map[string]int)
+	for _, team := range teams {
+		played[team] = 0
+	}
+
+	var worst PaceGap
+	found := false
+	last := WeekStart(sorted[len(sorted)-1].Slot.Date)
+	i := 0
+	for week := WeekStart(sorted[0].Slot.Date); !week.After(last); week = week.AddDate(0, 0, 7) {
+		next := week.AddDate(0, 0, 7)
+		for i < len(sorted) && sorted[i].Slot.Date.Before(next) {
+			played[sorted[i].Game.Home]++
+			played[sorted[i].Game.Away]++
+			i++
+		}
+		if week.Equal(last) {
+			break // the final week only shows how many games each team plays in all
+		}
+		gap := PaceGap{Week: week, Min: -1}
+		for _, n := range played {
+			if gap.Min < 0 || n < gap.Min {
+				gap.Min = n
+			}
+			gap.Max = max(gap.Max, n)
+		}
+		if !found || gap.Spread() > worst.Spread() {
+			worst, found = gap, true
+		}
+	}
+	return worst
+}
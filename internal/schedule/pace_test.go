@@ -0,0 +1,53 @@
+package schedule
+
+import (
+	"testing"
+
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+func TestPaceSpread(t *testing.T) {
+	teams := []string{"Angels", "Astros", "Cubs", "Padres"}
+	game := func(date, home, away string) Assignment {
+		return Assignment{Game: strategy.Game{Home: home, Away: away}, Slot: Slot{Date: mustDate(date)}}
+	}
+
+	t.Run("widest week wins", func(t *testing.T) {
+		gap := PaceSpread(teams, []Assignment{
+			game("2026-04-27", "Angels", "Astros"), // week of Apr 27: 1/1/0/0
+			game("2026-05-04", "Angels", "Astros"), // week of May 4:  2/2/0/0
+			game("2026-05-11", "Cubs", "Padres"),   // final week, not counted
+		})
+		if !gap.Week.Equal(mustDate("2026-05-04")) || gap.Min != 0 || gap.Max != 2 {
+			t.Errorf("gap = %+v, want 0 to 2 games in the week of May 4", gap)
+		}
+	})
+
+	t.Run("ties go to the earliest week", func(t *testing.T) {
+		gap := PaceSpread(teams, []Assignment{
+			game("2026-04-27", "Angels", "Astros"), // week of Apr 27: 1/1/0/0
+			game("2026-05-04", "Angels", "Astros"), // week of May 4:  2/2/1/1
+			game("2026-05-06", "Cubs", "Padres"),
+			game("2026-05-11", "Cubs", "Padres"),
+		})
+		if !gap.Week.Equal(mustDate("2026-04-27")) || gap.Spread() != 1 {
+			t.Errorf("gap = %+v, want a spread of 1 in the week of Apr 27", gap)
+		}
+	})
+
+	t.Run("final week is ignored", func(t *testing.T) {
+		gap := PaceSpread(teams, []Assignment{
+			game("2026-05-04", "Angels", "Astros"),
+			game("2026-05-05", "Astros", "Angels"),
+		})
+		if gap.Spread() != 0 {
+			t.Errorf("spread = %d, want 0 for a single-week schedule", gap.Spread())
+		}
+	})
+
+	t.Run("empty schedule", func(t *testing.T) {
+		if gap := PaceSpread(teams, nil); gap.Spread() != 0 {
+			t.Errorf("spread = %d, want 0", gap.Spread())
+		}
+	})
+}
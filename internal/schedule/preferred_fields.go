@@ -0,0 +1,48 @@
+package schedule
+
+import "github.com/derekprior/rbrl/internal/strategy"
+
+// PreferredFieldReport summarizes how well one division's preferred_fields
+// were honored.
+type PreferredFieldReport struct {
+	Division  string
+	Games     int // scheduled games involving the division
+	Preferred int // of those, games on one of its preferred fields
+}
+
+// offPreference counts the game's divisions that list preferred_fields
+// without field. A game between two divisions counts for each side.
+func (s *scheduler) offPreference(game strategy.Game, field string) int {
+	n := 0
+	for _, div := range s.divisionsOf(game) {
+		if prefs := s.preferred[div]; len(prefs) > 0 && !prefs[field] {
+			n++
+		}
+	}
+	return n
+}
+
+// preferredFieldReports counts, for each division with preferred_fields in
+// config order, its games and how many landed on a preferred field.
+func (s *scheduler) preferredFieldReports() []PreferredFieldReport {
+	var reports []PreferredFieldReport
+	for _, div := range s.cfg.Divisions {
+		if len(div.PreferredFields) == 0 {
+			continue
+		}
+		r := PreferredFieldReport{Division: div.Name}
+		for _, a := range s.assignments {
+			for _, d := range s.divisionsOf(a.Game) {
+				if d != div.Name {
+					continue
+				}
+				r.Games++
+				if s.preferred[d][a.Slot.Field] {
+					r.Preferred++
+				}
+			}
+		}
+		reports = append(reports, r)
+	}
+	return reports
+}
@@ -0,0 +1,73 @@
+package schedule
+
+import (
+	"testing"
+
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+func TestPreferredFields(t *testing.T) {
+	cfg := schedulerTestConfig()
+	cfg.Divisions[1].PreferredFields = []string{"Symonds Field"}
+	sat := mustDate("2026-05-02")
+
+	t.Run("scoreSlot penalizes fields off the division's preference", func(t *testing.T) {
+		s := newScheduler(cfg, nil, nil, nil)
+		national := strategy.Game{Home: "Cubs", Away: "Padres"}
+		preferred := s.scoreSlot(national, Slot{Date: sat, Time: "12:30", Field: "Symonds Field"})
+		other := s.scoreSlot(national, Slot{Date: sat, Time: "12:30", Field: "Washington Park"})
+		if preferred >= other {
+			t.Errorf("preferred field scored %.2f, other field %.2f; want preferred lower", preferred, other)
+		}
+		american := strategy.Game{Home: "Angels", Away: "Astros"}
+		if s.offPreference(american, "Washington Park") != 0 {
+			t.Error("offPreference for a division without preferred_fields, want 0")
+		}
+		if n := s.offPreference(strategy.Game{Home: "Angels", Away: "Cubs"}, "Washington Park"); n != 1 {
+			t.Errorf("offPreference for a cross-division game = %d, want 1", n)
+		}
+	})
+
+	t.Run("Schedule reports preferred games per division", func(t *testing.T) {
+		national := make(map[string]bool)
+		for _, team := range cfg.Divisions[1].Teams {
+			national[team] = true
+		}
+		onSymonds := func(result *Result) (games, preferred int) {
+			for _, a := range result.Assignments {
+				if national[a.Game.Home] || national[a.Game.Away] {
+					games++
+					if a.Slot.Field == "Symonds Field" {
+						preferred++
+					}
+				}
+			}
+			return games, preferred
+		}
+
+		games := (&strategy.DivisionWeighted{}).GenerateMatchups(cfg.Divisions)
+		result, err := Schedule(cfg, GenerateSlots(cfg), nil, games)
+		if err != nil {
+			t.Fatalf("Schedule() error: %v", err)
+		}
+		if len(result.Preferred) != 1 || result.Preferred[0].Division != "National" {
+			t.Fatalf("Preferred = %+v, want one report for National", result.Preferred)
+		}
+		r := result.Preferred[0]
+		if n, p := onSymonds(result); r.Games != n || r.Preferred != p {
+			t.Errorf("report = %+v, want %d games, %d preferred", r, n, p)
+		}
+
+		plain := schedulerTestConfig()
+		unpreferred, err := Schedule(plain, GenerateSlots(plain), nil, games)
+		if err != nil {
+			t.Fatalf("Schedule() error: %v", err)
+		}
+		if _, before := onSymonds(unpreferred); r.Preferred <= before {
+			t.Errorf("games on Symonds Field: %d with preferred_fields, %d without; want more", r.Preferred, before)
+		}
+		if len(unpreferred.Preferred) != 0 {
+			t.Errorf("Preferred without preferred_fields = %+v, want none", unpreferred.Preferred)
+		}
+	})
+}
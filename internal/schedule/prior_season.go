@@ -0,0 +1,89 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+// priorSeason holds, for each team in a prior season, how far its share of
+// games at each slot time and each field ran over the league's share.
+// Only overages are kept: they are what the new season corrects.
+type priorSeason struct {
+	times  map[string]map[string]float64 // team -> slot time key -> excess share
+	fields map[string]map[string]float64 // team -> field -> excess share
+}
+
+// newPriorSeason tallies games; it returns nil when there are none.
+func newPriorSeason(games []config.PriorGame) *priorSeason {
+	if len(games) == 0 {
+		return nil
+	}
+	return &priorSeason{
+		times: excessShares(games, func(g config.PriorGame) string {
+			return slotTimeKey(g.Date, g.Time)
+		}),
+		fields: excessShares(games, func(g config.PriorGame) string { return g.Field }),
+	}
+}
+
+// slotTimeKey names a slot time by the kind of day it falls on, so that
+// "Saturday 12:30" and a weekday 17:45 are told apart across seasons whose
+// dates don't line up.
+func slotTimeKey(d time.Time, hhmm string) string {
+	switch d.Weekday() {
+	case time.Saturday, time.Sunday:
+		return d.Weekday().String() + " " + hhmm
+	}
+	return "weekday " + hhmm
+}
+
+// excessShares returns, for each team, the keys where the team's share of
+// its own games exceeds the share of all games, by how much.
+func excessShares(games []config.PriorGame, key func(config.PriorGame) string) map[string]map[string]float64 {
+	teamCounts := make(map[string]map[string]int)
+	teamTotal := make(map[string]int)
+	league := make(map[string]int)
+	for _, g := range games {
+		k := key(g)
+		league[k] += 2
+		for _, team := range []string{g.Home, g.Away} {
+			if teamCounts[team] == nil {
+				teamCounts[team] = make(map[string]int)
+			}
+			teamCounts[team][k]++
+			teamTotal[team]++
+		}
+	}
+
+	excess := make(map[string]map[string]float64)
+	for team, counts := range teamCounts {
+		for k, n := range counts {
+			over := float64(n)/float64(teamTotal[team]) - float64(league[k])/float64(2*len(games))
+			if over <= 0 {
+				continue
+			}
+			if excess[team] == nil {
+				excess[team] = make(map[string]float64)
+			}
+			excess[team][k] = over
+		}
+	}
+	return excess
+}
+
+// priorPenalty scores a slot against the game's teams' prior season: each
+// team adds its overage at the slot's time and at its field, so a team
+// stuck with early Saturdays last season is steered toward later ones.
+func (s *scheduler) priorPenalty(game strategy.Game, slot Slot) float64 {
+	if s.prior == nil {
+		return 0
+	}
+	key := slotTimeKey(slot.Date, slot.Time)
+	penalty := 0.0
+	for _, team := range []string{game.Home, game.Away} {
+		penalty += s.prior.times[team][key] + s.prior.fields[team][slot.Field]
+	}
+	return penalty * 20
+}
@@ -0,0 +1,50 @@
+package schedule
+
+import (
+	"testing"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+func TestPriorSeason(t *testing.T) {
+	sat := mustDate("2026-05-02")
+	prior := []config.PriorGame{
+		{Home: "Angels", Away: "Astros", Date: sat, Time: "12:30", Field: "Symonds Field"},
+		{Home: "Angels", Away: "Athletics", Date: mustDate("2026-05-09"), Time: "12:30", Field: "Symonds Field"},
+		{Home: "Astros", Away: "Athletics", Date: mustDate("2026-05-16"), Time: "17:00", Field: "Washington Park"},
+	}
+
+	t.Run("keeps only shares over the league's", func(t *testing.T) {
+		p := newPriorSeason(prior)
+		// Angels played both their games at Saturday 12:30; the league
+		// played 4 of 6 team-games there.
+		if got, want := p.times["Angels"]["Saturday 12:30"], 1-4.0/6; got < want-1e-9 || got > want+1e-9 {
+			t.Errorf("Angels Saturday 12:30 excess = %.3f, want %.3f", got, want)
+		}
+		if _, ok := p.times["Astros"]["Saturday 12:30"]; ok {
+			t.Error("expected no excess for the Astros at their league share of 12:30")
+		}
+		if p.fields["Athletics"]["Washington Park"] <= 0 {
+			t.Error("expected an excess for the Athletics at Washington Park")
+		}
+		if newPriorSeason(nil) != nil {
+			t.Error("newPriorSeason(nil) should be nil")
+		}
+	})
+
+	t.Run("scoreSlot steers a team off the times it drew too often", func(t *testing.T) {
+		cfg := schedulerTestConfig()
+		cfg.PriorSeason = prior
+		s := newScheduler(cfg, nil, nil, nil)
+		game := strategy.Game{Home: "Angels", Away: "Cubs"}
+		early := s.scoreSlot(game, Slot{Date: mustDate("2026-05-30"), Time: "12:30", Field: "Moscariello Ballpark"})
+		late := s.scoreSlot(game, Slot{Date: mustDate("2026-05-30"), Time: "14:45", Field: "Moscariello Ballpark"})
+		if early <= late {
+			t.Errorf("12:30 scored %.2f, 14:45 %.2f; want 12:30 higher for the Angels", early, late)
+		}
+		if s.priorPenalty(strategy.Game{Home: "Cubs", Away: "Padres"}, Slot{Date: sat, Time: "12:30", Field: "Symonds Field"}) != 0 {
+			t.Error("expected no penalty for teams without a prior season")
+		}
+	})
+}
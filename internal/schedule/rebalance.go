@@ -0,0 +1,149 @@
+package schedule
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/derekprior/rbrl/internal/config"
+)
+
+// Swap records two games that traded slots while rebalancing. Sunday is the
+// game that was on Sunday before the swap.
+type Swap struct {
+	Sunday Assignment
+	Other  Assignment
+}
+
+// RebalanceSundays evens out Sunday games across teams by swapping the slots
+// of a Sunday game and a non-Sunday game, one swap at a time, until no team
+// has more than one Sunday game over any other or no swap helps. A swap is
+// only made if it adds no hard constraint violations; among equally good
+// swaps, the one with the best soft score wins. slots are the season's slots,
+// used for soft scoring. Returns the rebalanced assignments and the swaps
+// made, in order.
+func RebalanceSundays(cfg *config.Config, slots []Slot, assignments []Assignment) ([]Assignment, []Swap) {
+	current := append([]Assignment(nil), assignments...)
+	var swaps []Swap
+
+	for range len(current) {
+		counts := sundayCounts(cfg, current)
+		if sundaySpread(counts) <= 1 {
+			break
+		}
+		baseline, _ := replay(cfg, slots, current)
+
+		currentSquares := sundaySquares(counts)
+		bestI, bestJ := -1, -1
+		bestSquares, bestSoft := currentSquares, math.MaxFloat64
+		for i, sun := range current {
+			if sun.Slot.Date.Weekday() != time.Sunday {
+				continue
+			}
+			for j, other := range current {
+				if other.Slot.Date.Weekday() == time.Sunday {
+					continue
+				}
+				for _, team := range []string{sun.Game.Home, sun.Game.Away} {
+					counts[team]--
+				}
+				for _, team := range []string{other.Game.Home, other.Game.Away} {
+					counts[team]++
+				}
+				squares := sundaySquares(counts)
+				for _, team := range []string{sun.Game.Home, sun.Game.Away} {
+					counts[team]++
+				}
+				for _, team := range []string{other.Game.Home, other.Game.Away} {
+					counts[team]--
+				}
+				if squares >= currentSquares-scoreEpsilon || squares > bestSquares+scoreEpsilon {
+					continue
+				}
+
+				current[i].Slot, current[j].Slot = other.Slot, sun.Slot
+				violations, s := replay(cfg, slots, current)
+				soft := s.softScore()
+				current[i].Slot, current[j].Slot = sun.Slot, other.Slot
+				if violations > baseline {
+					continue
+				}
+				if bestI < 0 || squares < bestSquares-scoreEpsilon || soft < bestSoft {
+					bestI, bestJ = i, j
+					bestSquares, bestSoft = squares, soft
+				}
+			}
+		}
+
+		if bestI < 0 {
+			break
+		}
+		swaps = append(swaps, Swap{Sunday: current[bestI], Other: current[bestJ]})
+		current[bestI].Slot, current[bestJ].Slot = current[bestJ].Slot, current[bestI].Slot
+	}
+
+	return current, swaps
+}
+
+// replay assigns the games in date order on a fresh scheduler, counting the
+// games that break a hard constraint against those before them.
+func replay(cfg *config.Config, slots []Slot, assignments []Assignment) (int, *scheduler) {
+	ordered := append([]Assignment(nil), assignments...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		a, b := ordered[i].Slot, ordered[j].Slot
+		if !a.Date.Equal(b.Date) {
+			return a.Date.Before(b.Date)
+		}
+		if c := config.CompareTimes(a.Time, b.Time); c != 0 {
+			return c < 0
+		}
+		return a.Field < b.Field
+	})
+
+	s := newScheduler(cfg, slots, nil, nil)
+	violations := 0
+	for _, a := range ordered {
+		if _, ok := s.hardConstraintCheck(a.Game, a.Slot); !ok {
+			violations++
+		}
+		s.assign(a.Game, a.Slot)
+	}
+	return violations, s
+}
+
+func sundayCounts(cfg *config.Config, assignments []Assignment) map[string]int {
+	counts := make(map[string]int)
+	for _, team := range cfg.AllTeams() {
+		counts[team] = 0
+	}
+	for _, a := range assignments {
+		if a.Slot.Date.Weekday() == time.Sunday {
+			counts[a.Game.Home]++
+			counts[a.Game.Away]++
+		}
+	}
+	return counts
+}
+
+func sundaySpread(counts map[string]int) int {
+	most, least := 0, math.MaxInt
+	for _, n := range counts {
+		most = max(most, n)
+		least = min(least, n)
+	}
+	if least == math.MaxInt {
+		return 0
+	}
+	return most - least
+}
+
+// sundaySquares sums each team's squared Sunday count. Moving a Sunday from
+// a team with more to one with fewer always lowers it, so it measures how
+// uneven the counts are.
+func sundaySquares(counts map[string]int) float64 {
+	total := 0.0
+	for _, n := range counts {
+		total += float64(n * n)
+	}
+	return total
+}
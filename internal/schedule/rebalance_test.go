@@ -0,0 +1,77 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+func TestRebalanceSundays(t *testing.T) {
+	fourTeams := func() *config.Config {
+		cfg := schedulerTestConfig()
+		cfg.Divisions = []config.Division{{Name: "League", Teams: []string{"Angels", "Astros", "Cubs", "Padres"}}}
+		return cfg
+	}
+	lopsided := func() []Assignment {
+		var as []Assignment
+		for _, d := range []string{"2026-04-26", "2026-05-03", "2026-05-17"} {
+			as = append(as, Assignment{
+				Game: strategy.Game{Home: "Angels", Away: "Astros"},
+				Slot: Slot{Date: mustDate(d), Time: "17:00", Field: "Moscariello Ballpark"},
+			})
+		}
+		for _, d := range []string{"2026-04-29", "2026-05-06", "2026-05-13"} {
+			as = append(as, Assignment{
+				Game: strategy.Game{Home: "Cubs", Away: "Padres"},
+				Slot: Slot{Date: mustDate(d), Time: "17:45", Field: "Symonds Field"},
+			})
+		}
+		return as
+	}
+
+	t.Run("swaps until Sundays are within one", func(t *testing.T) {
+		cfg := fourTeams()
+		before := lopsided()
+		after, swaps := RebalanceSundays(cfg, GenerateSlots(cfg), before)
+		if len(swaps) != 1 {
+			t.Fatalf("swaps = %d, want 1", len(swaps))
+		}
+		if spread := sundaySpread(sundayCounts(cfg, after)); spread > 1 {
+			t.Errorf("Sunday spread = %d, want at most 1", spread)
+		}
+		if swaps[0].Sunday.Game.Home != "Angels" || swaps[0].Other.Game.Home != "Cubs" {
+			t.Errorf("swap = %+v, want an Angels Sunday game traded with a Cubs game", swaps[0])
+		}
+		if before[0].Slot.Date.Weekday() != time.Sunday {
+			t.Error("RebalanceSundays changed its input")
+		}
+		if violations, _ := replay(cfg, GenerateSlots(cfg), after); violations != 0 {
+			t.Errorf("rebalanced schedule has %d hard constraint violations", violations)
+		}
+	})
+
+	t.Run("no swap that breaks a hard constraint", func(t *testing.T) {
+		cfg := fourTeams()
+		cfg.Teams = []config.Team{{Name: "Cubs", HomeField: "Symonds Field"}}
+		before := lopsided()
+		after, swaps := RebalanceSundays(cfg, GenerateSlots(cfg), before)
+		if len(swaps) != 0 {
+			t.Errorf("swaps = %+v, want none: the Cubs' home games must stay on Symonds Field", swaps)
+		}
+		for i := range before {
+			if after[i].Slot != before[i].Slot {
+				t.Errorf("game %d moved from %+v to %+v", i, before[i].Slot, after[i].Slot)
+			}
+		}
+	})
+
+	t.Run("balanced schedule is left alone", func(t *testing.T) {
+		cfg := fourTeams()
+		as := lopsided()[2:5] // one Sunday game, for the Angels and Astros
+		if _, swaps := RebalanceSundays(cfg, GenerateSlots(cfg), as); len(swaps) != 0 {
+			t.Errorf("swaps = %d, want 0", len(swaps))
+		}
+	})
+}
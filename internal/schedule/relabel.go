@@ -0,0 +1,129 @@
+package schedule
+
+import (
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Relabel returns a copy of the result with every team renamed through
+// names, for a blind review; pair it with config.Relabel. Team names are
+// also replaced inside warning and violation messages and game notes. The
+// schedule itself and every count are unchanged.
+func (r *Result) Relabel(names map[string]string) *Result {
+	rename := func(team string) string {
+		if name, ok := names[team]; ok {
+			return name
+		}
+		return team
+	}
+	renameAll := func(teams []string) []string {
+		if teams == nil {
+			return nil
+		}
+		out := make([]string, len(teams))
+		for i, team := range teams {
+			out[i] = rename(team)
+		}
+		return out
+	}
+	text := TeamReplacer(names)
+
+	out := *r
+	out.Assignments = make([]Assignment, len(r.Assignments))
+	for i, a := range r.Assignments {
+		a.Game.Home, a.Game.Away = rename(a.Game.Home), rename(a.Game.Away)
+		a.Game.Note = text.Replace(a.Game.Note)
+		out.Assignments[i] = a
+	}
+	out.Warnings = make([]Warning, len(r.Warnings))
+	for i, w := range r.Warnings {
+		out.Warnings[i] = Warning{Category: w.Category, Teams: renameAll(w.Teams), Message: text.Replace(w.Message)}
+	}
+	if r.TeamGames != nil {
+		out.TeamGames = make(map[string]int, len(r.TeamGames))
+		for team, n := range r.TeamGames {
+			out.TeamGames[rename(team)] = n
+		}
+	}
+	if r.TeamMetrics != nil {
+		out.TeamMetrics = make(map[string]*TeamMetrics, len(r.TeamMetrics))
+		for team, m := range r.TeamMetrics {
+			copied := *m
+			copied.ToughestStretch = renameAll(m.ToughestStretch)
+			copied.Violations = make([]string, len(m.Violations))
+			for i, v := range m.Violations {
+				copied.Violations[i] = text.Replace(v)
+			}
+			out.TeamMetrics[rename(team)] = &copied
+		}
+	}
+	out.FamilyLinks = make([]FamilyLinkReport, len(r.FamilyLinks))
+	for i, link := range r.FamilyLinks {
+		link.Teams = renameAll(link.Teams)
+		out.FamilyLinks[i] = link
+	}
+	if r.Rematch != nil {
+		out.Rematch = &RematchGap{Teams: [2]string{rename(r.Rematch.Teams[0]), rename(r.Rematch.Teams[1])}, Days: r.Rematch.Days}
+	}
+	if r.SeasonEnd != nil {
+		end := *r.SeasonEnd
+		end.FirstTeam, end.LastTeam = rename(end.FirstTeam), rename(end.LastTeam)
+		out.SeasonEnd = &end
+	}
+	return &out
+}
+
+// TeamReplacer rewrites team names in free text, such as warning messages,
+// through names. Only whole words match, so relabeling "Red" leaves "Red
+// Sox" and "Reds" alone, and longer names are tried first, so "Red Sox"
+// isn't rewritten as "Red" plus "Sox".
+func TeamReplacer(names map[string]string) *NameReplacer {
+	teams := slices.Collect(maps.Keys(names))
+	slices.SortFunc(teams, func(a, b string) int {
+		if len(a) != len(b) {
+			return len(b) - len(a)
+		}
+		return strings.Compare(a, b)
+	})
+	var alternatives []string
+	for _, team := range teams {
+		if team == "" {
+			continue
+		}
+		pattern := regexp.QuoteMeta(team)
+		if wordByte(team[0]) {
+			pattern = `\b` + pattern
+		}
+		if wordByte(team[len(team)-1]) {
+			pattern += `\b`
+		}
+		alternatives = append(alternatives, pattern)
+	}
+	r := &NameReplacer{names: names}
+	if len(alternatives) > 0 {
+		r.re = regexp.MustCompile(strings.Join(alternatives, "|"))
+	}
+	return r
+}
+
+// NameReplacer replaces whole team names in text; see TeamReplacer.
+type NameReplacer struct {
+	re    *regexp.Regexp
+	names map[string]string
+}
+
+// Replace returns s with each team name replaced.
+func (r *NameReplacer) Replace(s string) string {
+	if r.re == nil {
+		return s
+	}
+	return r.re.ReplaceAllStringFunc(s, func(team string) string { return r.names[team] })
+}
+
+// wordByte reports whether b is an ASCII word character, the kind \b
+// looks for on either side of a boundary.
+func wordByte(b byte) bool {
+	return b == '_' || '0' <= b && b <= '9' || 'a' <= b && b <= 'z' || 'A' <= b && b <= 'Z'
+}
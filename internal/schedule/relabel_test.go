@@ -0,0 +1,65 @@
+package schedule
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+func TestRelabel(t *testing.T) {
+	result := &Result{
+		Assignments: []Assignment{{Game: strategy.Game{Home: "Red Sox", Away: "Sox", Note: "Red Sox 50th anniversary"}}},
+		Warnings:    []Warning{{Category: WarningRematch, Teams: []string{"Red Sox", "Sox"}, Message: "Red Sox and Sox meet twice in 3 days"}},
+		TeamGames:   map[string]int{"Red Sox": 1, "Sox": 1},
+		TeamMetrics: map[string]*TeamMetrics{
+			"Red Sox": {Games: 1, Home: 1, ToughestStretch: []string{"Sox"}, Violations: []string{"Red Sox and Sox meet twice in 3 days"}},
+			"Sox":     {Games: 1, Away: 1},
+		},
+		Rematch: &RematchGap{Teams: [2]string{"Red Sox", "Sox"}, Days: 3},
+	}
+	names := map[string]string{"Red Sox": "Team 1", "Sox": "Team 2"}
+	blind := result.Relabel(names)
+
+	if g := blind.Assignments[0].Game; g.Home != "Team 1" || g.Away != "Team 2" {
+		t.Errorf("game = %s vs %s, want Team 1 vs Team 2", g.Home, g.Away)
+	}
+	if note := blind.Assignments[0].Game.Note; note != "Team 1 50th anniversary" {
+		t.Errorf("note = %q, want the team name replaced", note)
+	}
+	w := blind.Warnings[0]
+	if !slices.Equal(w.Teams, []string{"Team 1", "Team 2"}) || w.Message != "Team 1 and Team 2 meet twice in 3 days" {
+		t.Errorf("warning = %+v", w)
+	}
+	if blind.TeamGames["Team 1"] != 1 || blind.TeamGames["Red Sox"] != 0 {
+		t.Errorf("team games = %v", blind.TeamGames)
+	}
+	m := blind.TeamMetrics["Team 1"]
+	if m == nil || m.Home != 1 || !slices.Equal(m.ToughestStretch, []string{"Team 2"}) || m.Violations[0] != w.Message {
+		t.Errorf("Team 1 metrics = %+v", m)
+	}
+	if r := blind.Rematch; r.Teams != [2]string{"Team 1", "Team 2"} || r.Days != 3 {
+		t.Errorf("rematch = %+v", r)
+	}
+	if result.Assignments[0].Game.Home != "Red Sox" || result.TeamMetrics["Red Sox"].Violations[0] != "Red Sox and Sox meet twice in 3 days" {
+		t.Error("Relabel changed the original result")
+	}
+}
+
+func TestTeamReplacer(t *testing.T) {
+	r := TeamReplacer(map[string]string{"Red": "Team 1", "Red Sox": "Team 2", "A's": "Team 3"})
+	tests := []struct{ in, want string }{
+		{"Red and Red Sox meet twice", "Team 1 and Team 2 meet twice"},
+		{"Reds fans Redeem at Red's stand", "Reds fans Redeem at Team 1's stand"},
+		{"A's @ Red", "Team 3 @ Team 1"},
+		{"Hatred", "Hatred"},
+	}
+	for _, tt := range tests {
+		if got := r.Replace(tt.in); got != tt.want {
+			t.Errorf("Replace(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+	if got := TeamReplacer(nil).Replace("Red"); got != "Red" {
+		t.Errorf("Replace with no names = %q, want it unchanged", got)
+	}
+}
@@ -0,0 +1,119 @@
+package schedule
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+// relaxations are the guideline changes ScheduleRelaxed tries, in order,
+// each on top of the ones before. Each returns what it changed, or "" when
+// the guideline was already off. Hard rules are never touched.
+var relaxations = []func(c *config.Config) string{
+	func(c *config.Config) string {
+		if !c.Guidelines.IntraDivisionFirst {
+			return ""
+		}
+		c.Guidelines.IntraDivisionFirst = false
+		return "intra_division_first: true → false"
+	},
+	func(c *config.Config) string {
+		if !c.Guidelines.BalanceSundayGames {
+			return ""
+		}
+		c.Guidelines.BalanceSundayGames = false
+		return "balance_sunday_games: true → false"
+	},
+	func(c *config.Config) string {
+		return scaleRematchDays(c, func(days int) int { return days / 2 })
+	},
+	func(c *config.Config) string {
+		if !c.Guidelines.OpponentVariety {
+			return ""
+		}
+		c.Guidelines.OpponentVariety = false
+		return "opponent_variety: true → false"
+	},
+	func(c *config.Config) string {
+		if c.Guidelines.MinDaysBetweenGroupGames == 0 {
+			return ""
+		}
+		before := c.Guidelines.MinDaysBetweenGroupGames
+		c.Guidelines.MinDaysBetweenGroupGames = 0
+		return fmt.Sprintf("min_days_between_group_games: %d → 0", before)
+	},
+	func(c *config.Config) string {
+		return scaleRematchDays(c, func(int) int { return 0 })
+	},
+	func(c *config.Config) string {
+		if !c.Guidelines.BalancePace {
+			return ""
+		}
+		c.Guidelines.BalancePace = false
+		return "balance_pace: true → false"
+	},
+}
+
+// ScheduleRelaxed runs Schedule, and when the season doesn't fit, retries
+// with successively relaxed guidelines until one succeeds. The result's
+// Relaxed lists every change the successful run needed; its warnings and
+// metrics are still measured against cfg as written. When no relaxation
+// helps, it returns Schedule's result and error for cfg unchanged. progress,
+// when not nil, follows every run's attempts in turn.
+func ScheduleRelaxed(cfg *config.Config, slots []Slot, overflowSlots []Slot, games []strategy.Game, progress ProgressFunc) (*Result, error) {
+	result, err := ScheduleWithProgress(cfg, slots, overflowSlots, games, progress)
+	if err == nil {
+		return result, err
+	}
+
+	relaxed := *cfg
+	relaxed.Divisions = slices.Clone(cfg.Divisions)
+	var changes []string
+	for _, relax := range relaxations {
+		change := relax(&relaxed)
+		if change == "" {
+			continue
+		}
+		changes = append(changes, change)
+		r, rerr := ScheduleWithProgress(&relaxed, slots, overflowSlots, games, progress)
+		if rerr != nil {
+			continue
+		}
+
+		open, _ := withoutHeld(cfg, slots)
+		s := newScheduler(cfg, open, overflowSlots, games)
+		for _, a := range r.Assignments {
+			s.assign(a.Game, a.Slot)
+		}
+		r.Warnings, r.TeamMetrics = s.buildMetrics()
+		r.Relaxed = changes
+		return r, nil
+	}
+	return result, err
+}
+
+// scaleRematchDays applies scale to min_days_between_same_matchup and every
+// division's override of it, describing what changed, or "" when nothing
+// did. Overrides are replaced rather than written through, so they stay
+// unchanged in the config they were copied from.
+func scaleRematchDays(c *config.Config, scale func(int) int) string {
+	var changes []string
+	if before := c.Guidelines.MinDaysBetweenSameMatchup; scale(before) != before {
+		c.Guidelines.MinDaysBetweenSameMatchup = scale(before)
+		changes = append(changes, fmt.Sprintf("min_days_between_same_matchup: %d → %d", before, scale(before)))
+	}
+	for i, div := range c.Divisions {
+		if div.MinDaysBetweenSameMatchup == nil {
+			continue
+		}
+		if before := *div.MinDaysBetweenSameMatchup; scale(before) != before {
+			after := scale(before)
+			c.Divisions[i].MinDaysBetweenSameMatchup = &after
+			changes = append(changes, fmt.Sprintf("%s min_days_between_same_matchup: %d → %d", div.Name, before, after))
+		}
+	}
+	return strings.Join(changes, "; ")
+}
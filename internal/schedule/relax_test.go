@@ -0,0 +1,71 @@
+package schedule
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+func TestRelaxations(t *testing.T) {
+	cfg := schedulerTestConfig()
+	cfg.Guidelines.IntraDivisionFirst = true
+	cfg.Guidelines.BalanceSundayGames = true
+	cfg.Guidelines.MinDaysBetweenSameMatchup = 10
+	cfg.Guidelines.OpponentVariety = false
+	cfg.Guidelines.MinDaysBetweenGroupGames = 0
+	cfg.Guidelines.BalancePace = true
+	relaxed := *cfg
+	relaxed.Divisions = slices.Clone(cfg.Divisions)
+	rec := 7
+	relaxed.Divisions[1].MinDaysBetweenSameMatchup = &rec
+
+	var changes []string
+	for _, relax := range relaxations {
+		if change := relax(&relaxed); change != "" {
+			changes = append(changes, change)
+		}
+	}
+	want := []string{
+		"intra_division_first: true → false",
+		"balance_sunday_games: true → false",
+		"min_days_between_same_matchup: 10 → 5; National min_days_between_same_matchup: 7 → 3",
+		"min_days_between_same_matchup: 5 → 0; National min_days_between_same_matchup: 3 → 0",
+		"balance_pace: true → false",
+	}
+	if !slices.Equal(changes, want) {
+		t.Errorf("changes = %q, want %q", changes, want)
+	}
+	if g := relaxed.Guidelines; g.IntraDivisionFirst || g.BalanceSundayGames || g.MinDaysBetweenSameMatchup != 0 || g.BalancePace {
+		t.Errorf("guidelines after relaxing = %+v, want all relaxed", g)
+	}
+	if !cfg.Guidelines.IntraDivisionFirst || cfg.Guidelines.MinDaysBetweenSameMatchup != 10 || rec != 7 {
+		t.Error("relaxing changed the original config")
+	}
+}
+
+func TestScheduleRelaxed(t *testing.T) {
+	cfg := schedulerTestConfig()
+	games := (&strategy.DivisionWeighted{}).GenerateMatchups(cfg.Divisions)
+
+	t.Run("a season that fits isn't relaxed", func(t *testing.T) {
+		result, err := ScheduleRelaxed(cfg, GenerateSlots(cfg), nil, games, nil)
+		if err != nil {
+			t.Fatalf("ScheduleRelaxed() error: %v", err)
+		}
+		if len(result.Relaxed) != 0 {
+			t.Errorf("Relaxed = %q, want none", result.Relaxed)
+		}
+	})
+
+	t.Run("no relaxation fixes too few slots", func(t *testing.T) {
+		slots := GenerateSlots(cfg)[:5]
+		result, err := ScheduleRelaxed(cfg, slots, nil, games, nil)
+		if err == nil {
+			t.Fatal("ScheduleRelaxed() succeeded with 5 slots, want error")
+		}
+		if len(result.Relaxed) != 0 {
+			t.Errorf("Relaxed = %q, want none on failure", result.Relaxed)
+		}
+	})
+}
@@ -0,0 +1,89 @@
+package schedule
+
+import (
+	"math"
+	"time"
+
+	"github.com/derekprior/rbrl/internal/config"
+)
+
+// RematchGap is the closest pair of meetings between two teams.
+type RematchGap struct {
+	Teams [2]string
+	Days  int
+}
+
+// rematchGaps returns the days between each pair's consecutive meetings.
+func (s *scheduler) rematchGaps() map[matchupKey][]int {
+	dates := make(map[matchupKey][]time.Time)
+	for _, a := range s.assignments {
+		mk := normalizeMatchup(a.Game.Home, a.Game.Away)
+		dates[mk] = append(dates[mk], a.Slot.Date)
+	}
+	gaps := make(map[matchupKey][]int)
+	for mk, ds := range dates {
+		sortDatesInPlace(ds)
+		for i := 1; i < len(ds); i++ {
+			gaps[mk] = append(gaps[mk], int(ds[i].Sub(ds[i-1]).Hours()/24))
+		}
+	}
+	return gaps
+}
+
+// closestRematch returns the pair that meets again soonest, breaking ties by
+// team names, or nil when no pair meets twice.
+func (s *scheduler) closestRematch() *RematchGap {
+	var closest *RematchGap
+	for mk, gaps := range s.rematchGaps() {
+		for _, days := range gaps {
+			if closest == nil || days < closest.Days ||
+				(days == closest.Days && (mk.a < closest.Teams[0] || mk.a == closest.Teams[0] && mk.b < closest.Teams[1])) {
+				closest = &RematchGap{Teams: [2]string{mk.a, mk.b}, Days: days}
+			}
+		}
+	}
+	return closest
+}
+
+// rematchSpacingPenalty ranks an attempt for optimize: rematch-spacing. It
+// is large enough to outweigh every other soft score term, so attempts are
+// compared first on their closest rematch, then on how many rematches are
+// that close, and only then on the usual guidelines.
+func (s *scheduler) rematchSpacingPenalty() float64 {
+	closest := math.MaxInt
+	atClosest := 0
+	for _, gaps := range s.rematchGaps() {
+		for _, days := range gaps {
+			switch {
+			case days < closest:
+				closest, atClosest = days, 1
+			case days == closest:
+				atClosest++
+			}
+		}
+	}
+	if closest == math.MaxInt {
+		return 0
+	}
+	return float64(s.seasonDays()-closest)*1e6 + float64(atClosest)*1e4
+}
+
+// rematchSlotPenalty pushes a rematch as late as the season allows when
+// optimizing for rematch spacing: the fewer days since the pair last met,
+// the worse the slot.
+func (s *scheduler) rematchSlotPenalty(daysBetween float64) float64 {
+	if s.cfg.Guidelines.Optimize != config.OptimizeRematchSpacing {
+		return 0
+	}
+	return (float64(s.seasonDays()) - daysBetween) * 5
+}
+
+// seasonDays returns the days from the start date through the last date a
+// game can be played, overflow included.
+func (s *scheduler) seasonDays() int {
+	end := s.cfg.Season.EndDate.Time
+	if s.cfg.Season.OverflowEndDate != nil {
+		end = s.cfg.Season.OverflowEndDate.Time
+	}
+	return int(end.Sub(s.cfg.Season.StartDate.Time).Hours()/24) + 1
+}
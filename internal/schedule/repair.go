@@ -0,0 +1,126 @@
+package schedule
+
+// RepairReport summarizes the local-search repair pass run after the best
+// attempt is chosen.
+type RepairReport struct {
+	Iterations int     // improvement rounds run, at most the configured cap
+	Swaps      int     // moves applied; each lowered the soft score
+	Before     float64 // softScore before repair
+	After      float64 // softScore after repair
+}
+
+// move relocates the games at assignment indices i and j into each other's
+// slots. When j is -1 the game at i moves to the empty slot to instead.
+type move struct {
+	i, j int
+	to   Slot
+}
+
+// repair improves a complete schedule by local search. Each round tries
+// every swap of two games' slots and every move of a game to an open slot,
+// applies the one that lowers softScore most without breaking a hard
+// constraint, and stops after maxIterations rounds or when no move helps.
+// Candidates are tried in a fixed order, so repair is deterministic.
+func (s *scheduler) repair(maxIterations int) RepairReport {
+	report := RepairReport{Before: s.softScore()}
+	current := report.Before
+
+	for report.Iterations < maxIterations {
+		report.Iterations++
+
+		best, bestScore := move{}, current-scoreEpsilon
+		found := false
+		open := s.openSlots()
+		for i := range s.assignments {
+			for j := i + 1; j < len(s.assignments); j++ {
+				if score, ok := s.tryMove(move{i: i, j: j}); ok && score < bestScore {
+					best, bestScore, found = move{i: i, j: j}, score, true
+				}
+			}
+			for _, slot := range open {
+				if score, ok := s.tryMove(move{i: i, j: -1, to: slot}); ok && score < bestScore {
+					best, bestScore, found = move{i: i, j: -1, to: slot}, score, true
+				}
+			}
+		}
+		if !found {
+			break
+		}
+
+		s.applyMove(best)
+		current = bestScore
+		report.Swaps++
+	}
+
+	report.After = current
+	return report
+}
+
+// openSlots returns the regular and overflow slots no game is using, in
+// slot order.
+func (s *scheduler) openSlots() []Slot {
+	var open []Slot
+	for _, slots := range [][]Slot{s.slots, s.overflowSlots} {
+		for _, slot := range slots {
+			if !s.usedSlots[slotKey{slot.Date, slot.Time, slot.Field}] {
+				open = append(open, slot)
+			}
+		}
+	}
+	return open
+}
+
+// tryMove applies m, scores the result, and puts everything back. ok is
+// false when m would break a hard constraint.
+func (s *scheduler) tryMove(m move) (float64, bool) {
+	saved := append([]Assignment(nil), s.assignments...)
+	moved, ok := s.applyMove(m)
+	if !ok {
+		s.restore(saved, moved)
+		return 0, false
+	}
+	score := s.softScore()
+	s.restore(saved, moved)
+	return score, true
+}
+
+// applyMove unassigns the games m touches and reassigns them to their new
+// slots, checking hard constraints as it goes. It returns how many games it
+// removed and whether every game was placed; on failure the caller must
+// restore.
+func (s *scheduler) applyMove(m move) (int, bool) {
+	a := s.assignments[m.i]
+	var targets []Assignment
+	if m.j < 0 {
+		s.unassign(m.i)
+		targets = []Assignment{{Game: a.Game, Slot: m.to}}
+	} else {
+		b := s.assignments[m.j]
+		s.unassign(m.j) // higher index first so m.i stays valid
+		s.unassign(m.i)
+		targets = []Assignment{{Game: a.Game, Slot: b.Slot}, {Game: b.Game, Slot: a.Slot}}
+	}
+
+	removed := len(targets)
+	for _, t := range targets {
+		if _, ok := s.hardConstraintCheck(t.Game, t.Slot); !ok {
+			return removed, false
+		}
+		s.assign(t.Game, t.Slot)
+	}
+	return removed, true
+}
+
+// restore undoes applyMove, returning the schedule to saved. removed is the
+// number of games applyMove took out.
+func (s *scheduler) restore(saved []Assignment, removed int) {
+	for len(s.assignments) > len(saved)-removed {
+		s.unassign(len(s.assignments) - 1)
+	}
+	for _, a := range saved {
+		if !s.usedSlots[slotKey{a.Slot.Date, a.Slot.Time, a.Slot.Field}] {
+			s.assign(a.Game, a.Slot)
+		}
+	}
+	s.assignments = saved
+}
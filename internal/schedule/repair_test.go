@@ -0,0 +1,73 @@
+package schedule
+
+import (
+	"testing"
+
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+func TestRepair(t *testing.T) {
+	cfg := schedulerTestConfig()
+	slots := GenerateSlots(cfg)
+	games := (&strategy.DivisionWeighted{}).GenerateMatchups(cfg.Divisions)
+
+	t.Run("off by default", func(t *testing.T) {
+		result, err := Schedule(cfg, slots, nil, games)
+		if err != nil {
+			t.Fatalf("Schedule() error: %v", err)
+		}
+		if result.Repair != nil {
+			t.Errorf("Repair = %+v, want nil", result.Repair)
+		}
+	})
+
+	t.Run("never makes the schedule worse", func(t *testing.T) {
+		cfg := schedulerTestConfig()
+		cfg.Guidelines.RepairIterations = 3
+		result, err := Schedule(cfg, slots, nil, games)
+		if err != nil {
+			t.Fatalf("Schedule() error: %v", err)
+		}
+		r := result.Repair
+		if r == nil {
+			t.Fatal("Repair = nil, want a report")
+		}
+		if r.Iterations > 3 || r.Swaps > r.Iterations {
+			t.Errorf("Iterations = %d, Swaps = %d; want at most 3 rounds and one swap per round", r.Iterations, r.Swaps)
+		}
+		if r.After > r.Before {
+			t.Errorf("soft score rose from %.1f to %.1f", r.Before, r.After)
+		}
+		if len(result.Assignments) != len(games) {
+			t.Errorf("Assignments = %d, want %d", len(result.Assignments), len(games))
+		}
+		if violations, s := replay(cfg, slots, result.Assignments); violations != 0 {
+			t.Errorf("repaired schedule has %d hard constraint violations", violations)
+		} else if got := s.softScore(); got < r.After-scoreEpsilon || got > r.After+scoreEpsilon {
+			t.Errorf("replayed soft score = %.1f, report says %.1f", got, r.After)
+		}
+	})
+
+	t.Run("applies the best move each round", func(t *testing.T) {
+		cfg := schedulerTestConfig()
+		s := newScheduler(cfg, slots, nil, games)
+		// Two Angels–Astros games three days apart, well inside the
+		// 14-day rematch window, with plenty of open slots to spread them
+		s.assign(strategy.Game{Home: "Angels", Away: "Astros"}, Slot{Date: mustDate("2026-04-27"), Time: "17:45", Field: "Symonds Field"})
+		s.assign(strategy.Game{Home: "Astros", Away: "Angels"}, Slot{Date: mustDate("2026-04-30"), Time: "17:45", Field: "Symonds Field"})
+
+		r := s.repair(1)
+		if r.Iterations != 1 || r.Swaps != 1 {
+			t.Fatalf("report = %+v, want one round with one swap", r)
+		}
+		if r.After >= r.Before {
+			t.Errorf("soft score went from %.1f to %.1f, want lower", r.Before, r.After)
+		}
+		if got := s.softScore(); got != r.After {
+			t.Errorf("softScore() = %.1f, report says %.1f", got, r.After)
+		}
+		if len(s.assignments) != 2 {
+			t.Errorf("assignments = %d, want 2", len(s.assignments))
+		}
+	})
+}
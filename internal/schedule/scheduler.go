@@ -1,9 +1,12 @@
 package schedule
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"math/rand"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/derekprior/rbrl/internal/config"
@@ -18,40 +21,146 @@ type Assignment struct {
 
 // TeamMetrics holds per-team schedule statistics.
 type TeamMetrics struct {
-	Games      int
-	Saturday   int
-	Sunday     int
-	Violations []string
+	Games          int
+	Home           int
+	Away           int
+	Saturday       int
+	Sunday         int
+	OffDatesPlayed []time.Time    // requested-off dates the team still plays on
+	ByeWeeks       []time.Time    // Monday of each season week without a game
+	Times          map[string]int // games per start time
+	Weekdays       map[string]int // games per day of the week, e.g. "Friday"
+	// OpponentVariety is the share of distinct opponents among the team's
+	// first k games, where k is its number of opponents; 1 means it met
+	// every opponent once before any rematch.
+	OpponentVariety float64
+	// ToughestStretch is the team's longest run of opponents rated above
+	// the league average, in order; empty without team ratings.
+	ToughestStretch []string
+	// LongestHomestand and LongestRoadTrip are the most home games, and
+	// the most away games, the team plays in a row.
+	LongestHomestand int
+	LongestRoadTrip  int
+	// OpeningAwayStreak counts the away games that open the team's season.
+	OpeningAwayStreak int
+	// Fields counts the distinct fields the team plays at.
+	Fields int
+	// StartTimeSpread is how many more games the team plays at its most
+	// frequent start time than its least, on days with a choice of times.
+	StartTimeSpread int
+	// FullWeekends counts the weekends the team plays both Saturday and
+	// Sunday.
+	FullWeekends int
+	// SaturdayCapReached is true when the team plays its
+	// max_saturday_games. It's information, not a warning: the cap doing
+	// its job.
+	SaturdayCapReached bool
+	// RegularSeason counts only the games before the overflow window; nil
+	// when no game is in overflow.
+	RegularSeason *Balance
+	Violations    []string
+}
+
+// Balance is a team's split of games by home and away and weekend day.
+type Balance struct {
+	Games    int
+	Home     int
+	Away     int
+	Saturday int
+	Sunday   int
+}
+
+// PhaseReport summarizes one scheduling pass when divisions are scheduled
+// separately (intra_division_first).
+type PhaseReport struct {
+	Name      string // division name, or "Inter-division" for the final pass
+	Games     int    // games attempted in the pass
+	Scheduled int    // games placed in the pass
+}
+
+// OverflowDay counts the games played on one date past the regular
+// season's end.
+type OverflowDay struct {
+	Date  time.Time
+	Games int
+}
+
+// Diagnostics describes the search behind a Result, so a successful
+// schedule still shows how close to the edge it was.
+type Diagnostics struct {
+	Attempts  int     // randomized attempts tried
+	Succeeded int     // attempts that placed every game
+	SoftScore float64 // soft score of the attempt used; lower is better
+	// Rejections counts, by reason, the slots the attempt used turned down
+	// for a game because of a hard constraint or because they were taken.
+	Rejections map[string]int
 }
 
 // Result is the output of the scheduling process.
 type Result struct {
-	Assignments []Assignment
-	Warnings    []string
-	TeamGames   map[string]int // games scheduled per team
-	TeamMetrics map[string]*TeamMetrics
+	Assignments  []Assignment
+	Warnings     []Warning
+	TeamGames    map[string]int // games scheduled per team
+	TeamMetrics  map[string]*TeamMetrics
+	LastGameDate time.Time              // date of the latest scheduled game, overflow included
+	Phases       []PhaseReport          // passes run with intra_division_first, in order
+	HeldOpen     []Slot                 // slots kept open by reserve_open_slots
+	Repair       *RepairReport          // nil unless repair_iterations is set
+	FamilyLinks  []FamilyLinkReport     // one per family_links entry, in config order
+	Preferred    []PreferredFieldReport // one per division with preferred_fields, in config order
+	Rematch      *RematchGap            // closest rematch; nil when no pair meets twice
+	SeasonEnd    *SeasonEnd             // first and last teams to finish; nil when no game was scheduled
+	Overflow     []OverflowDay          // games per overflow date used, in date order
+	Relaxed      []string               // guideline changes ScheduleRelaxed needed, in order
+	Diagnostics  Diagnostics
 }
 
+// Progress reports how far a scheduling run has got, after each attempt.
+type Progress struct {
+	Attempt   int     // attempts finished so far
+	Attempts  int     // attempts the run will make
+	Games     int     // games to place
+	Scheduled int     // most games any attempt so far has placed
+	SoftScore float64 // best soft score among attempts placing every game; NaN before the first
+}
+
+// ProgressFunc receives a Progress after each scheduling attempt.
+type ProgressFunc func(Progress)
+
 // Schedule assigns games to slots respecting constraints.
 // On failure, returns a partial Result with the best attempt alongside the error.
 func Schedule(cfg *config.Config, slots []Slot, overflowSlots []Slot, games []strategy.Game) (*Result, error) {
+	return ScheduleWithProgress(cfg, slots, overflowSlots, games, nil)
+}
+
+// ScheduleWithProgress is Schedule, calling progress after each attempt
+// when it isn't nil.
+func ScheduleWithProgress(cfg *config.Config, slots []Slot, overflowSlots []Slot, games []strategy.Game, progress ProgressFunc) (*Result, error) {
+	slots, held := withoutHeld(cfg, slots)
 	s := newScheduler(cfg, slots, overflowSlots, games)
-	if err := s.run(); err != nil {
-		warnings, metrics := s.buildMetrics()
-		return &Result{
-			Assignments: s.assignments,
-			Warnings:    warnings,
-			TeamGames:   s.teamGames,
-			TeamMetrics: metrics,
-		}, err
-	}
+	s.progress = progress
+	err := s.run()
 	warnings, metrics := s.buildMetrics()
-	return &Result{
-		Assignments: s.assignments,
-		Warnings:    warnings,
-		TeamGames:   s.teamGames,
-		TeamMetrics: metrics,
-	}, nil
+	result := &Result{
+		Assignments:  s.assignments,
+		Warnings:     warnings,
+		TeamGames:    s.teamGames,
+		TeamMetrics:  metrics,
+		LastGameDate: s.lastGameDate(),
+		Phases:       s.phases,
+		HeldOpen:     held,
+		Repair:       s.repairReport,
+		FamilyLinks:  s.familyReports(),
+		Preferred:    s.preferredFieldReports(),
+		Rematch:      s.closestRematch(),
+		SeasonEnd:    s.seasonEnd(),
+		Overflow:     s.overflowDays(),
+		Diagnostics:  s.diagnostics,
+	}
+	if verr := result.Validate(cfg); verr != nil {
+		err = errors.Join(err, fmt.Errorf("schedule failed its sanity check, a scheduler bug: %w", verr))
+	}
+	return result, err
 }
 
 // rejectionReason categorizes why a slot was rejected for a game.
@@ -64,25 +173,113 @@ const (
 	rejectConsecutiveDays
 	rejectMaxWeekGames
 	reject3In4Days
+	rejectDependency
+	rejectHomeField
+	rejectFieldBuffer
+	rejectExcludedField
+	rejectMaxWeekHomeGames
+	rejectMaxSaturdayGames
+	rejectDateCap
+	rejectWeekendOnly
+	rejectMatchupBlackout
+	rejectSharedLocation
+	rejectDistinctFields
+	rejectOpeningAwayStreak
 )
 
+func (r rejectionReason) String() string {
+	switch r {
+	case rejectSlotUsed:
+		return "slot already taken"
+	case rejectTimeslotCap:
+		return "timeslot at max_games_per_timeslot"
+	case rejectDoublePlay:
+		return "a team already plays that day or time"
+	case rejectConsecutiveDays:
+		return "too many consecutive days"
+	case rejectMaxWeekGames:
+		return "a team is at max_games_per_week"
+	case reject3In4Days:
+		return "3 games in 4 days"
+	case rejectDependency:
+		return "too close to a bracket game it depends on"
+	case rejectHomeField:
+		return "not the home team's home_field"
+	case rejectFieldBuffer:
+		return "too close to another game on the field"
+	case rejectExcludedField:
+		return "a team can't use the field (excluded_fields)"
+	case rejectMaxWeekHomeGames:
+		return "the home team is at max_home_games_per_week"
+	case rejectMaxSaturdayGames:
+		return "a team is at max_saturday_games"
+	case rejectDateCap:
+		return "date at max_games_per_date"
+	case rejectWeekendOnly:
+		return "weekend_only game on a weekday"
+	case rejectMatchupBlackout:
+		return "the teams can't meet that day (matchup_blackouts)"
+	case rejectSharedLocation:
+		return "another field at the location is in use (same_physical_location)"
+	case rejectDistinctFields:
+		return "a team would play at more than max_distinct_fields fields"
+	case rejectOpeningAwayStreak:
+		return "a team would open its season with more than max_opening_away_streak away games"
+	}
+	return "unknown"
+}
+
 type scheduler struct {
 	cfg           *config.Config
 	slots         []Slot
 	overflowSlots []Slot
 	games         []strategy.Game
+	regularEnd    time.Time // last regular-season date; zero without an overflow window
 
 	assignments []Assignment
 	usedSlots   map[slotKey]bool
-	teamDates   map[string][]time.Time   // team -> sorted game dates
-	teamGames   map[string]int           // team -> total games scheduled
-	slotTimeCnt map[timeKey]int          // (date, time) -> games in that timeslot
-	matchupDate map[matchupKey]time.Time // normalized pair -> last date played
+	teamDates   map[string][]time.Time        // team -> sorted game dates
+	teamGames   map[string]int                // team -> total games scheduled
+	slotTimeCnt map[timeKey]int               // (date, time) -> games in that timeslot
+	dateCnt     map[time.Time]int             // date -> games that day, all fields
+	timeDivCnt  map[timeDivKey]int            // (date, time, division) -> games involving the division
+	divisionOf  map[string]string             // team -> division
+	matchupDate map[matchupKey]time.Time      // normalized pair -> last date played
+	teamTimes   map[teamTimeKey]bool          // (team, date, time) -> team plays then
+	weekFields  map[weekFieldKey]int          // (team, week, field) -> games there that week
+	teamFields  map[string]map[string]int     // team -> field -> games there
+	teamStarts  map[string]map[string]float64 // team -> start time -> games past its fair share
+	maxFields   map[string]int                // team -> max_distinct_fields cap
+	offDates    map[string]map[time.Time]bool // team -> requested-off dates
+	groupDates  map[groupKey][]time.Time      // (team, opponent group) -> sorted dates played
+	groupsOf    map[string][]string           // team -> opponent groups it belongs to
+	opponents   map[string]int                // team -> distinct opponents in its games
+	fieldRank   map[string]int                // field -> position in field_priority
+	preferred   map[string]map[string]bool    // division -> its preferred_fields
+	sharedWith  map[string][]string           // field -> other fields at the same physical location
+	rematchDays map[matchupKey]int            // normalized pair -> min days between its meetings
+	homeField   map[string]string             // team -> field its home games are pinned to
+	excluded    map[string]map[string]bool    // team -> fields it never plays on
+	blackedOut  map[pairDateKey]bool          // (normalized pair, date) -> matchup_blackouts entry; "any" pairs a team with everyone
+	maxSat      map[string]int                // team -> max_saturday_games cap
+	labelDate   map[string]time.Time          // game label -> date assigned
+	dependents  map[string][]string           // game label -> labels of games that depend on it
+	weeks       []time.Time                   // Monday of each week with slots, in order
+	strong      map[string]bool               // team -> rated above the league average
+	linked      map[string][]string           // team -> teams sharing a family link
+	familySlots map[familyKey][]Slot          // (linked team, date) -> slots it plays
+	prior       *priorSeason                  // prior season's overages; nil without --prior-season
+
+	phases       []PhaseReport // per-pass results when scheduling by division
+	repairReport *RepairReport // set when the repair pass ran
+	diagnostics  Diagnostics   // set by run for the attempt it keeps
+	progress     ProgressFunc  // called by run after each attempt; may be nil
 
 	// diagnostics for failure reporting
-	rejections  map[rejectionReason]int
-	unscheduled []strategy.Game
-	stuckOnGame *strategy.Game
+	rejections     map[rejectionReason]int
+	dateRejections map[time.Time]int // date -> hard-constraint rejections
+	unscheduled    []strategy.Game
+	stuckOnGame    *strategy.Game
 }
 
 type slotKey struct {
@@ -96,10 +293,37 @@ type timeKey struct {
 	time string
 }
 
+type timeDivKey struct {
+	date     time.Time
+	time     string
+	division string
+}
+
 type matchupKey struct {
 	a, b string
 }
 
+type pairDateKey struct {
+	pair matchupKey
+	date time.Time
+}
+
+type teamTimeKey struct {
+	team string
+	date time.Time
+	time string
+}
+
+type weekFieldKey struct {
+	team  string
+	week  time.Time // Monday
+	field string
+}
+
+type groupKey struct {
+	team, group string
+}
+
 func normalizeMatchup(a, b string) matchupKey {
 	if a > b {
 		a, b = b, a
@@ -108,37 +332,208 @@ func normalizeMatchup(a, b string) matchupKey {
 }
 
 func newScheduler(cfg *config.Config, slots []Slot, overflowSlots []Slot, games []strategy.Game) *scheduler {
-	return &scheduler{
+	offDates := make(map[string]map[time.Time]bool)
+	homeField := make(map[string]string)
+	excluded := make(map[string]map[string]bool)
+	maxSat := make(map[string]int)
+	maxFields := make(map[string]int)
+	for _, t := range cfg.Teams {
+		if t.HomeField != "" {
+			homeField[t.Name] = t.HomeField
+		}
+		if t.MaxSaturdayGames > 0 {
+			maxSat[t.Name] = t.MaxSaturdayGames
+		}
+		if t.MaxDistinctFields > 0 {
+			maxFields[t.Name] = t.MaxDistinctFields
+		}
+		for _, name := range t.ExcludedFields {
+			if excluded[t.Name] == nil {
+				excluded[t.Name] = make(map[string]bool)
+			}
+			excluded[t.Name][name] = true
+		}
+		for _, d := range t.PreferredOffDates {
+			if offDates[t.Name] == nil {
+				offDates[t.Name] = make(map[time.Time]bool)
+			}
+			offDates[t.Name][d.Time] = true
+		}
+	}
+
+	blackedOut := make(map[pairDateKey]bool)
+	for _, m := range cfg.Rules.MatchupBlackouts {
+		blackedOut[pairDateKey{normalizeMatchup(m.Home, m.Away), m.Date.Time}] = true
+	}
+
+	divisionOf := make(map[string]string)
+	for _, div := range cfg.Divisions {
+		for _, team := range div.Teams {
+			divisionOf[team] = div.Name
+		}
+	}
+
+	groupsOf := make(map[string][]string)
+	for _, team := range cfg.AllTeams() {
+		if groups := cfg.Guidelines.GroupsOf(team); len(groups) > 0 {
+			groupsOf[team] = groups
+		}
+	}
+
+	fieldRank := make(map[string]int)
+	for i, name := range cfg.Guidelines.FieldPriority {
+		fieldRank[name] = i
+	}
+
+	preferred := make(map[string]map[string]bool)
+	for _, div := range cfg.Divisions {
+		for _, name := range div.PreferredFields {
+			if preferred[div.Name] == nil {
+				preferred[div.Name] = make(map[string]bool)
+			}
+			preferred[div.Name][name] = true
+		}
+	}
+
+	rematchDays := make(map[matchupKey]int)
+	teams := cfg.AllTeams()
+	for i, a := range teams {
+		for _, b := range teams[i+1:] {
+			rematchDays[normalizeMatchup(a, b)] = cfg.RematchDays(a, b)
+		}
+	}
+
+	sharedWith := make(map[string][]string)
+	for _, f := range cfg.Fields {
+		if others := cfg.Rules.SharedLocation(f.Name); len(others) > 0 {
+			sharedWith[f.Name] = others
+		}
+	}
+
+	seenWeek := make(map[time.Time]bool)
+	var weeks []time.Time
+	for _, slot := range slots {
+		if w := WeekStart(slot.Date); !seenWeek[w] {
+			seenWeek[w] = true
+			weeks = append(weeks, w)
+		}
+	}
+	sortDatesInPlace(weeks)
+
+	opponentSets := make(map[string]map[string]bool)
+	for _, g := range games {
+		for _, side := range [][2]string{{g.Home, g.Away}, {g.Away, g.Home}} {
+			if opponentSets[side[0]] == nil {
+				opponentSets[side[0]] = make(map[string]bool)
+			}
+			opponentSets[side[0]][side[1]] = true
+		}
+	}
+	opponents := make(map[string]int)
+	for team, set := range opponentSets {
+		opponents[team] = len(set)
+	}
+
+	linked := make(map[string][]string)
+	for _, team := range cfg.AllTeams() {
+		if teams := cfg.Guidelines.LinkedTeams(team); len(teams) > 0 {
+			linked[team] = teams
+		}
+	}
+
+	dependents := make(map[string][]string)
+	for _, g := range games {
+		for _, dep := range g.DependsOn {
+			dependents[dep] = append(dependents[dep], g.Label)
+		}
+	}
+
+	var regularEnd time.Time
+	if cfg.Season.OverflowEndDate != nil {
+		regularEnd = cfg.Season.EndDate.Time
+	}
+
+	s := &scheduler{
 		cfg:           cfg,
 		slots:         slots,
 		overflowSlots: overflowSlots,
 		games:         games,
-		usedSlots:     make(map[slotKey]bool),
-		teamDates:     make(map[string][]time.Time),
-		teamGames:     make(map[string]int),
-		slotTimeCnt:   make(map[timeKey]int),
-		matchupDate:   make(map[matchupKey]time.Time),
-		rejections:    make(map[rejectionReason]int),
-	}
+		regularEnd:    regularEnd,
+		divisionOf:    divisionOf,
+		maxFields:     maxFields,
+		prior:         newPriorSeason(cfg.PriorSeason),
+		offDates:      offDates,
+		groupsOf:      groupsOf,
+		opponents:     opponents,
+		fieldRank:     fieldRank,
+		preferred:     preferred,
+		sharedWith:    sharedWith,
+		rematchDays:   rematchDays,
+		homeField:     homeField,
+		excluded:      excluded,
+		blackedOut:    blackedOut,
+		maxSat:        maxSat,
+		dependents:    dependents,
+		weeks:         weeks,
+		strong:        strongTeams(cfg),
+		linked:        linked,
+	}
+	s.clearAssignments()
+	return s
 }
 
-func (s *scheduler) run() error {
-	rng := rand.New(rand.NewSource(42))
+// clearAssignments empties the schedule state that assign builds up,
+// keeping the tables derived from the config.
+func (s *scheduler) clearAssignments() {
+	s.assignments = nil
+	s.usedSlots = make(map[slotKey]bool)
+	s.teamDates = make(map[string][]time.Time)
+	s.teamGames = make(map[string]int)
+	s.slotTimeCnt = make(map[timeKey]int)
+	s.dateCnt = make(map[time.Time]int)
+	s.timeDivCnt = make(map[timeDivKey]int)
+	s.matchupDate = make(map[matchupKey]time.Time)
+	s.teamTimes = make(map[teamTimeKey]bool)
+	s.weekFields = make(map[weekFieldKey]int)
+	s.teamFields = make(map[string]map[string]int)
+	s.teamStarts = make(map[string]map[string]float64)
+	s.groupDates = make(map[groupKey][]time.Time)
+	s.labelDate = make(map[string]time.Time)
+	s.familySlots = make(map[familyKey][]Slot)
+	s.rejections = make(map[rejectionReason]int)
+	s.dateRejections = make(map[time.Time]int)
+	s.unscheduled = nil
+	s.stuckOnGame = nil
+}
+
+// scheduleAttempts is how many shuffled game orders run tries.
+const scheduleAttempts = 50
+
+// attempt runs one scheduling pass from scratch with the games shuffled by
+// seed, returning the attempt's state and whether it placed every game.
+func (s *scheduler) attempt(seed int64) (*scheduler, bool) {
+	candidate := newScheduler(s.cfg, s.slots, s.overflowSlots, s.games)
+	shuffled := make([]strategy.Game, len(s.games))
+	copy(shuffled, s.games)
+	rng := rand.New(rand.NewSource(seed))
+	rng.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	return candidate, candidate.trySchedule(shuffled, rng)
+}
 
+func (s *scheduler) run() error {
 	bestResult := (*scheduler)(nil)
 	bestScore := math.MaxFloat64
 	var bestFailure *scheduler
-
-	for attempt := range 50 {
-		candidate := newScheduler(s.cfg, s.slots, s.overflowSlots, s.games)
-		shuffled := make([]strategy.Game, len(s.games))
-		copy(shuffled, s.games)
-		rng = rand.New(rand.NewSource(int64(42 + attempt)))
-		rng.Shuffle(len(shuffled), func(i, j int) {
-			shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
-		})
-
-		if candidate.trySchedule(shuffled, rng) {
+	s.diagnostics = Diagnostics{Attempts: scheduleAttempts}
+	mostScheduled := 0
+
+	for attempt := range scheduleAttempts {
+		candidate, ok := s.attempt(int64(42 + attempt))
+		mostScheduled = max(mostScheduled, len(candidate.assignments))
+		if ok {
+			s.diagnostics.Succeeded++
 			score := candidate.softScore()
 			if score < bestScore {
 				bestScore = score
@@ -154,6 +549,13 @@ func (s *scheduler) run() error {
 				bestFailure = candidate
 			}
 		}
+		if s.progress != nil {
+			p := Progress{Attempt: attempt + 1, Attempts: scheduleAttempts, Games: len(s.games), Scheduled: mostScheduled, SoftScore: math.NaN()}
+			if bestResult != nil {
+				p.SoftScore = bestScore
+			}
+			s.progress(p)
+		}
 	}
 
 	if bestResult == nil {
@@ -164,20 +566,50 @@ func (s *scheduler) run() error {
 			s.teamDates = bestFailure.teamDates
 			s.teamGames = bestFailure.teamGames
 			s.slotTimeCnt = bestFailure.slotTimeCnt
+			s.dateCnt = bestFailure.dateCnt
+			s.timeDivCnt = bestFailure.timeDivCnt
 			s.matchupDate = bestFailure.matchupDate
+			s.familySlots = bestFailure.familySlots
+			s.teamFields = bestFailure.teamFields
+			s.teamStarts = bestFailure.teamStarts
+			s.phases = bestFailure.phases
+			s.diagnostics.SoftScore = bestFailure.softScore()
+			s.diagnostics.Rejections = bestFailure.rejectionCounts()
 		}
 		return s.buildFailureError(bestFailure)
 	}
 
+	if n := s.cfg.Guidelines.RepairIterations; n > 0 {
+		report := bestResult.repair(n)
+		s.repairReport = &report
+	}
+
 	s.assignments = bestResult.assignments
 	s.usedSlots = bestResult.usedSlots
 	s.teamDates = bestResult.teamDates
 	s.teamGames = bestResult.teamGames
 	s.slotTimeCnt = bestResult.slotTimeCnt
+	s.dateCnt = bestResult.dateCnt
+	s.timeDivCnt = bestResult.timeDivCnt
 	s.matchupDate = bestResult.matchupDate
+	s.familySlots = bestResult.familySlots
+	s.teamFields = bestResult.teamFields
+	s.teamStarts = bestResult.teamStarts
+	s.phases = bestResult.phases
+	s.diagnostics.SoftScore = bestResult.softScore()
+	s.diagnostics.Rejections = bestResult.rejectionCounts()
 	return nil
 }
 
+// rejectionCounts returns the attempt's slot rejections keyed by reason.
+func (s *scheduler) rejectionCounts() map[string]int {
+	counts := make(map[string]int, len(s.rejections))
+	for reason, n := range s.rejections {
+		counts[reason.String()] += n
+	}
+	return counts
+}
+
 func (s *scheduler) buildFailureError(best *scheduler) error {
 	msg := fmt.Sprintf("could not schedule all %d games into %d available slots", len(s.games), len(s.slots))
 
@@ -193,37 +625,124 @@ func (s *scheduler) buildFailureError(best *scheduler) error {
 			best.stuckOnGame.Home, best.stuckOnGame.Away)
 	}
 
+	if t, ok := best.mostConstrainedTeam(); ok {
+		msg += fmt.Sprintf("\nMost constrained team: %s have only %s left for %s",
+			t.team, plural(t.open, "legal slot"), plural(t.games, "remaining game"))
+	}
+
 	msg += "\n\nUnscheduled games:"
 	for _, g := range best.unscheduled {
 		msg += fmt.Sprintf("\n  • %s vs %s", g.Home, g.Away)
+		if g.WeekendOnly {
+			msg += " (weekend_only: no weekend slot fit)"
+		}
+	}
+
+	pinned := make(map[string]int)
+	for _, g := range best.unscheduled {
+		if _, ok := s.homeField[g.Home]; ok {
+			pinned[g.Home]++
+		}
 	}
+	if len(pinned) > 0 {
+		msg += "\n\nUnscheduled home games pinned by home_field:"
+		for _, team := range s.cfg.AllTeams() {
+			if n := pinned[team]; n > 0 {
+				msg += fmt.Sprintf("\n  • %s: %d home game(s) could not fit on %s", team, n, s.homeField[team])
+			}
+		}
+	}
+
+	if loss := SharedLocationLoss(s.cfg, s.slots) + SharedLocationLoss(s.cfg, s.overflowSlots); loss > 0 {
+		msg += fmt.Sprintf("\n\nFields sharing a location (same_physical_location) leave %s unusable; "+
+			"the best attempt turned down %s because the location was in use",
+			plural(loss, "slot"), plural(best.rejections[rejectSharedLocation], "slot"))
+	}
+
+	msg += best.criticalPath().String()
 
 	return fmt.Errorf("%s", msg)
 }
 
 func (s *scheduler) trySchedule(games []strategy.Game, rng *rand.Rand) bool {
+	var remaining []strategy.Game
+	if s.cfg.Guidelines.IntraDivisionFirst {
+		remaining = s.scheduleByDivision(games, rng)
+	} else {
+		remaining = s.schedulePhases(games, s.cfg.AllTeams(), rng)
+	}
+
+	// Phase 4: Place remaining games in overflow slots as last resort
+	if len(remaining) > 0 && len(s.overflowSlots) > 0 {
+		remaining = s.scheduleOverflow(remaining)
+	}
+
+	s.unscheduled = remaining
+	return len(s.unscheduled) == 0
+}
+
+// schedulePhases runs the Saturday, Sunday, and weekday phases over games,
+// matching Saturdays among the given teams, and returns the games that
+// didn't fit.
+func (s *scheduler) schedulePhases(games []strategy.Game, teams []string, rng *rand.Rand) []strategy.Game {
 	remaining := make([]strategy.Game, len(games))
 	copy(remaining, games)
 
 	// Phase 1: Schedule Saturdays — all teams play every Saturday
-	remaining = s.scheduleSaturdays(remaining, rng)
+	remaining = s.scheduleSaturdays(remaining, teams, rng)
 
 	// Phase 2: Schedule Sundays — balanced across teams
 	remaining = s.scheduleSundays(remaining, rng)
 
 	// Phase 3: Fill remaining games into weekday slots
-	// Sort by difficulty: games with fewer available slots go first
+	// Sort by difficulty: games with fewer available slots go first,
+	// but bracket rounds stay in order so feeder games are placed first
 	s.sortByDifficulty(remaining)
+	if len(s.dependents) > 0 {
+		sort.SliceStable(remaining, func(i, j int) bool {
+			return remaining[i].Round < remaining[j].Round
+		})
+	}
 
-	remaining = s.scheduleWithBacktracking(remaining)
+	return s.scheduleWithBacktracking(remaining)
+}
 
-	// Phase 4: Place remaining games in overflow slots as last resort
-	if len(remaining) > 0 && len(s.overflowSlots) > 0 {
-		remaining = s.scheduleOverflow(remaining)
+// scheduleByDivision schedules each division's intra-division games as its
+// own problem, then layers the inter-division games (and any intra-division
+// leftovers) on top. Each pass is recorded in s.phases.
+func (s *scheduler) scheduleByDivision(games []strategy.Game, rng *rand.Rand) []strategy.Game {
+	intra := make(map[string][]strategy.Game)
+	var inter []strategy.Game
+	for _, g := range games {
+		if d := s.divisionOf[g.Home]; d == s.divisionOf[g.Away] {
+			intra[d] = append(intra[d], g)
+		} else {
+			inter = append(inter, g)
+		}
 	}
 
-	s.unscheduled = remaining
-	return len(s.unscheduled) == 0
+	var leftover []strategy.Game
+	for _, div := range s.cfg.Divisions {
+		remaining := s.schedulePhases(intra[div.Name], div.Teams, rng)
+		s.phases = append(s.phases, PhaseReport{
+			Name:      div.Name,
+			Games:     len(intra[div.Name]),
+			Scheduled: len(intra[div.Name]) - len(remaining),
+		})
+		leftover = append(leftover, remaining...)
+	}
+
+	layered := append(inter, leftover...)
+	if len(layered) == 0 {
+		return nil
+	}
+	remaining := s.schedulePhases(layered, s.cfg.AllTeams(), rng)
+	s.phases = append(s.phases, PhaseReport{
+		Name:      "Inter-division",
+		Games:     len(layered),
+		Scheduled: len(layered) - len(remaining),
+	})
+	return remaining
 }
 
 // scheduleWithBacktracking tries to place all games, displacing existing
@@ -250,13 +769,16 @@ func (s *scheduler) scheduleWithBacktracking(games []strategy.Game) []strategy.G
 	return unscheduled
 }
 
-// scheduleOverflow places remaining games into overflow slots, preferring
-// the earliest dates to minimize how late the season extends.
+// scheduleOverflow places remaining games into overflow slots. By default
+// it prefers the earliest dates to minimize how late the season extends;
+// with overflow_strategy: spread it prefers the dates with the fewest
+// overflow games so far, earliest first among ties.
 func (s *scheduler) scheduleOverflow(games []strategy.Game) []strategy.Game {
+	spread := s.cfg.Season.OverflowStrategy == config.OverflowSpread
 	var unscheduled []strategy.Game
 	for _, game := range games {
-		placed := false
-		for _, slot := range s.overflowSlots {
+		var best *Slot
+		for i, slot := range s.overflowSlots {
 			sk := slotKey{slot.Date, slot.Time, slot.Field}
 			if s.usedSlots[sk] {
 				continue
@@ -264,13 +786,18 @@ func (s *scheduler) scheduleOverflow(games []strategy.Game) []strategy.Game {
 			if _, ok := s.hardConstraintCheck(game, slot); !ok {
 				continue
 			}
-			s.assign(game, slot)
-			placed = true
-			break
+			if best == nil || (spread && s.dateCnt[slot.Date] < s.dateCnt[best.Date]) {
+				best = &s.overflowSlots[i]
+			}
+			if !spread {
+				break
+			}
 		}
-		if !placed {
+		if best == nil {
 			unscheduled = append(unscheduled, game)
+			continue
 		}
+		s.assign(game, *best)
 	}
 	return unscheduled
 }
@@ -364,9 +891,9 @@ func (s *scheduler) countAvailableSlots(game strategy.Game) int {
 	return count
 }
 
-// scheduleSaturdays assigns games to Saturday slots so every team plays each Saturday.
-func (s *scheduler) scheduleSaturdays(games []strategy.Game, rng *rand.Rand) []strategy.Game {
-	teams := s.cfg.AllTeams()
+// scheduleSaturdays assigns games to Saturday slots so every team plays each
+// Saturday, except teams that have reached their max_saturday_games.
+func (s *scheduler) scheduleSaturdays(games []strategy.Game, teams []string, rng *rand.Rand) []strategy.Game {
 	saturdays := s.slotDates(time.Saturday)
 
 	scheduled := make(map[int]bool) // index into games
@@ -377,8 +904,42 @@ func (s *scheduler) scheduleSaturdays(games []strategy.Game, rng *rand.Rand) []s
 			continue
 		}
 
-		// Find a perfect matching: 5 games covering all teams
-		match := s.findPerfectMatch(games, scheduled, teams, rng)
+		// Teams that already play this Saturday (from an earlier pass) are
+		// left out of the matching.
+		var open []string
+		busy := make(map[string]bool)
+		for _, team := range teams {
+			if s.gamesOn(team, sat) > 0 || s.atSaturdayCap(team) {
+				busy[team] = true
+			} else {
+				open = append(open, team)
+			}
+		}
+		if len(open) < 2 {
+			continue
+		}
+
+		// Find a perfect matching: 5 games covering all teams. Teams that
+		// asked for this date off sit out if the rest can still be matched.
+		var match []int
+		if resting := s.teamsOffOn(sat); len(resting) > 0 {
+			skip := make(map[string]bool)
+			for team := range busy {
+				skip[team] = true
+			}
+			var playing []string
+			for _, team := range open {
+				if resting[team] {
+					skip[team] = true
+				} else {
+					playing = append(playing, team)
+				}
+			}
+			match = s.findPerfectMatch(games, scheduled, playing, skip, rng)
+		}
+		if match == nil {
+			match = s.findPerfectMatch(games, scheduled, open, busy, rng)
+		}
 		if match == nil {
 			continue
 		}
@@ -398,7 +959,7 @@ func (s *scheduler) scheduleSaturdays(games []strategy.Game, rng *rand.Rand) []s
 					continue
 				}
 				score := s.scoreSlot(game, slot)
-				if score < bestScore {
+				if s.beats(score, si, bestScore, bestSlot) {
 					bestScore = score
 					bestSlot = si
 				}
@@ -420,9 +981,9 @@ func (s *scheduler) scheduleSaturdays(games []strategy.Game, rng *rand.Rand) []s
 	return remaining
 }
 
-// findPerfectMatch finds len(teams)/2 games from the pool that cover all teams.
-// Uses recursive backtracking to find a valid matching.
-func (s *scheduler) findPerfectMatch(games []strategy.Game, used map[int]bool, teams []string, rng *rand.Rand) []int {
+// findPerfectMatch finds len(teams)/2 games from the pool that cover all teams,
+// never using a team in skip. Uses recursive backtracking to find a valid matching.
+func (s *scheduler) findPerfectMatch(games []strategy.Game, used map[int]bool, teams []string, skip map[string]bool, rng *rand.Rand) []int {
 	needed := len(teams) / 2
 
 	indices := make([]int, 0, len(games))
@@ -436,6 +997,9 @@ func (s *scheduler) findPerfectMatch(games []strategy.Game, used map[int]bool, t
 	})
 
 	teamUsed := make(map[string]bool)
+	for team := range skip {
+		teamUsed[team] = true
+	}
 	match := make([]int, 0, needed)
 
 	if s.findMatchRecursive(games, indices, teamUsed, &match, needed) {
@@ -522,7 +1086,7 @@ func (s *scheduler) scheduleSundays(games []strategy.Game, rng *rand.Rand) []str
 						continue
 					}
 					score := gameScore + s.scoreSlot(game, slot)
-					if score < bestScore {
+					if s.beats(score, si, bestScore, bestSlot) {
 						bestScore = score
 						bestGame = i
 						bestSlot = si
@@ -574,6 +1138,43 @@ func (s *scheduler) slotsForDate(date time.Time) []int {
 	return indices
 }
 
+// scoreEpsilon is how close two slot scores must be to count as a tie.
+const scoreEpsilon = 1e-6
+
+// beats reports whether slot si with the given score should replace the
+// current best slot. Scores within scoreEpsilon are ties, broken by earlier
+// date, then earlier time, then field_priority order, then field name, so
+// the choice never depends on slot iteration order.
+func (s *scheduler) beats(score float64, si int, bestScore float64, bestSi int) bool {
+	if bestSi < 0 || score < bestScore-scoreEpsilon {
+		return true
+	}
+	if score > bestScore+scoreEpsilon {
+		return false
+	}
+
+	a, b := s.slots[si], s.slots[bestSi]
+	if !a.Date.Equal(b.Date) {
+		return a.Date.Before(b.Date)
+	}
+	if c := config.CompareTimes(a.Time, b.Time); c != 0 {
+		return c < 0
+	}
+	if ra, rb := s.fieldPriorityRank(a.Field), s.fieldPriorityRank(b.Field); ra != rb {
+		return ra < rb
+	}
+	return a.Field < b.Field
+}
+
+// fieldPriorityRank returns the field's position in field_priority, with
+// unlisted fields ranked after all listed ones.
+func (s *scheduler) fieldPriorityRank(field string) int {
+	if rank, ok := s.fieldRank[field]; ok {
+		return rank
+	}
+	return len(s.cfg.Guidelines.FieldPriority)
+}
+
 func (s *scheduler) assignGame(game strategy.Game) bool {
 	bestSlot := -1
 	bestScore := math.MaxFloat64
@@ -587,11 +1188,12 @@ func (s *scheduler) assignGame(game strategy.Game) bool {
 
 		if reason, ok := s.hardConstraintCheck(game, slot); !ok {
 			s.rejections[reason]++
+			s.dateRejections[slot.Date]++
 			continue
 		}
 
 		score := s.scoreSlot(game, slot)
-		if score < bestScore {
+		if s.beats(score, i, bestScore, bestSlot) {
 			bestScore = score
 			bestSlot = i
 		}
@@ -600,7 +1202,6 @@ func (s *scheduler) assignGame(game strategy.Game) bool {
 	if bestSlot < 0 {
 		return false
 	}
-
 	s.assign(game, s.slots[bestSlot])
 	return true
 }
@@ -610,14 +1211,43 @@ func (s *scheduler) assign(game strategy.Game, slot Slot) {
 	sk := slotKey{slot.Date, slot.Time, slot.Field}
 	s.usedSlots[sk] = true
 	s.slotTimeCnt[timeKey{slot.Date, slot.Time}]++
+	s.dateCnt[slot.Date]++
+	for _, div := range s.divisionsOf(game) {
+		s.timeDivCnt[timeDivKey{slot.Date, slot.Time, div}]++
+	}
 
 	s.teamDates[game.Home] = insertSorted(s.teamDates[game.Home], slot.Date)
 	s.teamDates[game.Away] = insertSorted(s.teamDates[game.Away], slot.Date)
 	s.teamGames[game.Home]++
 	s.teamGames[game.Away]++
+	s.teamTimes[teamTimeKey{game.Home, slot.Date, slot.Time}] = true
+	s.teamTimes[teamTimeKey{game.Away, slot.Date, slot.Time}] = true
+	s.weekFields[weekFieldKey{game.Home, WeekStart(slot.Date), slot.Field}]++
+	s.weekFields[weekFieldKey{game.Away, WeekStart(slot.Date), slot.Field}]++
+	for _, team := range []string{game.Home, game.Away} {
+		if s.teamFields[team] == nil {
+			s.teamFields[team] = make(map[string]int)
+		}
+		s.teamFields[team][slot.Field]++
+	}
+	s.trackStarts(game, slot, 1)
 
 	mk := normalizeMatchup(game.Home, game.Away)
 	s.matchupDate[mk] = slot.Date
+
+	if game.Label != "" {
+		s.labelDate[game.Label] = slot.Date
+	}
+	s.trackFamily(game, slot, true)
+
+	for _, g := range s.groupsOf[game.Away] {
+		gk := groupKey{game.Home, g}
+		s.groupDates[gk] = insertSorted(s.groupDates[gk], slot.Date)
+	}
+	for _, g := range s.groupsOf[game.Home] {
+		gk := groupKey{game.Away, g}
+		s.groupDates[gk] = insertSorted(s.groupDates[gk], slot.Date)
+	}
 }
 
 func (s *scheduler) unassign(idx int) Assignment {
@@ -627,11 +1257,36 @@ func (s *scheduler) unassign(idx int) Assignment {
 	sk := slotKey{a.Slot.Date, a.Slot.Time, a.Slot.Field}
 	delete(s.usedSlots, sk)
 	s.slotTimeCnt[timeKey{a.Slot.Date, a.Slot.Time}]--
+	s.dateCnt[a.Slot.Date]--
+	for _, div := range s.divisionsOf(a.Game) {
+		s.timeDivCnt[timeDivKey{a.Slot.Date, a.Slot.Time, div}]--
+	}
 
 	s.teamDates[a.Game.Home] = removeDate(s.teamDates[a.Game.Home], a.Slot.Date)
 	s.teamDates[a.Game.Away] = removeDate(s.teamDates[a.Game.Away], a.Slot.Date)
 	s.teamGames[a.Game.Home]--
 	s.teamGames[a.Game.Away]--
+	delete(s.teamTimes, teamTimeKey{a.Game.Home, a.Slot.Date, a.Slot.Time})
+	delete(s.teamTimes, teamTimeKey{a.Game.Away, a.Slot.Date, a.Slot.Time})
+	s.weekFields[weekFieldKey{a.Game.Home, WeekStart(a.Slot.Date), a.Slot.Field}]--
+	s.weekFields[weekFieldKey{a.Game.Away, WeekStart(a.Slot.Date), a.Slot.Field}]--
+	for _, team := range []string{a.Game.Home, a.Game.Away} {
+		if s.teamFields[team][a.Slot.Field]--; s.teamFields[team][a.Slot.Field] == 0 {
+			delete(s.teamFields[team], a.Slot.Field)
+		}
+	}
+	s.trackStarts(a.Game, a.Slot, -1)
+	delete(s.labelDate, a.Game.Label)
+	s.trackFamily(a.Game, a.Slot, false)
+
+	for _, g := range s.groupsOf[a.Game.Away] {
+		gk := groupKey{a.Game.Home, g}
+		s.groupDates[gk] = removeDate(s.groupDates[gk], a.Slot.Date)
+	}
+	for _, g := range s.groupsOf[a.Game.Home] {
+		gk := groupKey{a.Game.Away, g}
+		s.groupDates[gk] = removeDate(s.groupDates[gk], a.Slot.Date)
+	}
 
 	// Rebuild matchupDate for this pair from remaining assignments
 	mk := normalizeMatchup(a.Game.Home, a.Game.Away)
@@ -658,18 +1313,74 @@ func removeDate(dates []time.Time, d time.Time) []time.Time {
 }
 
 func (s *scheduler) hardConstraintCheck(game strategy.Game, slot Slot) (rejectionReason, bool) {
+	// Showcase games stay on Saturdays and Sundays
+	if game.WeekendOnly && !isWeekend(slot.Date) {
+		return rejectWeekendOnly, false
+	}
+
+	// Teams with a shared-personnel conflict don't meet on the blacked-out date
+	if s.blackedOut[pairDateKey{normalizeMatchup(game.Home, game.Away), slot.Date}] ||
+		s.blackedOut[pairDateKey{normalizeMatchup(game.Home, config.AnyOpponent), slot.Date}] ||
+		s.blackedOut[pairDateKey{normalizeMatchup(game.Away, config.AnyOpponent), slot.Date}] {
+		return rejectMatchupBlackout, false
+	}
+
+	// Home games for a team with a home_field stay on that field
+	if field, ok := s.homeField[game.Home]; ok && slot.Field != field {
+		return rejectHomeField, false
+	}
+
+	// Neither team may play on a field it has excluded
+	if s.excluded[game.Home][slot.Field] || s.excluded[game.Away][slot.Field] {
+		return rejectExcludedField, false
+	}
+
+	// Teams with a hard max_distinct_fields stay on the fields they've used
+	if s.cfg.Rules.EnforceMaxDistinctFields && s.newFieldsOverCap(game, slot.Field) > 0 {
+		return rejectDistinctFields, false
+	}
+
+	// A hard max_opening_away_streak keeps teams from opening on a long road trip
+	if s.cfg.Rules.EnforceMaxOpeningAwayStreak && s.openingAwayOverCap(game, slot) > 0 {
+		return rejectOpeningAwayStreak, false
+	}
+
+	// Fields at the same physical location host one game per timeslot
+	for _, other := range s.sharedWith[slot.Field] {
+		if s.usedSlots[slotKey{slot.Date, slot.Time, other}] {
+			return rejectSharedLocation, false
+		}
+	}
+
+	// Fields that need time between games (e.g. to drag the infield)
+	if field := s.cfg.Field(slot.Field); field.MinMinutesBetweenGames > 0 {
+		for _, a := range s.assignments {
+			if a.Slot.Field == slot.Field && a.Slot.Date.Equal(slot.Date) &&
+				field.TooClose(a.Slot.Time, slot.Time, s.cfg.TimeSlots.GameLength()) {
+				return rejectFieldBuffer, false
+			}
+		}
+	}
+
 	// Max games per timeslot
 	tk := timeKey{slot.Date, slot.Time}
 	if s.slotTimeCnt[tk] >= s.cfg.Rules.MaxGamesPerTimeslot {
 		return rejectTimeslotCap, false
 	}
 
-	// No team plays twice in one day
+	// Max games on a date across all fields
+	if maxDate := s.cfg.Rules.MaxGamesPerDate; maxDate > 0 && s.dateCnt[slot.Date] >= maxDate {
+		return rejectDateCap, false
+	}
+
+	// Max games per day per team; doubleheader games need separate timeslots
+	maxPerDay := max(s.cfg.Rules.MaxGamesPerDayPerTeam, 1)
 	for _, team := range []string{game.Home, game.Away} {
-		for _, d := range s.teamDates[team] {
-			if d.Equal(slot.Date) {
-				return rejectDoublePlay, false
-			}
+		if s.gamesOn(team, slot.Date) >= maxPerDay {
+			return rejectDoublePlay, false
+		}
+		if s.teamTimes[teamTimeKey{team, slot.Date, slot.Time}] {
+			return rejectDoublePlay, false
 		}
 	}
 
@@ -695,6 +1406,29 @@ func (s *scheduler) hardConstraintCheck(game strategy.Game, slot Slot) (rejectio
 		}
 	}
 
+	// Max home games per week
+	if maxHome := s.cfg.Rules.MaxHomeGamesPerWeek; maxHome > 0 {
+		year, week := slot.Date.ISOWeek()
+		count := 0
+		for _, a := range s.assignments {
+			if y, w := a.Slot.Date.ISOWeek(); a.Game.Home == game.Home && y == year && w == week {
+				count++
+			}
+		}
+		if count >= maxHome {
+			return rejectMaxWeekHomeGames, false
+		}
+	}
+
+	// Teams with a Saturday cap sit out Saturdays once they reach it
+	if slot.Date.Weekday() == time.Saturday {
+		for _, team := range []string{game.Home, game.Away} {
+			if s.atSaturdayCap(team) {
+				return rejectMaxSaturdayGames, false
+			}
+		}
+	}
+
 	// No 3 games in 4 days
 	if s.cfg.Rules.Max3In4Days {
 		for _, team := range []string{game.Home, game.Away} {
@@ -704,6 +1438,20 @@ func (s *scheduler) hardConstraintCheck(game strategy.Game, slot Slot) (rejectio
 		}
 	}
 
+	// Bracket games follow the games that feed them, with rest in between
+	rest := s.cfg.Playoffs.RestDays
+	for _, dep := range game.DependsOn {
+		d, ok := s.labelDate[dep]
+		if !ok || !slot.Date.After(d.AddDate(0, 0, rest)) {
+			return rejectDependency, false
+		}
+	}
+	for _, later := range s.dependents[game.Label] {
+		if d, ok := s.labelDate[later]; ok && !d.After(slot.Date.AddDate(0, 0, rest)) {
+			return rejectDependency, false
+		}
+	}
+
 	return 0, true
 }
 
@@ -724,6 +1472,9 @@ func (s *scheduler) wouldMakeConsecutive(team string, newDate time.Time, maxCons
 
 	consecutive := 1
 	for i := 1; i < len(all); i++ {
+		if all[i].Equal(all[i-1]) {
+			continue // doubleheader: same day
+		}
 		if all[i].Sub(all[i-1]) == 24*time.Hour {
 			consecutive++
 			if consecutive > maxConsec {
@@ -756,15 +1507,46 @@ func (s *scheduler) scoreSlot(game strategy.Game, slot Slot) float64 {
 		score += math.Abs(float64(homeGames)-avgGames) * 2
 		score += math.Abs(float64(awayGames)-avgGames) * 2
 	}
+	score += s.seasonEndPenalty(game, slot)
 
 	// Avoid rematches too soon
 	mk := normalizeMatchup(game.Home, game.Away)
 	if lastDate, ok := s.matchupDate[mk]; ok {
 		daysBetween := slot.Date.Sub(lastDate).Hours() / 24
-		minDays := float64(s.cfg.Guidelines.MinDaysBetweenSameMatchup)
+		minDays := float64(s.minRematchDays(mk))
 		if daysBetween < minDays {
 			score += (minDays - daysBetween) * 5
 		}
+		score += s.rematchSlotPenalty(daysBetween)
+	}
+
+	// Cycle through opponents: push a rematch back until the team has faced
+	// everyone else, and pull a first meeting ahead of the team's rematches
+	if s.cfg.Guidelines.OpponentVariety {
+		for _, side := range [][2]string{{game.Home, game.Away}, {game.Away, game.Home}} {
+			team, opponent := side[0], side[1]
+			window := s.opponents[team] - 1
+			if gap, ok := s.gamesSinceOpponent(team, opponent, slot.Date); ok && gap < window {
+				score += float64(window-gap) * 20
+			} else if !ok {
+				score += float64(s.repeatsBefore(team, slot.Date)) * 20
+			}
+		}
+	}
+
+	// Space out games against the same opponent group
+	if minDays := float64(s.cfg.Guidelines.MinDaysBetweenGroupGames); minDays > 0 {
+		for _, side := range [][2]string{{game.Home, game.Away}, {game.Away, game.Home}} {
+			team, opponent := side[0], side[1]
+			for _, g := range s.groupsOf[opponent] {
+				for _, d := range s.groupDates[groupKey{team, g}] {
+					daysBetween := math.Abs(slot.Date.Sub(d).Hours() / 24)
+					if daysBetween < minDays {
+						score += (minDays - daysBetween) * 5
+					}
+				}
+			}
+		}
 	}
 
 	// Balance Sunday games
@@ -779,32 +1561,152 @@ func (s *scheduler) scoreSlot(game strategy.Game, slot Slot) float64 {
 		}
 	}
 
-	// Prefer earlier dates slightly (spread across season)
-	dayNum := slot.Date.Sub(s.cfg.Season.StartDate.Time).Hours() / 24
-	score += dayNum * 0.1
-
-	// Prefer later time slots (e.g., 17:00 over 12:30 on multi-slot days).
-	// "HH:MM" strings sort chronologically; invert so later = lower score.
-	t, err := time.Parse("15:04", slot.Time)
-	if err == nil {
-		minutesSinceMidnight := t.Hour()*60 + t.Minute()
-		score -= float64(minutesSinceMidnight) * 0.001
+	// Doubleheaders only when needed, and preferably on different fields
+	for _, team := range []string{game.Home, game.Away} {
+		if s.gamesOn(team, slot.Date) > 0 {
+			score += 100
+			for _, a := range s.assignments {
+				if a.Slot.Date.Equal(slot.Date) && a.Slot.Field == slot.Field &&
+					(a.Game.Home == team || a.Game.Away == team) {
+					score += 10
+				}
+			}
+		}
 	}
 
-	return score
-}
-
-func (s *scheduler) gamesInWindow(team string, center time.Time, windowDays int) int {
-	count := 0
-	start := center.AddDate(0, 0, -(windowDays - 1))
-	end := center.AddDate(0, 0, windowDays-1)
-	for _, d := range s.teamDates[team] {
-		if !d.Before(start) && !d.After(end) {
-			count++
+	// Avoid dates a team asked to have off
+	for _, team := range []string{game.Home, game.Away} {
+		if s.offDates[team][slot.Date] {
+			score += 500
 		}
 	}
-	return count
-}
+
+	// Keep teams with max_distinct_fields on the fields they've used
+	score += float64(s.newFieldsOverCap(game, slot.Field)) * 40
+
+	// Give each team Saturday or Sunday of a weekend, not both
+	if s.cfg.Guidelines.AvoidBothWeekendDays {
+		score += float64(s.bothWeekendDays(game, slot)) * 25
+	}
+
+	// Vary a team's fields within a week
+	if penalty := s.cfg.Guidelines.SameFieldWeekPenalty; penalty > 0 {
+		week := WeekStart(slot.Date)
+		for _, team := range []string{game.Home, game.Away} {
+			score += float64(s.weekFields[weekFieldKey{team, week, slot.Field}]) * penalty
+		}
+	}
+
+	// Share timeslots across divisions rather than letting one fill them
+	if s.cfg.Guidelines.BalanceDivisionTimeslots {
+		for _, div := range s.divisionsOf(game) {
+			score += float64(s.timeDivCnt[timeDivKey{slot.Date, slot.Time, div}]) * 15
+		}
+	}
+
+	// Spread a team's games across weeks so its byes don't cluster
+	if s.cfg.Guidelines.MaxConsecutiveByeWeeks > 0 {
+		week := WeekStart(slot.Date)
+		for _, team := range []string{game.Home, game.Away} {
+			for _, d := range s.teamDates[team] {
+				if WeekStart(d).Equal(week) {
+					score += 5
+				}
+			}
+		}
+	}
+
+	// Break up runs of games against above-average opponents
+	if maxRun := s.cfg.Guidelines.MaxStrongOpponentStreak; maxRun > 0 {
+		for _, side := range [][2]string{{game.Home, game.Away}, {game.Away, game.Home}} {
+			team, opponent := side[0], side[1]
+			if !s.strong[opponent] {
+				continue
+			}
+			if run := s.strongStreakWith(team, slot); run > maxRun {
+				score += float64(run-maxRun) * 30
+			}
+		}
+	}
+
+	// Don't open a team's season with a long road trip
+	score += float64(s.openingAwayOverCap(game, slot)) * 30
+
+	// Put linked teams' games on the same day, ideally back to back on one field
+	if len(s.linked) > 0 {
+		score -= s.familyBonus(game, slot)
+	}
+
+	// Prefer finishing by the target end date
+	if s.pastTarget(slot.Date) {
+		score += 20
+	}
+
+	// Prefer earlier dates slightly (spread across season)
+	dayNum := slot.Date.Sub(s.cfg.Season.StartDate.Time).Hours() / 24
+	score += dayNum * 0.1
+
+	// Prefer weekdays in preferred_weekday_order. Each step down the list
+	// outweighs several days of the date term, so a later preferred day in
+	// the same week wins.
+	if n := len(s.cfg.Guidelines.PreferredWeekdayOrder); n > 0 {
+		if day := slot.Date.Weekday(); day != time.Saturday && day != time.Sunday {
+			score += float64(s.cfg.Guidelines.WeekdayRank(day)) * 0.5
+		}
+	}
+
+	// Keep a division on its preferred fields when one is free. Worth about
+	// seven weeks of the date term: enough to wait a week or two for a
+	// preferred field, not enough to push games to the end of the season.
+	score += float64(s.offPreference(game, slot.Field)) * 5
+
+	// Even out slot times and fields that teams drew too often last season
+	score += s.priorPenalty(game, slot)
+
+	// Prefer higher-priority fields. Kept below one day's worth of the date
+	// term so it only decides between fields, never pushes a game later.
+	if n := len(s.cfg.Guidelines.FieldPriority); n > 0 {
+		score += float64(s.fieldPriorityRank(slot.Field)) * 0.05 / float64(n)
+	}
+
+	// Give each team a mix of start times
+	score += s.startPenalty(game, slot)
+
+	// Prefer later time slots (e.g., 17:00 over 12:30 on multi-slot days).
+	// Worth under a tenth of a game's overage in the start time term, so
+	// with balance_start_times it only breaks ties.
+	// "HH:MM" strings sort chronologically; invert so later = lower score.
+	t, err := time.Parse("15:04", slot.Time)
+	if err == nil {
+		minutesSinceMidnight := t.Hour()*60 + t.Minute()
+		score -= float64(minutesSinceMidnight) * 0.001
+	}
+
+	return score
+}
+
+func (s *scheduler) gamesInWindow(team string, center time.Time, windowDays int) int {
+	count := 0
+	start := center.AddDate(0, 0, -(windowDays - 1))
+	end := center.AddDate(0, 0, windowDays-1)
+	for _, d := range s.teamDates[team] {
+		if !d.Before(start) && !d.After(end) {
+			count++
+		}
+	}
+	return count
+}
+
+// gamesOn returns how many games the team has on the given date.
+func (s *scheduler) gamesOn(team string, date time.Time) int {
+	count := 0
+	for _, d := range s.teamDates[team] {
+		if d.Equal(date) {
+			count++
+		}
+	}
+	return count
+}
 
 func (s *scheduler) sundayGames(team string) int {
 	count := 0
@@ -826,6 +1728,44 @@ func (s *scheduler) saturdayGames(team string) int {
 	return count
 }
 
+// teamsOffOn returns the teams that asked to have the given date off.
+func (s *scheduler) teamsOffOn(date time.Time) map[string]bool {
+	var teams map[string]bool
+	for team, dates := range s.offDates {
+		if dates[date] {
+			if teams == nil {
+				teams = make(map[string]bool)
+			}
+			teams[team] = true
+		}
+	}
+	return teams
+}
+
+// minSaturdayGames returns how many Saturday games each team should get:
+// min_saturday_games when set, otherwise every Saturday.
+func (s *scheduler) minSaturdayGames() int {
+	if n := s.cfg.Guidelines.MinSaturdayGames; n > 0 {
+		return n
+	}
+	return len(s.slotDates(time.Saturday))
+}
+
+// saturdayFloor returns the Saturday games team should get given a league
+// floor of n, lowered to the team's max_saturday_games when that is less.
+func (s *scheduler) saturdayFloor(team string, n int) int {
+	if limit, ok := s.maxSat[team]; ok {
+		return min(n, limit)
+	}
+	return n
+}
+
+// atSaturdayCap reports whether team has played its max_saturday_games.
+func (s *scheduler) atSaturdayCap(team string) bool {
+	n, ok := s.maxSat[team]
+	return ok && s.saturdayGames(team) >= n
+}
+
 func (s *scheduler) minSundayGames() int {
 	min := math.MaxInt
 	for _, team := range s.cfg.AllTeams() {
@@ -840,6 +1780,30 @@ func (s *scheduler) minSundayGames() int {
 }
 
 func (s *scheduler) softScore() float64 {
+	if s.cfg.Guidelines.ExcludeOverflowFromScoring && s.overflowGamesCount() > 0 {
+		return s.regularSeason().guidelineScore() + s.overflowPenalty()
+	}
+	return s.guidelineScore() + s.overflowPenalty()
+}
+
+// regularSeason returns a copy of the schedule state holding only the
+// games before the overflow window, so guidelines can be scored without
+// the overflow games.
+func (s *scheduler) regularSeason() *scheduler {
+	r := *s
+	r.overflowSlots = nil
+	r.clearAssignments()
+	for _, a := range s.assignments {
+		if !s.inOverflow(a.Slot.Date) {
+			r.assign(a.Game, a.Slot)
+		}
+	}
+	return &r
+}
+
+// guidelineScore is the soft score of every guideline, without the
+// overflow penalty.
+func (s *scheduler) guidelineScore() float64 {
 	score := 0.0
 
 	// Pace imbalance
@@ -856,12 +1820,19 @@ func (s *scheduler) softScore() float64 {
 		score += float64(max - min)
 	}
 
-	// Saturday balance — heavily penalize teams missing Saturdays
-	numSaturdays := len(s.slotDates(time.Saturday))
+	// Season-end parity
+	if s.cfg.Guidelines.BalanceSeasonEnd {
+		if e := s.seasonEnd(); e != nil {
+			score += float64(e.Days()) * 2
+		}
+	}
+
+	// Saturday balance — heavily penalize teams short of their Saturdays
+	minSaturdays := s.minSaturdayGames()
 	for _, team := range s.cfg.AllTeams() {
 		satGames := s.saturdayGames(team)
-		if satGames < numSaturdays {
-			score += float64(numSaturdays-satGames) * 50
+		if want := s.saturdayFloor(team, minSaturdays); satGames < want {
+			score += float64(want-satGames) * 50
 		}
 	}
 
@@ -890,14 +1861,23 @@ func (s *scheduler) softScore() float64 {
 		}
 	}
 
+	// Games on requested-off dates
+	for team, dates := range s.teamDates {
+		for _, d := range dates {
+			if s.offDates[team][d] {
+				score += 50
+			}
+		}
+	}
+
 	// Rematch proximity — escalating: closer rematches are worse
 	matchups := make(map[matchupKey][]time.Time)
 	for _, a := range s.assignments {
 		mk := normalizeMatchup(a.Game.Home, a.Game.Away)
 		matchups[mk] = append(matchups[mk], a.Slot.Date)
 	}
-	minDays := float64(s.cfg.Guidelines.MinDaysBetweenSameMatchup)
-	for _, dates := range matchups {
+	for mk, dates := range matchups {
+		minDays := float64(s.minRematchDays(mk))
 		sortDatesInPlace(dates)
 		for i := 1; i < len(dates); i++ {
 			daysBetween := dates[i].Sub(dates[i-1]).Hours() / 24
@@ -907,14 +1887,118 @@ func (s *scheduler) softScore() float64 {
 		}
 	}
 
-	// Overflow usage — massive penalty per overflow day used, plus per game
-	overflowDays := s.overflowDaysUsed()
-	score += float64(overflowDays) * 1000
-	score += float64(s.overflowGamesCount()) * 100
+	if s.cfg.Guidelines.Optimize == config.OptimizeRematchSpacing {
+		score += s.rematchSpacingPenalty()
+	}
+
+	// Early rematches before a team has met all its opponents
+	if s.cfg.Guidelines.OpponentVariety {
+		for _, team := range s.cfg.AllTeams() {
+			repeats := (1 - s.opponentVariety(team)) * float64(s.opponents[team])
+			score += repeats * 10
+		}
+	}
+
+	// Opponent group spacing
+	for _, v := range s.groupSpacingViolations() {
+		score += (float64(s.cfg.Guidelines.MinDaysBetweenGroupGames) - v.days) * 5
+	}
+
+	// Clustered byes
+	if maxRun := s.cfg.Guidelines.MaxConsecutiveByeWeeks; maxRun > 0 {
+		for _, team := range s.cfg.AllTeams() {
+			for _, run := range s.byeRuns(team) {
+				if len(run) > maxRun {
+					score += float64(len(run)-maxRun) * 25
+				}
+			}
+		}
+	}
+
+	// Runs of above-average opponents
+	if maxRun := s.cfg.Guidelines.MaxStrongOpponentStreak; maxRun > 0 {
+		for _, team := range s.cfg.AllTeams() {
+			for _, run := range s.strongRuns(team) {
+				if len(run) > maxRun {
+					score += float64(len(run)-maxRun) * 25
+				}
+			}
+		}
+	}
+
+	// Seasons opening with a long road trip
+	if limit := s.cfg.Guidelines.MaxOpeningAwayStreak; limit > 0 {
+		for _, team := range s.cfg.AllTeams() {
+			if n := s.openingAwayStreak(team); n > limit {
+				score += float64(n-limit) * 25
+			}
+		}
+	}
+
+	// Fields past a team's max_distinct_fields
+	for team, limit := range s.maxFields {
+		if n := len(s.teamFields[team]); n > limit {
+			score += float64(n-limit) * 40
+		}
+	}
+
+	// Weekends a team plays both days
+	if s.cfg.Guidelines.AvoidBothWeekendDays {
+		for _, saturdays := range FullWeekends(s.assignments) {
+			score += float64(len(saturdays)) * 15
+		}
+	}
 
+	// Teams' start times away from an even mix
+	if s.cfg.Guidelines.BalanceStartTimes {
+		for _, team := range s.cfg.AllTeams() {
+			score += s.startDeviation(team) * 2
+		}
+	}
+
+	// Games off their division's preferred fields
+	for _, a := range s.assignments {
+		score += float64(s.offPreference(a.Game, a.Slot.Field)) * 5
+	}
+
+	// Games repeating slot times and fields teams drew too often last season
+	for _, a := range s.assignments {
+		score += s.priorPenalty(a.Game, a.Slot)
+	}
+
+	// Days linked teams play together
+	for _, r := range s.familyReports() {
+		score -= float64(r.Together)*8 + float64(r.SameField)*4
+	}
+
+	// Regular-season games after the target end date, worse the later they are
+	if target := s.cfg.Season.TargetEndDate; target != nil {
+		for _, a := range s.assignments {
+			if s.pastTarget(a.Slot.Date) {
+				score += 10 + a.Slot.Date.Sub(target.Time).Hours()/24
+			}
+		}
+	}
+
+	return score
+}
+
+// overflowPenalty is a massive penalty per overflow game, plus per overflow
+// day used unless overflow_strategy asks for games to be spread out.
+func (s *scheduler) overflowPenalty() float64 {
+	score := float64(s.overflowGamesCount()) * 100
+	if s.cfg.Season.OverflowStrategy != config.OverflowSpread {
+		score += float64(s.overflowDaysUsed()) * 1000
+	}
 	return score
 }
 
+// inOverflow reports whether d falls in the overflow window after the
+// regular season.
+func (s *scheduler) inOverflow(d time.Time) bool {
+	return !s.regularEnd.IsZero() && d.After(s.regularEnd)
+}
+
 // overflowDaysUsed returns the number of unique dates in the overflow period
 // that have games assigned.
 func (s *scheduler) overflowDaysUsed() int {
@@ -931,6 +2015,19 @@ func (s *scheduler) overflowDaysUsed() int {
 	return len(days)
 }
 
+// overflowDays returns how many games are on each overflow date used, in
+// date order.
+func (s *scheduler) overflowDays() []OverflowDay {
+	var days []OverflowDay
+	for date, n := range s.dateCnt {
+		if n > 0 && date.After(s.cfg.Season.EndDate.Time) {
+			days = append(days, OverflowDay{Date: date, Games: n})
+		}
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Date.Before(days[j].Date) })
+	return days
+}
+
 // overflowGamesCount returns the number of games scheduled in the overflow period.
 func (s *scheduler) overflowGamesCount() int {
 	if s.cfg.Season.OverflowEndDate == nil {
@@ -962,13 +2059,52 @@ func (s *scheduler) latestOverflowDate() time.Time {
 	return latest
 }
 
-func (s *scheduler) buildMetrics() ([]string, map[string]*TeamMetrics) {
-	var warnings []string
+// isWeekend reports whether d is a Saturday or Sunday.
+func isWeekend(d time.Time) bool {
+	return d.Weekday() == time.Saturday || d.Weekday() == time.Sunday
+}
+
+// pastTarget reports whether a regular-season date falls after the target
+// end date. Overflow dates are penalized separately.
+func (s *scheduler) pastTarget(d time.Time) bool {
+	target := s.cfg.Season.TargetEndDate
+	return target != nil && d.After(target.Time) && !d.After(s.cfg.Season.EndDate.Time)
+}
+
+// lastGameDate returns the date of the latest assignment, or zero time if
+// nothing is scheduled.
+func (s *scheduler) lastGameDate() time.Time {
+	var last time.Time
+	for _, a := range s.assignments {
+		if a.Slot.Date.After(last) {
+			last = a.Slot.Date
+		}
+	}
+	return last
+}
+
+func (s *scheduler) buildMetrics() ([]Warning, map[string]*TeamMetrics) {
+	var warnings []Warning
 	metrics := make(map[string]*TeamMetrics)
+	// warn records a warning and lists it under each team's violations.
+	warn := func(category, msg string, teams ...string) {
+		warnings = append(warnings, Warning{Category: category, Teams: teams, Message: msg})
+		for _, team := range teams {
+			metrics[team].Violations = append(metrics[team].Violations, msg)
+		}
+	}
 
 	// Initialize metrics for all teams
 	for _, team := range s.cfg.AllTeams() {
 		m := &TeamMetrics{Games: s.teamGames[team]}
+		for _, a := range s.assignments {
+			switch team {
+			case a.Game.Home:
+				m.Home++
+			case a.Game.Away:
+				m.Away++
+			}
+		}
 		for _, d := range s.teamDates[team] {
 			switch d.Weekday() {
 			case time.Saturday:
@@ -977,21 +2113,71 @@ func (s *scheduler) buildMetrics() ([]string, map[string]*TeamMetrics) {
 				m.Sunday++
 			}
 		}
+		for _, run := range s.byeRuns(team) {
+			m.ByeWeeks = append(m.ByeWeeks, run...)
+		}
+		m.OpponentVariety = s.opponentVariety(team)
+		m.LongestHomestand, m.LongestRoadTrip = s.homeAwayStreaks(team)
+		m.OpeningAwayStreak = s.openingAwayStreak(team)
+		for _, a := range s.toughestStretch(team) {
+			m.ToughestStretch = append(m.ToughestStretch, opponentOf(a.Game.Home, a.Game.Away, team))
+		}
+		m.Times = make(map[string]int)
+		m.Weekdays = make(map[string]int)
+		for _, a := range s.assignments {
+			if a.Game.Home == team || a.Game.Away == team {
+				m.Times[a.Slot.Time]++
+				m.Weekdays[a.Slot.Date.Weekday().String()]++
+			}
+		}
 		metrics[team] = m
 	}
 
+	// Regular-season balance, apart from any overflow games
+	if s.overflowGamesCount() > 0 {
+		for _, team := range s.cfg.AllTeams() {
+			b := &Balance{}
+			for _, a := range s.assignments {
+				if s.inOverflow(a.Slot.Date) || (a.Game.Home != team && a.Game.Away != team) {
+					continue
+				}
+				b.Games++
+				if a.Game.Home == team {
+					b.Home++
+				} else {
+					b.Away++
+				}
+				switch a.Slot.Date.Weekday() {
+				case time.Saturday:
+					b.Saturday++
+				case time.Sunday:
+					b.Sunday++
+				}
+			}
+			metrics[team].RegularSeason = b
+		}
+	}
+
+	// Requested-off dates that still have games
+	for _, team := range s.cfg.AllTeams() {
+		for _, d := range s.teamDates[team] {
+			if s.offDates[team][d] {
+				metrics[team].OffDatesPlayed = append(metrics[team].OffDatesPlayed, d)
+				warn(WarningOffDate, fmt.Sprintf("%s plays on requested off date %s", team, d.Format("01/02")), team)
+			}
+		}
+	}
+
 	// Check 3-in-4-days
 	for _, team := range s.cfg.AllTeams() {
 		dates := s.teamDates[team]
 		for i := 2; i < len(dates); i++ {
 			if dates[i].Sub(dates[i-2]).Hours()/24 <= 3 {
-				w := fmt.Sprintf("%s plays 3 games in 4 days: %s, %s, %s",
+				warn(WarningThreeInFour, fmt.Sprintf("%s plays 3 games in 4 days: %s, %s, %s",
 					team,
 					dates[i-2].Format("01/02"),
 					dates[i-1].Format("01/02"),
-					dates[i].Format("01/02"))
-				warnings = append(warnings, w)
-				metrics[team].Violations = append(metrics[team].Violations, w)
+					dates[i].Format("01/02")), team)
 			}
 		}
 	}
@@ -1010,12 +2196,13 @@ func (s *scheduler) buildMetrics() ([]string, map[string]*TeamMetrics) {
 		matchups[mk] = append(matchups[mk], a.Slot.Date)
 	}
 	for mk, dates := range matchups {
+		minDays := s.minRematchDays(mk)
 		sortDatesInPlace(dates)
 		for i := 1; i < len(dates); i++ {
 			daysBetween := dates[i].Sub(dates[i-1]).Hours() / 24
-			if daysBetween < float64(s.cfg.Guidelines.MinDaysBetweenSameMatchup) {
+			if daysBetween < float64(minDays) {
 				w := fmt.Sprintf("%s vs %s rematch after %.0f days (min %d): %s and %s",
-					mk.a, mk.b, daysBetween, s.cfg.Guidelines.MinDaysBetweenSameMatchup,
+					mk.a, mk.b, daysBetween, minDays,
 					dates[i-1].Format("01/02"), dates[i].Format("01/02"))
 				rematchViolations = append(rematchViolations, rematchViolation{
 					days: daysBetween, warning: w, teamA: mk.a, teamB: mk.b,
@@ -1030,9 +2217,133 @@ func (s *scheduler) buildMetrics() ([]string, map[string]*TeamMetrics) {
 		}
 	}
 	for _, rv := range rematchViolations {
-		warnings = append(warnings, rv.warning)
-		metrics[rv.teamA].Violations = append(metrics[rv.teamA].Violations, rv.warning)
-		metrics[rv.teamB].Violations = append(metrics[rv.teamB].Violations, rv.warning)
+		warn(WarningRematch, rv.warning, rv.teamA, rv.teamB)
+	}
+
+	// Opponent group spacing
+	for _, v := range s.groupSpacingViolations() {
+		warn(WarningGroupSpacing, fmt.Sprintf("%s plays opponent group %s after %.0f days (min %d): %s and %s",
+			v.team, v.group, v.days, s.cfg.Guidelines.MinDaysBetweenGroupGames,
+			v.first.Format("01/02"), v.second.Format("01/02")), v.team)
+	}
+
+	// Saturday floor
+	if minSat := s.cfg.Guidelines.MinSaturdayGames; minSat > 0 {
+		for _, team := range s.cfg.AllTeams() {
+			if n, floor := metrics[team].Saturday, s.saturdayFloor(team, minSat); n < floor {
+				warn(WarningSaturdayFloor, fmt.Sprintf("%s plays %d Saturday games (min %d)", team, n, floor), team)
+			}
+		}
+	}
+
+	// Saturday caps reached
+	for _, team := range s.cfg.AllTeams() {
+		if limit, ok := s.maxSat[team]; ok && metrics[team].Saturday >= limit {
+			metrics[team].SaturdayCapReached = true
+		}
+	}
+
+	// Clustered byes
+	if maxRun := s.cfg.Guidelines.MaxConsecutiveByeWeeks; maxRun > 0 {
+		for _, team := range s.cfg.AllTeams() {
+			for _, run := range s.byeRuns(team) {
+				if len(run) <= maxRun {
+					continue
+				}
+				warn(WarningByeWeeks, fmt.Sprintf("%s has %d straight bye weeks (max %d): weeks of %s through %s",
+					team, len(run), maxRun, run[0].Format("01/02"), run[len(run)-1].Format("01/02")), team)
+			}
+		}
+	}
+
+	// Runs of above-average opponents
+	if maxRun := s.cfg.Guidelines.MaxStrongOpponentStreak; maxRun > 0 {
+		for _, team := range s.cfg.AllTeams() {
+			for _, run := range s.strongRuns(team) {
+				if len(run) <= maxRun {
+					continue
+				}
+				warn(WarningStrongStreak, fmt.Sprintf("%s faces %d above-average opponents in a row (max %d): %s",
+					team, len(run), maxRun, describeStretch(team, run)), team)
+			}
+		}
+	}
+
+	// Teams opening their season with a long road trip
+	if limit := s.cfg.Guidelines.MaxOpeningAwayStreak; limit > 0 {
+		for _, team := range s.cfg.AllTeams() {
+			if n := metrics[team].OpeningAwayStreak; n > limit {
+				warn(WarningOpeningAwayStreak, fmt.Sprintf("%s opens the season with %d away games (max_opening_away_streak %d)", team, n, limit), team)
+			}
+		}
+	}
+
+	// Teams at more fields than max_distinct_fields
+	for _, team := range s.cfg.AllTeams() {
+		metrics[team].Fields = len(s.teamFields[team])
+		if limit, ok := s.maxFields[team]; ok && metrics[team].Fields > limit {
+			warn(WarningDistinctFields, fmt.Sprintf("%s plays at %d fields (max_distinct_fields %d)", team, metrics[team].Fields, limit), team)
+		}
+	}
+
+	// Teams playing both days of a weekend
+	full := FullWeekends(s.assignments)
+	for _, team := range s.cfg.AllTeams() {
+		metrics[team].FullWeekends = len(full[team])
+		if !s.cfg.Guidelines.AvoidBothWeekendDays {
+			continue
+		}
+		for _, sat := range full[team] {
+			warn(WarningBothWeekendDays, fmt.Sprintf("%s plays both days of the weekend of %s", team, sat.Format("01/02")), team)
+		}
+	}
+
+	// Same field three or more times in a week
+	if s.cfg.Guidelines.SameFieldWeekPenalty > 0 {
+		counts := make(map[weekFieldKey]int)
+		for _, a := range s.assignments {
+			for _, team := range []string{a.Game.Home, a.Game.Away} {
+				counts[weekFieldKey{team, WeekStart(a.Slot.Date), a.Slot.Field}]++
+			}
+		}
+		for _, team := range s.cfg.AllTeams() {
+			for _, week := range s.weeks {
+				for _, f := range s.cfg.Fields {
+					if n := counts[weekFieldKey{team, week, f.Name}]; n >= 3 {
+						warn(WarningSameField, fmt.Sprintf("%s plays %d games at %s in the week of %s",
+							team, n, f.Name, week.Format("01/02")), team)
+					}
+				}
+			}
+		}
+	}
+
+	// Teams stuck at one time of day
+	for _, team := range s.cfg.AllTeams() {
+		metrics[team].StartTimeSpread = s.startTimeSpread(team)
+		if sk, ok := s.timeslotSkew(team); ok {
+			warn(WarningTimeslotSkew, fmt.Sprintf("%s plays %d of %d games at %s on days with a choice of times (expected about %.1f)",
+				team, sk.games, sk.total, sk.time, sk.expected), team)
+		}
+	}
+
+	// Timeslots monopolized by one division
+	if s.cfg.Guidelines.BalanceDivisionTimeslots && len(s.cfg.Divisions) > 1 {
+		alone := s.monopolizedTimeslots()
+		most, least := 0, math.MaxInt
+		for _, div := range s.cfg.Divisions {
+			most = max(most, alone[div.Name])
+			least = min(least, alone[div.Name])
+		}
+		if most-least > 1 {
+			var parts []string
+			for _, div := range s.cfg.Divisions {
+				parts = append(parts, fmt.Sprintf("%s %d", div.Name, alone[div.Name]))
+			}
+			warn(WarningDivisionTimeslots, fmt.Sprintf(
+				"Division timeslot imbalance: shared timeslots filled by one division: %s",
+				strings.Join(parts, ", ")))
+		}
 	}
 
 	// Sunday balance
@@ -1045,15 +2356,43 @@ func (s *scheduler) buildMetrics() ([]string, map[string]*TeamMetrics) {
 			minSun = m.Sunday
 		}
 	}
-	if maxSun-minSun > 1 {
-		warnings = append(warnings, fmt.Sprintf(
+	if maxSun-minSun > s.cfg.Guidelines.SundayTolerance() {
+		warn(WarningSundayImbalance, fmt.Sprintf(
 			"Sunday game imbalance: min %d, max %d across teams", minSun, maxSun))
 	}
 
+	// Pace balance
+	if tolerance, ok := s.cfg.Guidelines.PaceTolerance(); ok && s.cfg.Guidelines.BalancePace {
+		if gap := PaceSpread(s.cfg.AllTeams(), s.assignments); gap.Spread() > tolerance {
+			warn(WarningPaceImbalance, fmt.Sprintf(
+				"Pace imbalance: week of %s ends with teams at %d to %d games played",
+				gap.Week.Format("Jan 2"), gap.Min, gap.Max))
+		}
+	}
+
+	// Games after the target end date
+	if target := s.cfg.Season.TargetEndDate; target != nil {
+		late := 0
+		var last time.Time
+		for _, a := range s.assignments {
+			if s.pastTarget(a.Slot.Date) {
+				late++
+				if a.Slot.Date.After(last) {
+					last = a.Slot.Date
+				}
+			}
+		}
+		if late > 0 {
+			warn(WarningPastTarget, fmt.Sprintf(
+				"%d game(s) after target end date %s (through %s)",
+				late, target.Time.Format("01/02"), last.Format("01/02")))
+		}
+	}
+
 	// Overflow usage
 	if overflowDays := s.overflowDaysUsed(); overflowDays > 0 {
 		latest := s.latestOverflowDate()
-		warnings = append(warnings, fmt.Sprintf(
+		warn(WarningOverflow, fmt.Sprintf(
 			"Overflow: %d game(s) on %d day(s) past end of regular season (through %s)",
 			s.overflowGamesCount(), overflowDays, latest.Format("01/02")))
 	}
@@ -1061,6 +2400,286 @@ func (s *scheduler) buildMetrics() ([]string, map[string]*TeamMetrics) {
 	return warnings, metrics
 }
 
+// groupSpacingViolation is a pair of a team's games against the same
+// opponent group played closer together than the configured minimum.
+type groupSpacingViolation struct {
+	team, group   string
+	days          float64
+	first, second time.Time
+}
+
+// groupSpacingViolations scans assignments for games against the same
+// opponent group that are closer than min_days_between_group_games.
+func (s *scheduler) groupSpacingViolations() []groupSpacingViolation {
+	minDays := float64(s.cfg.Guidelines.MinDaysBetweenGroupGames)
+	if minDays <= 0 || len(s.groupsOf) == 0 {
+		return nil
+	}
+
+	dates := make(map[groupKey][]time.Time)
+	for _, a := range s.assignments {
+		for _, g := range s.groupsOf[a.Game.Away] {
+			gk := groupKey{a.Game.Home, g}
+			dates[gk] = append(dates[gk], a.Slot.Date)
+		}
+		for _, g := range s.groupsOf[a.Game.Home] {
+			gk := groupKey{a.Game.Away, g}
+			dates[gk] = append(dates[gk], a.Slot.Date)
+		}
+	}
+
+	var violations []groupSpacingViolation
+	for _, team := range s.cfg.AllTeams() {
+		for _, og := range s.cfg.Guidelines.OpponentGroups {
+			ds := dates[groupKey{team, og.Name}]
+			sortDatesInPlace(ds)
+			for i := 1; i < len(ds); i++ {
+				daysBetween := ds[i].Sub(ds[i-1]).Hours() / 24
+				if daysBetween < minDays {
+					violations = append(violations, groupSpacingViolation{
+						team: team, group: og.Name, days: daysBetween,
+						first: ds[i-1], second: ds[i],
+					})
+				}
+			}
+		}
+	}
+	return violations
+}
+
+// divisionsOf returns the divisions a game involves: one for an
+// intra-division game, two for an inter-division game.
+func (s *scheduler) divisionsOf(game strategy.Game) []string {
+	home, away := s.divisionOf[game.Home], s.divisionOf[game.Away]
+	if home == away {
+		return []string{home}
+	}
+	return []string{home, away}
+}
+
+// monopolizedTimeslots counts, per division, the timeslots holding more than
+// one game where every game is that division's intra-division game.
+func (s *scheduler) monopolizedTimeslots() map[string]int {
+	alone := make(map[string]int)
+	for tk, n := range s.slotTimeCnt {
+		if n < 2 {
+			continue
+		}
+		for _, div := range s.cfg.Divisions {
+			if s.timeDivCnt[timeDivKey{tk.date, tk.time, div.Name}] > 0 && s.onlyDivisionIn(tk, div.Name) {
+				alone[div.Name]++
+			}
+		}
+	}
+	return alone
+}
+
+// onlyDivisionIn reports whether no other division has a game in the timeslot.
+func (s *scheduler) onlyDivisionIn(tk timeKey, division string) bool {
+	for _, div := range s.cfg.Divisions {
+		if div.Name != division && s.timeDivCnt[timeDivKey{tk.date, tk.time, div.Name}] > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// gamesSinceOpponent counts the team's games strictly between d and its
+// nearest meeting with opponent, before or after d. It reports false when
+// the two haven't met.
+func (s *scheduler) gamesSinceOpponent(team, opponent string, d time.Time) (int, bool) {
+	var nearest time.Time
+	found := false
+	for _, a := range s.assignments {
+		if (a.Game.Home != team || a.Game.Away != opponent) && (a.Game.Home != opponent || a.Game.Away != team) {
+			continue
+		}
+		if !found || absDays(a.Slot.Date, d) < absDays(nearest, d) {
+			nearest = a.Slot.Date
+			found = true
+		}
+	}
+	if !found {
+		return 0, false
+	}
+
+	from, to := nearest, d
+	if to.Before(from) {
+		from, to = to, from
+	}
+	gap := 0
+	for _, td := range s.teamDates[team] {
+		if td.After(from) && td.Before(to) {
+			gap++
+		}
+	}
+	return gap, true
+}
+
+// repeatsBefore counts the team's games before d against an opponent it
+// had already met.
+func (s *scheduler) repeatsBefore(team string, d time.Time) int {
+	var games []Assignment
+	for _, a := range s.assignments {
+		if (a.Game.Home == team || a.Game.Away == team) && a.Slot.Date.Before(d) {
+			games = append(games, a)
+		}
+	}
+	sort.SliceStable(games, func(i, j int) bool { return games[i].Slot.Date.Before(games[j].Slot.Date) })
+	met := make(map[string]bool)
+	repeats := 0
+	for _, a := range games {
+		opponent := a.Game.Home
+		if opponent == team {
+			opponent = a.Game.Away
+		}
+		if met[opponent] {
+			repeats++
+		}
+		met[opponent] = true
+	}
+	return repeats
+}
+
+// opponentVariety returns the share of distinct opponents among the team's
+// first k games in date order, where k is its number of opponents.
+func (s *scheduler) opponentVariety(team string) float64 {
+	var games []Assignment
+	for _, a := range s.assignments {
+		if a.Game.Home == team || a.Game.Away == team {
+			games = append(games, a)
+		}
+	}
+	sort.SliceStable(games, func(i, j int) bool {
+		if !games[i].Slot.Date.Equal(games[j].Slot.Date) {
+			return games[i].Slot.Date.Before(games[j].Slot.Date)
+		}
+		return config.CompareTimes(games[i].Slot.Time, games[j].Slot.Time) < 0
+	})
+
+	k := min(s.opponents[team], len(games))
+	if k == 0 {
+		return 0
+	}
+	seen := make(map[string]bool)
+	for _, a := range games[:k] {
+		if a.Game.Home == team {
+			seen[a.Game.Away] = true
+		} else {
+			seen[a.Game.Home] = true
+		}
+	}
+	return float64(len(seen)) / float64(k)
+}
+
+// plural formats n with noun, adding an s unless n is 1.
+func plural(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+func absDays(a, b time.Time) float64 {
+	return math.Abs(a.Sub(b).Hours() / 24)
+}
+
+// Timeslot skew thresholds: a team is flagged when it has at least
+// skewMinGames games on days offering more than one start time and plays
+// at least skewFactor times its fair share of them at a single time.
+const (
+	skewMinGames = 4
+	skewFactor   = 2.0
+)
+
+type timeSkew struct {
+	time     string
+	games    int     // games at time
+	total    int     // games on days with a choice of times
+	expected float64 // games at time if each offered time were equally likely
+}
+
+// timeslotSkew finds the start time the team plays most often beyond its
+// fair share, counting only days that offer more than one start time.
+// It reports false when the team's times are not heavily skewed.
+func (s *scheduler) timeslotSkew(team string) (timeSkew, bool) {
+	games := make(map[string]int)
+	expected := make(map[string]float64)
+	total := 0
+	for _, a := range s.assignments {
+		if a.Game.Home != team && a.Game.Away != team {
+			continue
+		}
+		times := TimesForDay(s.cfg, a.Slot.Date)
+		if len(times) < 2 {
+			continue
+		}
+		total++
+		games[a.Slot.Time]++
+		for _, t := range times {
+			expected[t] += 1 / float64(len(times))
+		}
+	}
+	if total < skewMinGames {
+		return timeSkew{}, false
+	}
+
+	var worst timeSkew
+	found := false
+	for t, n := range games {
+		if float64(n) < skewFactor*expected[t] {
+			continue
+		}
+		if !found || n > worst.games || (n == worst.games && t < worst.time) {
+			worst = timeSkew{time: t, games: n, total: total, expected: expected[t]}
+			found = true
+		}
+	}
+	return worst, found
+}
+
+// byeRuns returns the team's bye weeks (season weeks without a game),
+// grouped into runs broken only by weeks the team plays. Weeks with no
+// slots at all don't break a run.
+func (s *scheduler) byeRuns(team string) [][]time.Time {
+	played := make(map[time.Time]bool)
+	for _, d := range s.teamDates[team] {
+		played[WeekStart(d)] = true
+	}
+
+	var runs [][]time.Time
+	var run []time.Time
+	for _, w := range s.weeks {
+		if played[w] {
+			if len(run) > 0 {
+				runs = append(runs, run)
+				run = nil
+			}
+			continue
+		}
+		run = append(run, w)
+	}
+	if len(run) > 0 {
+		runs = append(runs, run)
+	}
+	return runs
+}
+
+// minRematchDays returns min_days_between_same_matchup for the pair, after
+// its divisions' overrides.
+func (s *scheduler) minRematchDays(mk matchupKey) int {
+	if days, ok := s.rematchDays[mk]; ok {
+		return days
+	}
+	return s.cfg.Guidelines.MinDaysBetweenSameMatchup
+}
+
+// WeekStart returns the Monday of the date's week, the calendar week the
+// weekly rules and guidelines count by.
+func WeekStart(d time.Time) time.Time {
+	return d.AddDate(0, 0, -((int(d.Weekday()) + 6) % 7))
+}
+
 func insertSorted(dates []time.Time, d time.Time) []time.Time {
 	i := 0
 	for i < len(dates) && dates[i].Before(d) {
@@ -1079,3 +2698,16 @@ func sortDatesInPlace(dates []time.Time) {
 		}
 	}
 }
+
+// newFieldsOverCap counts the game's teams with a max_distinct_fields cap
+// for which field would be a new field past the cap.
+func (s *scheduler) newFieldsOverCap(game strategy.Game, field string) int {
+	n := 0
+	for _, team := range []string{game.Home, game.Away} {
+		limit, ok := s.maxFields[team]
+		if ok && s.teamFields[team][field] == 0 && len(s.teamFields[team]) >= limit {
+			n++
+		}
+	}
+	return n
+}
@@ -1,6 +1,9 @@
 package schedule
 
 import (
+	"fmt"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -33,8 +36,8 @@ func schedulerTestConfig() *config.Config {
 			Weekday:  []string{"17:45"},
 			Saturday: []string{"12:30", "14:45", "17:00"},
 			Sunday:   []string{"17:00"},
-			HolidayDates: []config.Date{
-				date(2026, 5, 25),
+			HolidayDates: []config.Holiday{
+				{Date: date(2026, 5, 25)},
 			},
 		},
 		Strategy: "division_weighted",
@@ -183,3 +186,1412 @@ func sortDates(dates []time.Time) {
 		}
 	}
 }
+
+func TestScheduleWithProgress(t *testing.T) {
+	cfg := schedulerTestConfig()
+	games := (&strategy.DivisionWeighted{}).GenerateMatchups(cfg.Divisions)
+
+	var reports []Progress
+	result, err := ScheduleWithProgress(cfg, GenerateSlots(cfg), nil, games, func(p Progress) {
+		reports = append(reports, p)
+	})
+	if err != nil {
+		t.Fatalf("ScheduleWithProgress() error: %v", err)
+	}
+	if len(reports) != result.Diagnostics.Attempts {
+		t.Fatalf("got %d progress reports, want one per attempt (%d)", len(reports), result.Diagnostics.Attempts)
+	}
+	for i, p := range reports {
+		if p.Attempt != i+1 || p.Attempts != result.Diagnostics.Attempts || p.Games != len(games) {
+			t.Errorf("report %d = %+v, want attempt %d of %d for %d games", i, p, i+1, result.Diagnostics.Attempts, len(games))
+		}
+		if i > 0 && (p.Scheduled < reports[i-1].Scheduled || p.SoftScore > reports[i-1].SoftScore) {
+			t.Errorf("report %d = %+v got worse than %+v", i, p, reports[i-1])
+		}
+	}
+	last := reports[len(reports)-1]
+	if last.Scheduled != len(games) {
+		t.Errorf("last report placed %d games, want %d", last.Scheduled, len(games))
+	}
+	if result.Repair == nil && last.SoftScore != result.Diagnostics.SoftScore {
+		t.Errorf("last report soft score = %.1f, want the result's %.1f", last.SoftScore, result.Diagnostics.SoftScore)
+	}
+}
+
+func TestSchedulePreferredOffDates(t *testing.T) {
+	cfg := schedulerTestConfig()
+	cfg.Teams = []config.Team{
+		{Name: "Angels", PreferredOffDates: []config.Date{date(2026, 5, 2), date(2026, 5, 3)}},
+	}
+	slots := GenerateSlots(cfg)
+	games := (&strategy.DivisionWeighted{}).GenerateMatchups(cfg.Divisions)
+
+	result, err := Schedule(cfg, slots, nil, games)
+	if err != nil {
+		t.Fatalf("Schedule() error: %v", err)
+	}
+
+	t.Run("requested weekend is avoided", func(t *testing.T) {
+		for _, d := range teamGameDates(result.Assignments)["Angels"] {
+			if d.Equal(mustDate("2026-05-02")) || d.Equal(mustDate("2026-05-03")) {
+				t.Errorf("Angels scheduled on requested off date %s", d.Format("01/02"))
+			}
+		}
+	})
+
+	t.Run("metrics report no off dates played", func(t *testing.T) {
+		if n := len(result.TeamMetrics["Angels"].OffDatesPlayed); n != 0 {
+			t.Errorf("Angels OffDatesPlayed = %d, want 0", n)
+		}
+	})
+}
+
+func TestGroupSpacingViolations(t *testing.T) {
+	cfg := schedulerTestConfig()
+	cfg.Guidelines.OpponentGroups = []config.OpponentGroup{
+		{Name: "Travel", Teams: []string{"Cubs", "Padres"}},
+	}
+	cfg.Guidelines.MinDaysBetweenGroupGames = 7
+
+	s := newScheduler(cfg, nil, nil, nil)
+	s.assign(strategy.Game{Home: "Angels", Away: "Cubs"}, Slot{Date: mustDate("2026-05-01"), Time: "17:45", Field: "Symonds Field"})
+	s.assign(strategy.Game{Home: "Padres", Away: "Angels"}, Slot{Date: mustDate("2026-05-04"), Time: "17:45", Field: "Symonds Field"})
+
+	t.Run("close group games are reported", func(t *testing.T) {
+		v := s.groupSpacingViolations()
+		if len(v) != 1 || v[0].team != "Angels" || v[0].group != "Travel" || v[0].days != 3 {
+			t.Errorf("violations = %+v, want one for Angels vs Travel after 3 days", v)
+		}
+	})
+
+	t.Run("scoreSlot penalizes another group game nearby", func(t *testing.T) {
+		near := s.scoreSlot(strategy.Game{Home: "Angels", Away: "Cubs"}, Slot{Date: mustDate("2026-05-06"), Time: "17:45", Field: "Symonds Field"})
+		cfg.Guidelines.MinDaysBetweenGroupGames = 0
+		without := s.scoreSlot(strategy.Game{Home: "Angels", Away: "Cubs"}, Slot{Date: mustDate("2026-05-06"), Time: "17:45", Field: "Symonds Field"})
+		if near <= without {
+			t.Errorf("score with group spacing = %.2f, want more than %.2f", near, without)
+		}
+	})
+
+	t.Run("unassign clears group history", func(t *testing.T) {
+		s.unassign(1)
+		if n := len(s.groupDates[groupKey{"Angels", "Travel"}]); n != 1 {
+			t.Errorf("Angels/Travel dates = %d, want 1", n)
+		}
+	})
+}
+
+func TestHardConstraint3In4Days(t *testing.T) {
+	cfg := schedulerTestConfig()
+	s := newScheduler(cfg, nil, nil, nil)
+	s.assign(strategy.Game{Home: "Angels", Away: "Cubs"}, Slot{Date: mustDate("2026-05-04"), Time: "17:45", Field: "Symonds Field"})
+	s.assign(strategy.Game{Home: "Padres", Away: "Angels"}, Slot{Date: mustDate("2026-05-05"), Time: "17:45", Field: "Symonds Field"})
+	third := Slot{Date: mustDate("2026-05-07"), Time: "17:45", Field: "Symonds Field"}
+	game := strategy.Game{Home: "Angels", Away: "Astros"}
+
+	t.Run("allowed when rule disabled", func(t *testing.T) {
+		if _, ok := s.hardConstraintCheck(game, third); !ok {
+			t.Error("expected slot to be allowed with max_3_in_4_days off")
+		}
+	})
+
+	t.Run("rejected when rule enabled", func(t *testing.T) {
+		cfg.Rules.Max3In4Days = true
+		reason, ok := s.hardConstraintCheck(game, third)
+		if ok || reason != reject3In4Days {
+			t.Errorf("hardConstraintCheck = (%v, %v), want reject3In4Days", reason, ok)
+		}
+	})
+}
+
+func TestScoreSlotFieldPriority(t *testing.T) {
+	cfg := schedulerTestConfig()
+	game := strategy.Game{Home: "Angels", Away: "Cubs"}
+	mon := mustDate("2026-05-04")
+	score := func(s *scheduler, field string) float64 {
+		return s.scoreSlot(game, Slot{Date: mon, Time: "17:45", Field: field})
+	}
+
+	t.Run("neutral when unset", func(t *testing.T) {
+		s := newScheduler(cfg, nil, nil, nil)
+		if score(s, "Washington Park") != score(s, "Moscariello Ballpark") {
+			t.Error("expected equal scores without field_priority")
+		}
+	})
+
+	t.Run("prefers fields earlier in the list", func(t *testing.T) {
+		cfg.Guidelines.FieldPriority = []string{"Washington Park", "Symonds Field"}
+		s := newScheduler(cfg, nil, nil, nil)
+		wash, sym, mosc := score(s, "Washington Park"), score(s, "Symonds Field"), score(s, "Moscariello Ballpark")
+		if !(wash < sym && sym < mosc) {
+			t.Errorf("scores Washington=%.3f Symonds=%.3f Moscariello=%.3f, want increasing", wash, sym, mosc)
+		}
+	})
+
+	t.Run("never outweighs an earlier date", func(t *testing.T) {
+		s := newScheduler(cfg, nil, nil, nil)
+		tue := s.scoreSlot(game, Slot{Date: mon.AddDate(0, 0, 1), Time: "17:45", Field: "Washington Park"})
+		if score(s, "Moscariello Ballpark") >= tue {
+			t.Error("field priority should not push a game to a later date")
+		}
+	})
+}
+
+func TestScheduleBracket(t *testing.T) {
+	cfg := schedulerTestConfig()
+	cfg.Playoffs.RestDays = 1
+	games := (&strategy.Bracket{Seeds: []string{"Angels", "Cubs", "Padres", "Royals", "Astros", "Pirates"}}).
+		GenerateMatchups(cfg.Divisions)
+
+	result, err := Schedule(cfg, GenerateSlots(cfg), nil, games)
+	if err != nil {
+		t.Fatalf("Schedule() error: %v", err)
+	}
+
+	dates := make(map[string]time.Time)
+	for _, a := range result.Assignments {
+		dates[a.Game.Label] = a.Slot.Date
+	}
+	for _, g := range games {
+		for _, dep := range g.DependsOn {
+			if !dates[g.Label].After(dates[dep].AddDate(0, 0, 1)) {
+				t.Errorf("%s on %s is too soon after %s on %s", g.Label,
+					dates[g.Label].Format("01/02"), dep, dates[dep].Format("01/02"))
+			}
+		}
+	}
+}
+
+func TestAssignGameTiebreak(t *testing.T) {
+	cfg := schedulerTestConfig()
+	mon := mustDate("2026-05-04")
+	// Same date and time, so scores tie; listed in reverse name order
+	slots := []Slot{
+		{Date: mon, Time: "17:45", Field: "Washington Park"},
+		{Date: mon, Time: "17:45", Field: "Moscariello Ballpark"},
+	}
+	game := strategy.Game{Home: "Angels", Away: "Cubs"}
+
+	t.Run("ties go to field name order", func(t *testing.T) {
+		s := newScheduler(cfg, slots, nil, nil)
+		if !s.assignGame(game) {
+			t.Fatal("assignGame() = false")
+		}
+		if got := s.assignments[0].Slot.Field; got != "Moscariello Ballpark" {
+			t.Errorf("assigned to %s, want Moscariello Ballpark", got)
+		}
+	})
+
+	t.Run("slot order does not matter", func(t *testing.T) {
+		s := newScheduler(cfg, []Slot{slots[1], slots[0]}, nil, nil)
+		s.assignGame(game)
+		if got := s.assignments[0].Slot.Field; got != "Moscariello Ballpark" {
+			t.Errorf("assigned to %s, want Moscariello Ballpark", got)
+		}
+	})
+}
+
+func TestScheduleWeekendDoubleheaders(t *testing.T) {
+	cfg := &config.Config{
+		Season: config.Season{StartDate: date(2026, 5, 2), EndDate: date(2026, 5, 3)},
+		Divisions: []config.Division{
+			{Name: "A", Teams: []string{"Angels", "Astros", "Cubs", "Padres"}},
+		},
+		Fields: []config.Field{{Name: "Symonds Field"}, {Name: "Washington Park"}},
+		TimeSlots: config.TimeSlots{
+			Saturday: []string{"12:30", "14:45", "17:00"},
+			Sunday:   []string{"12:30", "17:00"},
+		},
+		Rules: config.Rules{
+			MaxGamesPerDayPerTeam: 2,
+			MaxConsecutiveDays:    2,
+			MaxGamesPerWeek:       3,
+			MaxGamesPerTimeslot:   2,
+		},
+	}
+	// Single round robin: each team plays 3 games over a 2-day weekend
+	var games []strategy.Game
+	teams := cfg.AllTeams()
+	for i := range teams {
+		for j := i + 1; j < len(teams); j++ {
+			games = append(games, strategy.Game{Home: teams[i], Away: teams[j]})
+		}
+	}
+
+	t.Run("fails with one game per day", func(t *testing.T) {
+		single := *cfg
+		single.Rules.MaxGamesPerDayPerTeam = 1
+		if _, err := Schedule(&single, GenerateSlots(&single), nil, games); err == nil {
+			t.Error("expected failure: 3 games cannot fit in 2 days at 1 per day")
+		}
+	})
+
+	result, err := Schedule(cfg, GenerateSlots(cfg), nil, games)
+	if err != nil {
+		t.Fatalf("Schedule() error: %v", err)
+	}
+
+	t.Run("all games scheduled", func(t *testing.T) {
+		if len(result.Assignments) != 6 {
+			t.Errorf("scheduled %d games, want 6", len(result.Assignments))
+		}
+	})
+
+	t.Run("doubleheaders use separate timeslots", func(t *testing.T) {
+		type teamTime struct {
+			team string
+			date time.Time
+			time string
+		}
+		perDay := make(map[string]int)
+		seen := make(map[teamTime]bool)
+		for _, a := range result.Assignments {
+			for _, team := range []string{a.Game.Home, a.Game.Away} {
+				perDay[team+a.Slot.Date.Format("01/02")]++
+				tt := teamTime{team, a.Slot.Date, a.Slot.Time}
+				if seen[tt] {
+					t.Errorf("%s plays twice at %s %s", team, a.Slot.Date.Format("01/02"), a.Slot.Time)
+				}
+				seen[tt] = true
+			}
+		}
+		for key, n := range perDay {
+			if n > 2 {
+				t.Errorf("%s: %d games in a day, max 2", key, n)
+			}
+		}
+	})
+}
+
+func TestByeWeeks(t *testing.T) {
+	cfg := schedulerTestConfig()
+	cfg.Season.EndDate = date(2026, 5, 17)
+	cfg.Guidelines.MaxConsecutiveByeWeeks = 2
+	s := newScheduler(cfg, GenerateSlots(cfg), nil, nil)
+	// Season weeks start 04/20 (opening Saturday), 04/27, 05/04, 05/11
+	s.assign(strategy.Game{Home: "Angels", Away: "Cubs"}, Slot{Date: mustDate("2026-04-28"), Time: "17:45", Field: "Symonds Field"})
+
+	t.Run("runs of weeks without games", func(t *testing.T) {
+		runs := s.byeRuns("Angels")
+		if len(runs) != 2 || len(runs[0]) != 1 || len(runs[1]) != 2 {
+			t.Fatalf("byeRuns = %v, want [[04/20] [05/04 05/11]]", runs)
+		}
+		if !runs[1][0].Equal(mustDate("2026-05-04")) {
+			t.Errorf("second run starts %s, want 05/04", runs[1][0].Format("01/02"))
+		}
+		if runs := s.byeRuns("Astros"); len(runs) != 1 || len(runs[0]) != 4 {
+			t.Errorf("Astros byeRuns = %v, want one run of 4 weeks", runs)
+		}
+	})
+
+	t.Run("metrics list bye weeks and warn on long runs", func(t *testing.T) {
+		warnings, metrics := s.buildMetrics()
+		if n := len(metrics["Angels"].ByeWeeks); n != 3 {
+			t.Errorf("Angels ByeWeeks = %d, want 3", n)
+		}
+		found := false
+		for _, w := range warnings {
+			if w.Message == "Astros has 4 straight bye weeks (max 2): weeks of 04/20 through 05/11" {
+				found = true
+			}
+			if strings.HasPrefix(w.Message, "Angels has") {
+				t.Errorf("unexpected warning for Angels: %s", w)
+			}
+		}
+		if !found {
+			t.Errorf("missing bye warning for Astros in %v", warnings)
+		}
+	})
+
+	t.Run("scoreSlot prefers weeks the team hasn't played", func(t *testing.T) {
+		game := strategy.Game{Home: "Angels", Away: "Astros"}
+		sameWeek := s.scoreSlot(game, Slot{Date: mustDate("2026-04-30"), Time: "17:45", Field: "Symonds Field"})
+		nextWeek := s.scoreSlot(game, Slot{Date: mustDate("2026-05-04"), Time: "17:45", Field: "Symonds Field"})
+		if sameWeek <= nextWeek {
+			t.Errorf("same week score %.2f, want more than next week %.2f", sameWeek, nextWeek)
+		}
+	})
+}
+
+func TestScheduleBalancedByes(t *testing.T) {
+	cfg := schedulerTestConfig()
+	cfg.Guidelines.MaxConsecutiveByeWeeks = 1
+	games := (&strategy.DivisionWeighted{}).GenerateMatchups(cfg.Divisions)
+
+	result, err := Schedule(cfg, GenerateSlots(cfg), nil, games)
+	if err != nil {
+		t.Fatalf("Schedule() error: %v", err)
+	}
+	if len(result.Assignments) != len(games) {
+		t.Errorf("scheduled %d games, want %d", len(result.Assignments), len(games))
+	}
+	for team, m := range result.TeamMetrics {
+		if len(m.ByeWeeks) > 0 {
+			t.Errorf("%s has bye weeks %v; every team should play weekly", team, m.ByeWeeks)
+		}
+	}
+}
+
+func TestScheduleTargetEndDate(t *testing.T) {
+	games := (&strategy.DivisionWeighted{}).GenerateMatchups(schedulerTestConfig().Divisions)
+	target := date(2026, 5, 21)
+	lateGames := func(result *Result) int {
+		n := 0
+		for _, a := range result.Assignments {
+			if a.Slot.Date.After(target.Time) {
+				n++
+			}
+		}
+		return n
+	}
+
+	cfg := schedulerTestConfig()
+	baseline, err := Schedule(cfg, GenerateSlots(cfg), nil, games)
+	if err != nil {
+		t.Fatalf("Schedule() error: %v", err)
+	}
+
+	cfg.Season.TargetEndDate = &target
+	result, err := Schedule(cfg, GenerateSlots(cfg), nil, games)
+	if err != nil {
+		t.Fatalf("Schedule() error: %v", err)
+	}
+
+	t.Run("fewer games after the target", func(t *testing.T) {
+		if got, was := lateGames(result), lateGames(baseline); got >= was {
+			t.Errorf("%d games after target, want fewer than %d without it", got, was)
+		}
+	})
+
+	t.Run("reports the last game date", func(t *testing.T) {
+		var last time.Time
+		for _, a := range result.Assignments {
+			if a.Slot.Date.After(last) {
+				last = a.Slot.Date
+			}
+		}
+		if !result.LastGameDate.Equal(last) {
+			t.Errorf("LastGameDate = %s, want %s", result.LastGameDate.Format("01/02"), last.Format("01/02"))
+		}
+	})
+}
+
+func TestScheduleIntraDivisionFirst(t *testing.T) {
+	cfg := schedulerTestConfig()
+	cfg.Guidelines.IntraDivisionFirst = true
+	games := (&strategy.DivisionWeighted{}).GenerateMatchups(cfg.Divisions)
+
+	result, err := Schedule(cfg, GenerateSlots(cfg), nil, games)
+	if err != nil {
+		t.Fatalf("Schedule() error: %v", err)
+	}
+
+	t.Run("all games scheduled", func(t *testing.T) {
+		if len(result.Assignments) != len(games) {
+			t.Errorf("scheduled %d games, want %d", len(result.Assignments), len(games))
+		}
+	})
+
+	t.Run("reports each division then inter-division", func(t *testing.T) {
+		want := []string{"American", "National", "Inter-division"}
+		if len(result.Phases) != len(want) {
+			t.Fatalf("Phases = %+v, want %v", result.Phases, want)
+		}
+		scheduled := 0
+		for i, p := range result.Phases {
+			if p.Name != want[i] {
+				t.Errorf("phase %d = %q, want %q", i, p.Name, want[i])
+			}
+			scheduled += p.Scheduled
+		}
+		if scheduled != len(games) {
+			t.Errorf("phases scheduled %d games, want %d", scheduled, len(games))
+		}
+		if p := result.Phases[0]; p.Games != 20 {
+			t.Errorf("American phase attempted %d games, want 20 intra-division games", p.Games)
+		}
+	})
+
+	t.Run("every team plays every Saturday", func(t *testing.T) {
+		saturdays := len(slotDatesFor(GenerateSlots(cfg), time.Saturday))
+		for team, m := range result.TeamMetrics {
+			if m.Saturday != saturdays {
+				t.Errorf("%s plays %d of %d Saturdays", team, m.Saturday, saturdays)
+			}
+		}
+	})
+
+	t.Run("no phases reported by default", func(t *testing.T) {
+		cfg := schedulerTestConfig()
+		result, err := Schedule(cfg, GenerateSlots(cfg), nil, games)
+		if err != nil {
+			t.Fatalf("Schedule() error: %v", err)
+		}
+		if len(result.Phases) != 0 {
+			t.Errorf("Phases = %+v, want none", result.Phases)
+		}
+	})
+}
+
+func slotDatesFor(slots []Slot, day time.Weekday) map[time.Time]bool {
+	dates := make(map[time.Time]bool)
+	for _, s := range slots {
+		if s.Date.Weekday() == day {
+			dates[s.Date] = true
+		}
+	}
+	return dates
+}
+
+func TestScheduleHomeField(t *testing.T) {
+	games := (&strategy.DivisionWeighted{}).GenerateMatchups(schedulerTestConfig().Divisions)
+
+	t.Run("home games stay on the home field", func(t *testing.T) {
+		cfg := schedulerTestConfig()
+		cfg.Teams = []config.Team{{Name: "Angels", HomeField: "Symonds Field"}}
+		result, err := Schedule(cfg, GenerateSlots(cfg), nil, games)
+		if err != nil {
+			t.Fatalf("Schedule() error: %v", err)
+		}
+		for _, a := range result.Assignments {
+			if a.Game.Home == "Angels" && a.Slot.Field != "Symonds Field" {
+				t.Errorf("Angels home game on %s %s at %s", a.Slot.Date.Format("01/02"), a.Slot.Time, a.Slot.Field)
+			}
+		}
+	})
+
+	t.Run("failure names pinned teams", func(t *testing.T) {
+		cfg := schedulerTestConfig()
+		cfg.Fields[1].Reservations = []config.Reservation{
+			{StartDate: &cfg.Season.StartDate, EndDate: &cfg.Season.EndDate, Reason: "Varsity"},
+		}
+		cfg.Teams = []config.Team{{Name: "Angels", HomeField: "Symonds Field"}}
+		_, err := Schedule(cfg, GenerateSlots(cfg), nil, games)
+		if err == nil {
+			t.Fatal("expected failure with the home field reserved all season")
+		}
+		if !strings.Contains(err.Error(), "home game(s) could not fit on Symonds Field") {
+			t.Errorf("error does not explain home_field:\n%s", err)
+		}
+	})
+}
+
+func TestScheduleExcludedFields(t *testing.T) {
+	cfg := schedulerTestConfig()
+	cfg.Teams = []config.Team{{Name: "Royals", ExcludedFields: []string{"Washington Park"}}}
+
+	t.Run("hardConstraintCheck rejects home and away games", func(t *testing.T) {
+		s := newScheduler(cfg, nil, nil, nil)
+		slot := Slot{Date: mustDate("2026-05-04"), Time: "17:45", Field: "Washington Park"}
+		for _, game := range []strategy.Game{{Home: "Royals", Away: "Cubs"}, {Home: "Cubs", Away: "Royals"}} {
+			if reason, ok := s.hardConstraintCheck(game, slot); ok || reason != rejectExcludedField {
+				t.Errorf("%s @ %s: hardConstraintCheck = (%v, %v), want rejectExcludedField", game.Away, game.Home, reason, ok)
+			}
+		}
+		slot.Field = "Symonds Field"
+		if _, ok := s.hardConstraintCheck(strategy.Game{Home: "Royals", Away: "Cubs"}, slot); !ok {
+			t.Error("expected Symonds Field to be allowed")
+		}
+	})
+
+	t.Run("no games on the excluded field", func(t *testing.T) {
+		games := (&strategy.DivisionWeighted{}).GenerateMatchups(cfg.Divisions)
+		result, err := Schedule(cfg, GenerateSlots(cfg), nil, games)
+		if err != nil {
+			t.Fatalf("Schedule() error: %v", err)
+		}
+		for _, a := range result.Assignments {
+			if (a.Game.Home == "Royals" || a.Game.Away == "Royals") && a.Slot.Field == "Washington Park" {
+				t.Errorf("%s @ %s on %s at Washington Park", a.Game.Away, a.Game.Home, a.Slot.Date.Format("01/02"))
+			}
+		}
+	})
+}
+
+func TestScheduleMaxHomeGamesPerWeek(t *testing.T) {
+	cfg := schedulerTestConfig()
+	cfg.Rules.MaxHomeGamesPerWeek = 1
+
+	t.Run("hardConstraintCheck rejects a second home game in the week", func(t *testing.T) {
+		s := newScheduler(cfg, nil, nil, nil)
+		s.assign(strategy.Game{Home: "Angels", Away: "Cubs"}, Slot{Date: mustDate("2026-05-04"), Time: "17:45", Field: "Symonds Field"})
+		slot := Slot{Date: mustDate("2026-05-07"), Time: "17:45", Field: "Symonds Field"}
+		if reason, ok := s.hardConstraintCheck(strategy.Game{Home: "Angels", Away: "Padres"}, slot); ok || reason != rejectMaxWeekHomeGames {
+			t.Errorf("hardConstraintCheck = (%v, %v), want rejectMaxWeekHomeGames", reason, ok)
+		}
+		if _, ok := s.hardConstraintCheck(strategy.Game{Home: "Padres", Away: "Angels"}, slot); !ok {
+			t.Error("expected an away game for the Angels to be allowed")
+		}
+		slot.Date = mustDate("2026-05-11")
+		if _, ok := s.hardConstraintCheck(strategy.Game{Home: "Angels", Away: "Padres"}, slot); !ok {
+			t.Error("expected a home game the next week to be allowed")
+		}
+	})
+
+	t.Run("no team hosts more than the cap in a week", func(t *testing.T) {
+		cfg.Rules.MaxHomeGamesPerWeek = 2
+		games := (&strategy.DivisionWeighted{}).GenerateMatchups(cfg.Divisions)
+		result, err := Schedule(cfg, GenerateSlots(cfg), nil, games)
+		if err != nil {
+			t.Fatalf("Schedule() error: %v", err)
+		}
+		hosted := make(map[string]int)
+		for _, a := range result.Assignments {
+			_, week := a.Slot.Date.ISOWeek()
+			key := fmt.Sprintf("%s/%d", a.Game.Home, week)
+			if hosted[key]++; hosted[key] > 2 {
+				t.Errorf("%s hosts %d games in week %d", a.Game.Home, hosted[key], week)
+			}
+		}
+	})
+}
+
+func TestOptimizeRematchSpacing(t *testing.T) {
+	t.Run("penalty ranks the closest rematch first", func(t *testing.T) {
+		cfg := schedulerTestConfig()
+		attempt := func(second string) *scheduler {
+			s := newScheduler(cfg, nil, nil, nil)
+			s.assign(strategy.Game{Home: "Angels", Away: "Cubs"}, Slot{Date: mustDate("2026-05-02"), Time: "12:30", Field: "Symonds Field"})
+			s.assign(strategy.Game{Home: "Cubs", Away: "Angels"}, Slot{Date: mustDate(second), Time: "17:45", Field: "Symonds Field"})
+			return s
+		}
+		near, far := attempt("2026-05-05"), attempt("2026-05-20")
+		if near.rematchSpacingPenalty() <= far.rematchSpacingPenalty() {
+			t.Errorf("penalty for a 3-day rematch (%v) should exceed an 18-day one (%v)",
+				near.rematchSpacingPenalty(), far.rematchSpacingPenalty())
+		}
+		if r := near.closestRematch(); r == nil || r.Days != 3 || r.Teams != [2]string{"Angels", "Cubs"} {
+			t.Errorf("closestRematch() = %+v, want Angels and Cubs 3 days apart", r)
+		}
+	})
+
+	t.Run("spreads rematches at least as well as the default", func(t *testing.T) {
+		cfg := schedulerTestConfig()
+		games := (&strategy.DivisionWeighted{}).GenerateMatchups(cfg.Divisions)
+		balanced, err := Schedule(cfg, GenerateSlots(cfg), nil, games)
+		if err != nil {
+			t.Fatalf("Schedule() error: %v", err)
+		}
+		cfg.Guidelines.Optimize = config.OptimizeRematchSpacing
+		spaced, err := Schedule(cfg, GenerateSlots(cfg), nil, games)
+		if err != nil {
+			t.Fatalf("Schedule() error: %v", err)
+		}
+		if balanced.Rematch == nil || spaced.Rematch == nil {
+			t.Fatalf("Rematch = %v and %v, want both set", balanced.Rematch, spaced.Rematch)
+		}
+		if spaced.Rematch.Days < balanced.Rematch.Days {
+			t.Errorf("closest rematch with rematch-spacing = %d days, want at least the default's %d",
+				spaced.Rematch.Days, balanced.Rematch.Days)
+		}
+	})
+}
+
+func TestScheduleDiagnostics(t *testing.T) {
+	t.Run("populated on success", func(t *testing.T) {
+		cfg := schedulerTestConfig()
+		games := (&strategy.DivisionWeighted{}).GenerateMatchups(cfg.Divisions)
+		result, err := Schedule(cfg, GenerateSlots(cfg), nil, games)
+		if err != nil {
+			t.Fatalf("Schedule() error: %v", err)
+		}
+		d := result.Diagnostics
+		if d.Attempts != scheduleAttempts || d.Succeeded < 1 || d.Succeeded > d.Attempts {
+			t.Errorf("Diagnostics = %d of %d attempts succeeded, want 1 to %d", d.Succeeded, d.Attempts, scheduleAttempts)
+		}
+		if d.SoftScore < 0 {
+			t.Errorf("SoftScore = %v, want 0 or more", d.SoftScore)
+		}
+		if d.Rejections[rejectSlotUsed.String()] == 0 {
+			t.Errorf("Rejections = %v, want some %q", d.Rejections, rejectSlotUsed)
+		}
+	})
+
+	t.Run("populated on failure", func(t *testing.T) {
+		cfg := schedulerTestConfig()
+		games := (&strategy.DivisionWeighted{}).GenerateMatchups(cfg.Divisions)
+		result, err := Schedule(cfg, GenerateSlots(cfg)[:20], nil, games)
+		if err == nil {
+			t.Fatal("expected an error with too few slots")
+		}
+		if d := result.Diagnostics; d.Attempts != scheduleAttempts || d.Succeeded != 0 || len(d.Rejections) == 0 {
+			t.Errorf("Diagnostics = %+v, want %d attempts, none succeeding, with rejections", d, scheduleAttempts)
+		}
+	})
+}
+
+func TestScheduleMaxSaturdayGames(t *testing.T) {
+	cfg := schedulerTestConfig()
+	cfg.Teams = []config.Team{{Name: "Angels", MaxSaturdayGames: 2}}
+
+	t.Run("hardConstraintCheck rejects Saturdays past the cap", func(t *testing.T) {
+		s := newScheduler(cfg, nil, nil, nil)
+		s.assign(strategy.Game{Home: "Angels", Away: "Cubs"}, Slot{Date: mustDate("2026-05-02"), Time: "12:30", Field: "Symonds Field"})
+		s.assign(strategy.Game{Home: "Padres", Away: "Angels"}, Slot{Date: mustDate("2026-05-09"), Time: "12:30", Field: "Symonds Field"})
+		saturday := Slot{Date: mustDate("2026-05-16"), Time: "12:30", Field: "Symonds Field"}
+		if reason, ok := s.hardConstraintCheck(strategy.Game{Home: "Astros", Away: "Angels"}, saturday); ok || reason != rejectMaxSaturdayGames {
+			t.Errorf("hardConstraintCheck = (%v, %v), want rejectMaxSaturdayGames", reason, ok)
+		}
+		if _, ok := s.hardConstraintCheck(strategy.Game{Home: "Astros", Away: "Cubs"}, saturday); !ok {
+			t.Error("expected a Saturday game for uncapped teams to be allowed")
+		}
+		weekday := Slot{Date: mustDate("2026-05-12"), Time: "17:45", Field: "Symonds Field"}
+		if _, ok := s.hardConstraintCheck(strategy.Game{Home: "Astros", Away: "Angels"}, weekday); !ok {
+			t.Error("expected a weekday game for the Angels to be allowed")
+		}
+	})
+
+	t.Run("the capped team plays at most its Saturdays", func(t *testing.T) {
+		games := (&strategy.DivisionWeighted{}).GenerateMatchups(cfg.Divisions)
+		result, err := Schedule(cfg, GenerateSlots(cfg), nil, games)
+		if err != nil {
+			t.Fatalf("Schedule() error: %v", err)
+		}
+		if n := result.TeamMetrics["Angels"].Saturday; n > 2 {
+			t.Errorf("Angels play %d Saturdays, want at most 2", n)
+		}
+		if !result.TeamMetrics["Angels"].SaturdayCapReached {
+			t.Error("expected the Angels' metrics to show the Saturday cap reached")
+		}
+		if result.TeamMetrics["Astros"].SaturdayCapReached {
+			t.Error("expected no Saturday cap for the uncapped Astros")
+		}
+		for _, w := range result.Warnings {
+			if w.Category == WarningSaturdayFloor && w.Teams[0] == "Angels" {
+				t.Errorf("unexpected Saturday floor warning for the capped Angels: %v", w)
+			}
+		}
+	})
+}
+
+func TestScheduleMaxDistinctFields(t *testing.T) {
+	cfg := schedulerTestConfig()
+	cfg.Teams = []config.Team{{Name: "Angels", MaxDistinctFields: 1}}
+	played := Slot{Date: mustDate("2026-05-02"), Time: "12:30", Field: "Symonds Field"}
+	same := Slot{Date: mustDate("2026-05-05"), Time: "17:45", Field: "Symonds Field"}
+	other := Slot{Date: mustDate("2026-05-05"), Time: "17:45", Field: "Washington Park"}
+	game := strategy.Game{Home: "Astros", Away: "Angels"}
+
+	t.Run("scoreSlot prefers a field the team has played at", func(t *testing.T) {
+		s := newScheduler(cfg, nil, nil, nil)
+		s.assign(strategy.Game{Home: "Angels", Away: "Cubs"}, played)
+		if s.scoreSlot(game, other) <= s.scoreSlot(game, same) {
+			t.Error("expected a new field past the cap to score worse")
+		}
+		if _, ok := s.hardConstraintCheck(game, other); !ok {
+			t.Error("expected a new field to be allowed while the cap is a guideline")
+		}
+	})
+
+	t.Run("hardConstraintCheck rejects a new field when enforced", func(t *testing.T) {
+		enforced := *cfg
+		enforced.Rules.EnforceMaxDistinctFields = true
+		s := newScheduler(&enforced, nil, nil, nil)
+		s.assign(strategy.Game{Home: "Angels", Away: "Cubs"}, played)
+		if reason, ok := s.hardConstraintCheck(game, other); ok || reason != rejectDistinctFields {
+			t.Errorf("hardConstraintCheck = (%v, %v), want rejectDistinctFields", reason, ok)
+		}
+		if _, ok := s.hardConstraintCheck(game, same); !ok {
+			t.Error("expected a field the Angels have played at to be allowed")
+		}
+	})
+
+	t.Run("metrics count fields and warn past the cap", func(t *testing.T) {
+		s := newScheduler(cfg, nil, nil, nil)
+		s.assign(strategy.Game{Home: "Angels", Away: "Cubs"}, played)
+		s.assign(game, other)
+		warnings, metrics := s.buildMetrics()
+		if metrics["Angels"].Fields != 2 {
+			t.Errorf("Angels Fields = %d, want 2", metrics["Angels"].Fields)
+		}
+		if !slices.ContainsFunc(warnings, func(w Warning) bool {
+			return w.Category == WarningDistinctFields && slices.Equal(w.Teams, []string{"Angels"})
+		}) {
+			t.Errorf("warnings = %v, want a distinct-fields warning for the Angels", warnings)
+		}
+		s.unassign(len(s.assignments) - 1)
+		if _, metrics := s.buildMetrics(); metrics["Angels"].Fields != 1 {
+			t.Errorf("after unassign, Angels Fields = %d, want 1", metrics["Angels"].Fields)
+		}
+	})
+}
+
+func TestScheduleMaxGamesPerDate(t *testing.T) {
+	cfg := schedulerTestConfig()
+	cfg.Rules.MaxGamesPerDate = 2
+
+	t.Run("hardConstraintCheck rejects a third game on the date", func(t *testing.T) {
+		s := newScheduler(cfg, nil, nil, nil)
+		s.assign(strategy.Game{Home: "Angels", Away: "Cubs"}, Slot{Date: mustDate("2026-05-02"), Time: "12:30", Field: "Symonds Field"})
+		s.assign(strategy.Game{Home: "Astros", Away: "Padres"}, Slot{Date: mustDate("2026-05-02"), Time: "14:45", Field: "Washington Park"})
+		slot := Slot{Date: mustDate("2026-05-02"), Time: "17:00", Field: "Moscariello Ballpark"}
+		if reason, ok := s.hardConstraintCheck(strategy.Game{Home: "Athletics", Away: "Phillies"}, slot); ok || reason != rejectDateCap {
+			t.Errorf("hardConstraintCheck = (%v, %v), want rejectDateCap", reason, ok)
+		}
+		slot.Date = mustDate("2026-05-03")
+		if _, ok := s.hardConstraintCheck(strategy.Game{Home: "Athletics", Away: "Phillies"}, slot); !ok {
+			t.Error("expected a game the next day to be allowed")
+		}
+	})
+
+	t.Run("no date holds more than the cap", func(t *testing.T) {
+		cfg.Rules.MaxGamesPerDate = 4
+		games := (&strategy.DivisionWeighted{}).GenerateMatchups(cfg.Divisions)
+		result, _ := Schedule(cfg, GenerateSlots(cfg), nil, games)
+		perDate := make(map[time.Time]int)
+		for _, a := range result.Assignments {
+			if perDate[a.Slot.Date]++; perDate[a.Slot.Date] > 4 {
+				t.Errorf("%d games on %s", perDate[a.Slot.Date], a.Slot.Date.Format("01/02"))
+			}
+		}
+	})
+}
+
+func TestPreferredWeekdayOrder(t *testing.T) {
+	game := strategy.Game{Home: "Angels", Away: "Cubs"}
+	monday := Slot{Date: mustDate("2026-05-04"), Time: "17:45", Field: "Symonds Field"}
+	friday := Slot{Date: mustDate("2026-05-08"), Time: "17:45", Field: "Symonds Field"}
+
+	t.Run("earlier weekday wins without a preference", func(t *testing.T) {
+		s := newScheduler(schedulerTestConfig(), nil, nil, nil)
+		if mon, fri := s.scoreSlot(game, monday), s.scoreSlot(game, friday); mon >= fri {
+			t.Errorf("Monday %.2f, Friday %.2f; want Monday lower", mon, fri)
+		}
+	})
+
+	t.Run("preferred weekday wins within the week", func(t *testing.T) {
+		cfg := schedulerTestConfig()
+		cfg.Guidelines.PreferredWeekdayOrder = []string{"friday", "thursday"}
+		s := newScheduler(cfg, nil, nil, nil)
+		if mon, fri := s.scoreSlot(game, monday), s.scoreSlot(game, friday); fri >= mon {
+			t.Errorf("Monday %.2f, Friday %.2f; want Friday lower", mon, fri)
+		}
+		saturday := Slot{Date: mustDate("2026-05-09"), Time: "17:00", Field: "Symonds Field"}
+		plain := newScheduler(schedulerTestConfig(), nil, nil, nil)
+		if got, want := s.scoreSlot(game, saturday), plain.scoreSlot(game, saturday); got != want {
+			t.Errorf("Saturday score = %.2f, want %.2f: weekends are unaffected", got, want)
+		}
+	})
+
+	t.Run("metrics count games per weekday", func(t *testing.T) {
+		s := newScheduler(schedulerTestConfig(), nil, nil, nil)
+		s.assign(game, monday)
+		s.assign(strategy.Game{Home: "Cubs", Away: "Angels"}, friday)
+		_, metrics := s.buildMetrics()
+		if got := metrics["Angels"].Weekdays; got["Monday"] != 1 || got["Friday"] != 1 || len(got) != 2 {
+			t.Errorf("Angels weekdays = %v, want one Monday and one Friday", got)
+		}
+	})
+}
+
+func TestMinSaturdayGames(t *testing.T) {
+	cfg := schedulerTestConfig()
+	s := newScheduler(cfg, GenerateSlots(cfg), nil, nil)
+	s.assign(strategy.Game{Home: "Angels", Away: "Cubs"}, Slot{Date: mustDate("2026-05-02"), Time: "12:30", Field: "Symonds Field"})
+	s.assign(strategy.Game{Home: "Angels", Away: "Padres"}, Slot{Date: mustDate("2026-05-09"), Time: "12:30", Field: "Symonds Field"})
+
+	t.Run("defaults to every Saturday", func(t *testing.T) {
+		if got, want := s.minSaturdayGames(), len(s.slotDates(time.Saturday)); got != want {
+			t.Errorf("minSaturdayGames() = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("floor lowers the Saturday penalty", func(t *testing.T) {
+		every := s.softScore()
+		cfg.Guidelines.MinSaturdayGames = 2
+		floor := s.softScore()
+		if floor >= every {
+			t.Errorf("softScore with floor = %.1f, want less than %.1f", floor, every)
+		}
+	})
+
+	t.Run("teams under the floor are reported", func(t *testing.T) {
+		cfg.Guidelines.MinSaturdayGames = 2
+		warnings, metrics := s.buildMetrics()
+		if v := metrics["Angels"].Violations; len(v) != 0 {
+			t.Errorf("Angels violations = %v, want none at the floor", v)
+		}
+		found := false
+		for _, w := range warnings {
+			if w.Message == "Cubs plays 1 Saturday games (min 2)" {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("missing Saturday floor warning for Cubs in %v", warnings)
+		}
+	})
+}
+
+func TestBalanceDivisionTimeslots(t *testing.T) {
+	cfg := schedulerTestConfig()
+	cfg.Guidelines.BalanceDivisionTimeslots = true
+	s := newScheduler(cfg, nil, nil, nil)
+	sat := mustDate("2026-05-02")
+	s.assign(strategy.Game{Home: "Angels", Away: "Astros"}, Slot{Date: sat, Time: "12:30", Field: "Symonds Field"})
+
+	t.Run("scoreSlot prefers sharing the timeslot across divisions", func(t *testing.T) {
+		slot := Slot{Date: sat, Time: "12:30", Field: "Washington Park"}
+		same := s.scoreSlot(strategy.Game{Home: "Royals", Away: "Mariners"}, slot)
+		other := s.scoreSlot(strategy.Game{Home: "Cubs", Away: "Padres"}, slot)
+		if same <= other {
+			t.Errorf("same-division score %.2f, want more than cross-division %.2f", same, other)
+		}
+	})
+
+	t.Run("monopolized timeslots are counted and reported", func(t *testing.T) {
+		s.assign(strategy.Game{Home: "Royals", Away: "Mariners"}, Slot{Date: sat, Time: "12:30", Field: "Washington Park"})
+		s.assign(strategy.Game{Home: "Athletics", Away: "Angels"}, Slot{Date: sat, Time: "14:45", Field: "Symonds Field"})
+		s.assign(strategy.Game{Home: "Astros", Away: "Royals"}, Slot{Date: sat, Time: "14:45", Field: "Washington Park"})
+		s.assign(strategy.Game{Home: "Cubs", Away: "Mariners"}, Slot{Date: sat, Time: "17:00", Field: "Symonds Field"})
+		s.assign(strategy.Game{Home: "Padres", Away: "Pirates"}, Slot{Date: sat, Time: "17:00", Field: "Washington Park"})
+
+		alone := s.monopolizedTimeslots()
+		if alone["American"] != 2 || alone["National"] != 0 {
+			t.Errorf("monopolized = %v, want American 2, National 0", alone)
+		}
+		warnings, _ := s.buildMetrics()
+		found := false
+		for _, w := range warnings {
+			if strings.HasPrefix(w.Message, "Division timeslot imbalance") {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("missing division timeslot warning in %v", warnings)
+		}
+	})
+
+	t.Run("unassign keeps division counts", func(t *testing.T) {
+		s.unassign(0)
+		if n := s.timeDivCnt[timeDivKey{sat, "12:30", "American"}]; n != 1 {
+			t.Errorf("American games at 12:30 = %d, want 1", n)
+		}
+	})
+}
+
+func TestScheduleSingleDivision(t *testing.T) {
+	for _, teams := range [][]string{
+		{"Angels", "Astros", "Athletics", "Mariners", "Royals", "Twins"},
+		{"Angels", "Astros", "Athletics", "Mariners", "Royals"},
+	} {
+		t.Run(fmt.Sprintf("%d teams", len(teams)), func(t *testing.T) {
+			cfg := schedulerTestConfig()
+			cfg.Divisions = []config.Division{{Name: "League", Teams: teams}}
+			games := (&strategy.DivisionWeighted{}).GenerateMatchups(cfg.Divisions)
+
+			result, err := Schedule(cfg, GenerateSlots(cfg), nil, games)
+			if err != nil {
+				t.Fatalf("Schedule() error: %v", err)
+			}
+			if len(result.Assignments) != len(games) {
+				t.Errorf("scheduled %d games, want %d", len(result.Assignments), len(games))
+			}
+
+			want := 2 * (len(teams) - 1)
+			for _, team := range teams {
+				m := result.TeamMetrics[team]
+				if m.Games != want || m.Home != want/2 || m.Away != want/2 {
+					t.Errorf("%s: %d games (%d home, %d away), want %d split evenly", team, m.Games, m.Home, m.Away, want)
+				}
+			}
+
+			perDay := make(map[string]int)
+			for _, a := range result.Assignments {
+				for _, team := range []string{a.Game.Home, a.Game.Away} {
+					key := team + a.Slot.Date.Format("01/02")
+					if perDay[key]++; perDay[key] > cfg.Rules.MaxGamesPerDayPerTeam {
+						t.Errorf("%s plays twice on %s", team, a.Slot.Date.Format("01/02"))
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestScheduleSingleDivisionIntraFirst(t *testing.T) {
+	cfg := schedulerTestConfig()
+	cfg.Divisions = []config.Division{{Name: "League", Teams: []string{"Angels", "Astros", "Cubs", "Padres"}}}
+	cfg.Guidelines.IntraDivisionFirst = true
+	games := (&strategy.DivisionWeighted{}).GenerateMatchups(cfg.Divisions)
+
+	result, err := Schedule(cfg, GenerateSlots(cfg), nil, games)
+	if err != nil {
+		t.Fatalf("Schedule() error: %v", err)
+	}
+	if len(result.Phases) != 1 || result.Phases[0].Name != "League" || result.Phases[0].Scheduled != len(games) {
+		t.Errorf("Phases = %+v, want a single League pass with all %d games", result.Phases, len(games))
+	}
+}
+
+func TestSameFieldWeekPenalty(t *testing.T) {
+	cfg := schedulerTestConfig()
+	cfg.Guidelines.SameFieldWeekPenalty = 10
+	s := newScheduler(cfg, GenerateSlots(cfg), nil, nil)
+	mon, tue := mustDate("2026-04-27"), mustDate("2026-04-28")
+	s.assign(strategy.Game{Home: "Angels", Away: "Astros"}, Slot{Date: mon, Time: "17:45", Field: "Symonds Field"})
+
+	t.Run("scoreSlot penalizes a repeated field in the same week", func(t *testing.T) {
+		game := strategy.Game{Home: "Angels", Away: "Royals"}
+		same := s.scoreSlot(game, Slot{Date: tue, Time: "17:45", Field: "Symonds Field"})
+		other := s.scoreSlot(game, Slot{Date: tue, Time: "17:45", Field: "Washington Park"})
+		if diff := same - other; diff < 9.5 || diff > 10.5 {
+			t.Errorf("same field score %.2f, other field %.2f, want about 10 more", same, other)
+		}
+	})
+
+	t.Run("a new week starts fresh", func(t *testing.T) {
+		game := strategy.Game{Home: "Angels", Away: "Royals"}
+		next := mustDate("2026-05-05")
+		same := s.scoreSlot(game, Slot{Date: next, Time: "17:45", Field: "Symonds Field"})
+		other := s.scoreSlot(game, Slot{Date: next, Time: "17:45", Field: "Washington Park"})
+		if diff := same - other; diff < -0.5 || diff > 0.5 {
+			t.Errorf("same field score %.2f, want about %.2f in a new week", same, other)
+		}
+	})
+
+	t.Run("three games at one field in a week are reported", func(t *testing.T) {
+		s.assign(strategy.Game{Home: "Royals", Away: "Angels"}, Slot{Date: tue, Time: "17:45", Field: "Symonds Field"})
+		s.assign(strategy.Game{Home: "Angels", Away: "Mariners"}, Slot{Date: mustDate("2026-05-01"), Time: "17:45", Field: "Symonds Field"})
+		_, metrics := s.buildMetrics()
+		want := "Angels plays 3 games at Symonds Field in the week of 04/27"
+		found := false
+		for _, v := range metrics["Angels"].Violations {
+			if v == want {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Angels violations = %v, want %q", metrics["Angels"].Violations, want)
+		}
+		if len(metrics["Astros"].Violations) != 0 {
+			t.Errorf("Astros violations = %v, want none", metrics["Astros"].Violations)
+		}
+	})
+
+	t.Run("unassign keeps field counts", func(t *testing.T) {
+		s.unassign(len(s.assignments) - 1)
+		if n := s.weekFields[weekFieldKey{"Angels", mon, "Symonds Field"}]; n != 2 {
+			t.Errorf("Angels Symonds Field count = %d, want 2", n)
+		}
+	})
+}
+
+func TestTimeslotSkew(t *testing.T) {
+	cfg := schedulerTestConfig()
+	s := newScheduler(cfg, GenerateSlots(cfg), nil, nil)
+	saturdays := []string{"2026-04-25", "2026-05-02", "2026-05-09", "2026-05-16"}
+	for i, d := range saturdays {
+		s.assign(strategy.Game{Home: "Angels", Away: "Astros"}, Slot{Date: mustDate(d), Time: "17:00", Field: "Symonds Field"})
+		s.assign(strategy.Game{Home: "Cubs", Away: "Padres"}, Slot{Date: mustDate(d), Time: cfg.TimeSlots.Saturday[i%3], Field: "Washington Park"})
+	}
+	for _, d := range []string{"2026-04-27", "2026-04-28", "2026-04-29", "2026-04-30"} {
+		s.assign(strategy.Game{Home: "Royals", Away: "Mariners"}, Slot{Date: mustDate(d), Time: "17:45", Field: "Symonds Field"})
+	}
+
+	tests := []struct {
+		team   string
+		skewed bool
+	}{
+		{"Angels", true},     // every Saturday at 17:00
+		{"Cubs", false},      // Saturday times rotate
+		{"Royals", false},    // weekdays offer only one time
+		{"Athletics", false}, // no games
+	}
+	for _, tt := range tests {
+		t.Run(tt.team, func(t *testing.T) {
+			sk, ok := s.timeslotSkew(tt.team)
+			if ok != tt.skewed {
+				t.Fatalf("timeslotSkew(%s) = %+v, %v, want skewed %v", tt.team, sk, ok, tt.skewed)
+			}
+			if ok && (sk.time != "17:00" || sk.games != 4 || sk.total != 4) {
+				t.Errorf("timeslotSkew(%s) = %+v, want 4 of 4 at 17:00", tt.team, sk)
+			}
+		})
+	}
+
+	t.Run("reported in metrics", func(t *testing.T) {
+		_, metrics := s.buildMetrics()
+		want := "Angels plays 4 of 4 games at 17:00 on days with a choice of times (expected about 1.3)"
+		found := false
+		for _, v := range metrics["Angels"].Violations {
+			if v == want {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Angels violations = %v, want %q", metrics["Angels"].Violations, want)
+		}
+		if metrics["Angels"].Times["17:00"] != 4 {
+			t.Errorf("Angels Times = %v, want 4 at 17:00", metrics["Angels"].Times)
+		}
+	})
+}
+
+func TestOpponentVariety(t *testing.T) {
+	cfg := schedulerTestConfig()
+	cfg.Guidelines.OpponentVariety = true
+	games := []strategy.Game{
+		{Home: "Angels", Away: "Astros"}, {Home: "Astros", Away: "Angels"},
+		{Home: "Angels", Away: "Royals"}, {Home: "Royals", Away: "Angels"},
+		{Home: "Angels", Away: "Mariners"}, {Home: "Mariners", Away: "Angels"},
+	}
+	s := newScheduler(cfg, GenerateSlots(cfg), nil, games)
+	s.assign(games[0], Slot{Date: mustDate("2026-04-27"), Time: "17:45", Field: "Symonds Field"})
+	s.assign(games[2], Slot{Date: mustDate("2026-04-29"), Time: "17:45", Field: "Symonds Field"})
+
+	t.Run("games since the last meeting", func(t *testing.T) {
+		gap, ok := s.gamesSinceOpponent("Angels", "Astros", mustDate("2026-05-01"))
+		if !ok || gap != 1 {
+			t.Errorf("gamesSinceOpponent = %d, %v, want 1 game", gap, ok)
+		}
+		if _, ok := s.gamesSinceOpponent("Angels", "Mariners", mustDate("2026-05-01")); ok {
+			t.Error("Angels and Mariners haven't met")
+		}
+	})
+
+	t.Run("scoreSlot prefers a new opponent", func(t *testing.T) {
+		slot := Slot{Date: mustDate("2026-05-01"), Time: "17:45", Field: "Symonds Field"}
+		rematch := s.scoreSlot(games[1], slot)
+		fresh := s.scoreSlot(games[4], slot)
+		if rematch <= fresh {
+			t.Errorf("rematch score %.2f, want more than new opponent %.2f", rematch, fresh)
+		}
+	})
+
+	t.Run("metrics report the share of distinct early opponents", func(t *testing.T) {
+		s.assign(games[1], Slot{Date: mustDate("2026-05-01"), Time: "17:45", Field: "Symonds Field"})
+		_, metrics := s.buildMetrics()
+		// First three games: Astros, Royals, Astros
+		if got := metrics["Angels"].OpponentVariety; got < 0.66 || got > 0.67 {
+			t.Errorf("Angels OpponentVariety = %.3f, want 2/3", got)
+		}
+		// Astros' only opponent is the Angels, met first
+		if got := metrics["Astros"].OpponentVariety; got != 1 {
+			t.Errorf("Astros OpponentVariety = %.3f, want 1", got)
+		}
+	})
+}
+
+func TestFieldBuffer(t *testing.T) {
+	cfg := schedulerTestConfig()
+	cfg.Fields[1].MinMinutesBetweenGames = 30 // Symonds Field
+	s := newScheduler(cfg, GenerateSlots(cfg), nil, nil)
+	sat := mustDate("2026-05-02")
+	s.assign(strategy.Game{Home: "Angels", Away: "Astros"}, Slot{Date: sat, Time: "12:30", Field: "Symonds Field"})
+
+	tests := []struct {
+		name string
+		slot Slot
+		ok   bool
+	}{
+		{"next timeslot on the same field", Slot{Date: sat, Time: "14:45", Field: "Symonds Field"}, false},
+		{"two timeslots later", Slot{Date: sat, Time: "17:00", Field: "Symonds Field"}, true},
+		{"next timeslot on another field", Slot{Date: sat, Time: "14:45", Field: "Washington Park"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason, ok := s.hardConstraintCheck(strategy.Game{Home: "Cubs", Away: "Padres"}, tt.slot)
+			if ok != tt.ok {
+				t.Fatalf("hardConstraintCheck() ok = %v, want %v", ok, tt.ok)
+			}
+			if !ok && reason != rejectFieldBuffer {
+				t.Errorf("reason = %v, want %v", reason, rejectFieldBuffer)
+			}
+		})
+	}
+}
+
+func TestReserveOpenSlots(t *testing.T) {
+	cfg := schedulerTestConfig()
+	cfg.Season.ReserveOpenSlots = &config.ReserveOpenSlots{Count: 1, Per: "saturday"}
+	slots := GenerateSlots(cfg)
+	games := (&strategy.DivisionWeighted{}).GenerateMatchups(cfg.Divisions)
+
+	result, err := Schedule(cfg, slots, nil, games)
+	if err != nil {
+		t.Fatalf("Schedule() error: %v", err)
+	}
+	if len(result.HeldOpen) != 5 {
+		t.Errorf("HeldOpen = %d slots, want one per Saturday (5)", len(result.HeldOpen))
+	}
+	for _, a := range result.Assignments {
+		if slices.Contains(result.HeldOpen, a.Slot) {
+			t.Errorf("%s @ %s was scheduled in held-open slot %+v", a.Game.Away, a.Game.Home, a.Slot)
+		}
+	}
+}
+
+func TestOverflowStrategy(t *testing.T) {
+	var overflow []Slot
+	for _, day := range []string{"2026-06-01", "2026-06-02", "2026-06-03"} {
+		for _, field := range []string{"Moscariello Ballpark", "Symonds Field", "Washington Park"} {
+			overflow = append(overflow, Slot{Date: mustDate(day), Time: "17:45", Field: field})
+		}
+	}
+	games := []strategy.Game{
+		{Home: "Angels", Away: "Cubs"},
+		{Home: "Astros", Away: "Padres"},
+		{Home: "Royals", Away: "Marlins"},
+	}
+	tests := []struct {
+		strategy string
+		want     []OverflowDay
+	}{
+		{"", []OverflowDay{{mustDate("2026-06-01"), 2}, {mustDate("2026-06-02"), 1}}},
+		{config.OverflowEarliest, []OverflowDay{{mustDate("2026-06-01"), 2}, {mustDate("2026-06-02"), 1}}},
+		{config.OverflowSpread, []OverflowDay{{mustDate("2026-06-01"), 1}, {mustDate("2026-06-02"), 1}, {mustDate("2026-06-03"), 1}}},
+	}
+	for _, tt := range tests {
+		t.Run("strategy "+tt.strategy, func(t *testing.T) {
+			cfg := schedulerTestConfig()
+			cfg.Season.OverflowStrategy = tt.strategy
+			s := newScheduler(cfg, nil, overflow, games)
+			if left := s.scheduleOverflow(games); len(left) != 0 {
+				t.Fatalf("unscheduled = %v, want none", left)
+			}
+			if got := s.overflowDays(); !slices.Equal(got, tt.want) {
+				t.Errorf("overflow days = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExcludeOverflowFromScoring(t *testing.T) {
+	cfg := schedulerTestConfig()
+	overflowEnd := date(2026, 6, 7)
+	cfg.Season.OverflowEndDate = &overflowEnd
+	regular := Assignment{
+		Game: strategy.Game{Home: "Angels", Away: "Cubs"},
+		Slot: Slot{Date: mustDate("2026-05-30"), Time: "12:30", Field: "Symonds Field"},
+	}
+	overflow := Assignment{
+		Game: strategy.Game{Home: "Cubs", Away: "Angels"},
+		Slot: Slot{Date: mustDate("2026-06-01"), Time: "17:45", Field: "Symonds Field"},
+	}
+	build := func(exclude bool, assignments ...Assignment) *scheduler {
+		cfg := *cfg
+		cfg.Guidelines.ExcludeOverflowFromScoring = exclude
+		s := newScheduler(&cfg, nil, nil, nil)
+		for _, a := range assignments {
+			s.assign(a.Game, a.Slot)
+		}
+		return s
+	}
+
+	t.Run("overflow rematch counts by default", func(t *testing.T) {
+		s := build(false, regular, overflow)
+		if got, want := s.softScore(), build(false, regular).guidelineScore()+s.overflowPenalty(); got <= want {
+			t.Errorf("softScore() = %.1f, want more than %.1f for the 2-day rematch", got, want)
+		}
+	})
+
+	t.Run("excluded overflow only adds its penalty", func(t *testing.T) {
+		s := build(true, regular, overflow)
+		if got, want := s.softScore(), build(true, regular).guidelineScore()+s.overflowPenalty(); got != want {
+			t.Errorf("softScore() = %.1f, want %.1f", got, want)
+		}
+	})
+
+	t.Run("regular-season balance in metrics", func(t *testing.T) {
+		_, metrics := build(false, regular, overflow).buildMetrics()
+		want := Balance{Games: 1, Home: 1, Saturday: 1}
+		if b := metrics["Angels"].RegularSeason; b == nil || *b != want {
+			t.Errorf("Angels regular season = %+v, want %+v", b, want)
+		}
+		if metrics["Angels"].Games != 2 {
+			t.Errorf("Angels games = %d, want 2 including overflow", metrics["Angels"].Games)
+		}
+		if _, metrics := build(false, regular).buildMetrics(); metrics["Angels"].RegularSeason != nil {
+			t.Error("want no regular-season breakdown without overflow games")
+		}
+	})
+}
+
+func TestWeekendOnly(t *testing.T) {
+	cfg := schedulerTestConfig()
+	showcase := strategy.Game{Home: "Angels", Away: "Cubs", WeekendOnly: true}
+
+	t.Run("hardConstraintCheck rejects weekdays", func(t *testing.T) {
+		s := newScheduler(cfg, nil, nil, nil)
+		weekday := Slot{Date: mustDate("2026-05-05"), Time: "17:45", Field: "Symonds Field"}
+		if reason, ok := s.hardConstraintCheck(showcase, weekday); ok || reason != rejectWeekendOnly {
+			t.Errorf("hardConstraintCheck = (%v, %v), want rejectWeekendOnly", reason, ok)
+		}
+		for _, day := range []string{"2026-05-02", "2026-05-03"} {
+			if _, ok := s.hardConstraintCheck(showcase, Slot{Date: mustDate(day), Time: "17:00", Field: "Symonds Field"}); !ok {
+				t.Errorf("expected the game to be allowed on %s", day)
+			}
+		}
+	})
+
+	t.Run("Schedule keeps showcase games on weekends", func(t *testing.T) {
+		games := append((&strategy.DivisionWeighted{}).GenerateMatchups(cfg.Divisions),
+			strategy.Game{Home: "Cubs", Away: "Angels", Label: "Showcase 1", WeekendOnly: true},
+			strategy.Game{Home: "Royals", Away: "Pirates", Label: "Showcase 2", WeekendOnly: true})
+		result, err := Schedule(cfg, GenerateSlots(cfg), nil, games)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		placed := 0
+		for _, a := range result.Assignments {
+			if a.Game.WeekendOnly {
+				placed++
+				if !isWeekend(a.Slot.Date) {
+					t.Errorf("%s on %s, want a weekend", a.Game.Label, a.Slot.Date.Format("Mon 01/02"))
+				}
+			}
+		}
+		if placed != 2 {
+			t.Errorf("placed %d showcase games, want 2", placed)
+		}
+	})
+
+	t.Run("failure names weekend_only games left over", func(t *testing.T) {
+		s := newScheduler(cfg, nil, nil, []strategy.Game{showcase})
+		best := newScheduler(cfg, nil, nil, nil)
+		best.unscheduled = []strategy.Game{showcase}
+		if err := s.buildFailureError(best); !strings.Contains(err.Error(), "Angels vs Cubs (weekend_only: no weekend slot fit)") {
+			t.Errorf("error = %v, want the weekend_only game called out", err)
+		}
+	})
+}
+
+func TestDivisionRematchDays(t *testing.T) {
+	cfg := schedulerTestConfig()
+	competitive := 21
+	cfg.Guidelines.MinDaysBetweenSameMatchup = 7
+	cfg.Divisions[0].MinDaysBetweenSameMatchup = &competitive
+	first := Slot{Date: mustDate("2026-05-02"), Time: "12:30", Field: "Symonds Field"}
+	later := Slot{Date: mustDate("2026-05-12"), Time: "17:45", Field: "Symonds Field"}
+
+	t.Run("scoreSlot uses the division's spacing", func(t *testing.T) {
+		s := newScheduler(cfg, nil, nil, nil)
+		s.assign(strategy.Game{Home: "Angels", Away: "Astros"}, first)
+		s.assign(strategy.Game{Home: "Cubs", Away: "Padres"}, first)
+		american := s.scoreSlot(strategy.Game{Home: "Astros", Away: "Angels"}, later)
+		national := s.scoreSlot(strategy.Game{Home: "Padres", Away: "Cubs"}, later)
+		if american-national < 50 {
+			t.Errorf("scores: American rematch %.2f, National rematch %.2f; want the 21-day division penalized", american, national)
+		}
+	})
+
+	t.Run("buildMetrics warns at the stricter spacing", func(t *testing.T) {
+		s := newScheduler(cfg, nil, nil, nil)
+		for _, g := range []strategy.Game{{Home: "Angels", Away: "Astros"}, {Home: "Cubs", Away: "Padres"}, {Home: "Angels", Away: "Cubs"}} {
+			s.assign(g, first)
+			s.assign(strategy.Game{Home: g.Away, Away: g.Home}, later)
+		}
+		warnings, _ := s.buildMetrics()
+		var rematches []string
+		for _, w := range warnings {
+			if strings.Contains(w.Message, "rematch after") {
+				rematches = append(rematches, w.Message)
+			}
+		}
+		want := []string{
+			"Angels vs Astros rematch after 10 days (min 21): 05/02 and 05/12",
+			"Angels vs Cubs rematch after 10 days (min 21): 05/02 and 05/12",
+		}
+		slices.Sort(rematches)
+		if !slices.Equal(rematches, want) {
+			t.Errorf("rematch warnings = %q, want %q", rematches, want)
+		}
+	})
+}
+
+func TestSamePhysicalLocation(t *testing.T) {
+	cfg := schedulerTestConfig()
+	cfg.Rules.MaxGamesPerTimeslot = 3
+	cfg.Rules.SamePhysicalLocation = [][]string{{"Symonds Field", "Washington Park"}}
+	sat := mustDate("2026-05-02")
+
+	t.Run("one game per timeslot across the location", func(t *testing.T) {
+		s := newScheduler(cfg, nil, nil, nil)
+		s.assign(strategy.Game{Home: "Angels", Away: "Astros"}, Slot{Date: sat, Time: "12:30", Field: "Symonds Field"})
+		game := strategy.Game{Home: "Cubs", Away: "Padres"}
+		if reason, ok := s.hardConstraintCheck(game, Slot{Date: sat, Time: "12:30", Field: "Washington Park"}); ok || reason != rejectSharedLocation {
+			t.Errorf("hardConstraintCheck = (%v, %v), want rejectSharedLocation", reason, ok)
+		}
+		if _, ok := s.hardConstraintCheck(game, Slot{Date: sat, Time: "14:45", Field: "Washington Park"}); !ok {
+			t.Error("expected a later time at the location to be allowed")
+		}
+		if _, ok := s.hardConstraintCheck(game, Slot{Date: sat, Time: "12:30", Field: "Moscariello Ballpark"}); !ok {
+			t.Error("expected another location at the same time to be allowed")
+		}
+	})
+
+	t.Run("Schedule never doubles up the location", func(t *testing.T) {
+		games := (&strategy.DivisionWeighted{}).GenerateMatchups(cfg.Divisions)
+		result, _ := Schedule(cfg, GenerateSlots(cfg), nil, games)
+		used := make(map[timeKey]int)
+		for _, a := range result.Assignments {
+			if a.Slot.Field != "Moscariello Ballpark" {
+				used[timeKey{a.Slot.Date, a.Slot.Time}]++
+			}
+		}
+		for tk, n := range used {
+			if n > 1 {
+				t.Errorf("%s %s: %d games at the shared location", tk.date.Format("01/02"), tk.time, n)
+			}
+		}
+	})
+
+	t.Run("a failure reports the capacity the location costs", func(t *testing.T) {
+		var slots []Slot
+		for _, slot := range GenerateSlots(cfg) {
+			if slot.Date.Equal(sat) {
+				slots = append(slots, slot)
+			}
+		}
+		games := (&strategy.DivisionWeighted{}).GenerateMatchups(cfg.Divisions)
+		_, err := Schedule(cfg, slots, nil, games)
+		if err == nil || !strings.Contains(err.Error(), "same_physical_location) leave 3 slots unusable") {
+			t.Errorf("error = %v, want the shared location's lost slots", err)
+		}
+	})
+}
+
+func TestMatchupBlackouts(t *testing.T) {
+	cfg := schedulerTestConfig()
+	blackout := mustDate("2026-05-05")
+	cfg.Rules.MatchupBlackouts = []config.MatchupBlackout{
+		{Home: "Cubs", Away: "Angels", Date: config.Date{Time: blackout}},
+	}
+	s := newScheduler(cfg, nil, nil, nil)
+	slot := Slot{Date: blackout, Time: "17:45", Field: "Symonds Field"}
+
+	t.Run("rejects the pairing either way round", func(t *testing.T) {
+		for _, g := range []strategy.Game{{Home: "Cubs", Away: "Angels"}, {Home: "Angels", Away: "Cubs"}} {
+			if reason, ok := s.hardConstraintCheck(g, slot); ok || reason != rejectMatchupBlackout {
+				t.Errorf("%s vs %s: hardConstraintCheck = (%v, %v), want rejectMatchupBlackout", g.Home, g.Away, reason, ok)
+			}
+		}
+	})
+
+	t.Run("each team may play someone else that day", func(t *testing.T) {
+		if _, ok := s.hardConstraintCheck(strategy.Game{Home: "Cubs", Away: "Royals"}, slot); !ok {
+			t.Error("expected Cubs vs Royals to be allowed")
+		}
+		next := Slot{Date: blackout.AddDate(0, 0, 1), Time: "17:45", Field: "Symonds Field"}
+		if _, ok := s.hardConstraintCheck(strategy.Game{Home: "Cubs", Away: "Angels"}, next); !ok {
+			t.Error("expected Cubs vs Angels to be allowed the next day")
+		}
+	})
+
+	t.Run("any opponent rejects every game of the team that day", func(t *testing.T) {
+		cfg := schedulerTestConfig()
+		cfg.Rules.MatchupBlackouts = []config.MatchupBlackout{
+			{Home: config.AnyOpponent, Away: "Padres", Date: config.Date{Time: blackout}},
+		}
+		s := newScheduler(cfg, nil, nil, nil)
+		for _, g := range []strategy.Game{{Home: "Padres", Away: "Cubs"}, {Home: "Royals", Away: "Padres"}} {
+			if reason, ok := s.hardConstraintCheck(g, slot); ok || reason != rejectMatchupBlackout {
+				t.Errorf("%s vs %s: hardConstraintCheck = (%v, %v), want rejectMatchupBlackout", g.Home, g.Away, reason, ok)
+			}
+		}
+		if _, ok := s.hardConstraintCheck(strategy.Game{Home: "Cubs", Away: "Royals"}, slot); !ok {
+			t.Error("expected Cubs vs Royals to be allowed")
+		}
+	})
+}
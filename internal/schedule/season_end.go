@@ -0,0 +1,94 @@
+package schedule
+
+import (
+	"math"
+	"time"
+
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+// SeasonEnd is the spread of teams' final game dates: the team whose season
+// ends first and the one whose ends last.
+type SeasonEnd struct {
+	FirstTeam string
+	First     time.Time
+	LastTeam  string
+	Last      time.Time
+}
+
+// Days returns how many days separate the first and last teams to finish.
+func (e SeasonEnd) Days() int {
+	return int(e.Last.Sub(e.First).Hours() / 24)
+}
+
+// seasonEnd returns the earliest and latest final game dates across teams
+// with games, breaking ties by config order, or nil before any game.
+func (s *scheduler) seasonEnd() *SeasonEnd {
+	var end *SeasonEnd
+	for _, team := range s.cfg.AllTeams() {
+		dates := s.teamDates[team]
+		if len(dates) == 0 {
+			continue
+		}
+		last := dates[len(dates)-1]
+		if end == nil {
+			end = &SeasonEnd{FirstTeam: team, First: last, LastTeam: team, Last: last}
+			continue
+		}
+		if last.Before(end.First) {
+			end.FirstTeam, end.First = team, last
+		}
+		if last.After(end.Last) {
+			end.LastTeam, end.Last = team, last
+		}
+	}
+	return end
+}
+
+// seasonEndDeviation returns the standard deviation, in days, of the final
+// game dates of teams with games. extend, when set, stands in for a game
+// on date: each of its teams' final dates moves to date if that is later.
+func (s *scheduler) seasonEndDeviation(extend []string, date time.Time) float64 {
+	var days []float64
+	for _, team := range s.cfg.AllTeams() {
+		dates := s.teamDates[team]
+		var last time.Time
+		if len(dates) > 0 {
+			last = dates[len(dates)-1]
+		}
+		for _, t := range extend {
+			if t == team && date.After(last) {
+				last = date
+			}
+		}
+		if !last.IsZero() {
+			days = append(days, last.Sub(s.cfg.Season.StartDate.Time).Hours()/24)
+		}
+	}
+	if len(days) < 2 {
+		return 0
+	}
+	mean := 0.0
+	for _, d := range days {
+		mean += d
+	}
+	mean /= float64(len(days))
+	variance := 0.0
+	for _, d := range days {
+		variance += (d - mean) * (d - mean)
+	}
+	return math.Sqrt(variance / float64(len(days)))
+}
+
+// seasonEndPenalty scores a slot for balance_season_end by how much playing
+// the game there would widen the spread of teams' final game dates. Slots
+// that narrow it aren't rewarded: games are placed in shuffled order, so a
+// team's last date mid-run says little about where its season will end.
+func (s *scheduler) seasonEndPenalty(game strategy.Game, slot Slot) float64 {
+	if !s.cfg.Guidelines.BalanceSeasonEnd {
+		return 0
+	}
+	before := s.seasonEndDeviation(nil, time.Time{})
+	after := s.seasonEndDeviation([]string{game.Home, game.Away}, slot.Date)
+	return max(after-before, 0) * 3
+}
@@ -0,0 +1,55 @@
+package schedule
+
+import (
+	"testing"
+
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+func TestSeasonEnd(t *testing.T) {
+	cfg := schedulerTestConfig()
+	cfg.Guidelines.BalanceSeasonEnd = true
+	s := newScheduler(cfg, nil, nil, nil)
+	if s.seasonEnd() != nil {
+		t.Error("seasonEnd() before any game, want nil")
+	}
+	s.assign(strategy.Game{Home: "Angels", Away: "Astros", Label: "Game 1"}, Slot{Date: mustDate("2026-05-02"), Time: "12:30", Field: "Symonds Field"})
+	s.assign(strategy.Game{Home: "Cubs", Away: "Padres", Label: "Game 2"}, Slot{Date: mustDate("2026-05-09"), Time: "12:30", Field: "Symonds Field"})
+	s.assign(strategy.Game{Home: "Angels", Away: "Cubs", Label: "Game 3"}, Slot{Date: mustDate("2026-05-16"), Time: "12:30", Field: "Symonds Field"})
+
+	t.Run("reports the first and last teams to finish", func(t *testing.T) {
+		e := s.seasonEnd()
+		if e == nil {
+			t.Fatal("seasonEnd() = nil, want a spread")
+		}
+		if e.FirstTeam != "Astros" || !e.First.Equal(mustDate("2026-05-02")) {
+			t.Errorf("first = %s on %s, want Astros on 05/02", e.FirstTeam, e.First.Format("01/02"))
+		}
+		if e.LastTeam != "Angels" || !e.Last.Equal(mustDate("2026-05-16")) {
+			t.Errorf("last = %s on %s, want Angels (first in config order) on 05/16", e.LastTeam, e.Last.Format("01/02"))
+		}
+		if e.Days() != 14 {
+			t.Errorf("Days() = %d, want 14", e.Days())
+		}
+	})
+
+	t.Run("scoreSlot penalizes widening the spread", func(t *testing.T) {
+		catchUp := s.seasonEndPenalty(strategy.Game{Home: "Astros", Away: "Padres"}, Slot{Date: mustDate("2026-05-16"), Time: "12:30", Field: "Symonds Field"})
+		if catchUp != 0 {
+			t.Errorf("penalty for catching up teams that finished early = %.2f, want 0", catchUp)
+		}
+		pullAway := s.seasonEndPenalty(strategy.Game{Home: "Angels", Away: "Cubs"}, Slot{Date: mustDate("2026-05-30"), Time: "12:30", Field: "Symonds Field"})
+		if pullAway <= 0 {
+			t.Errorf("penalty for extending the latest teams' seasons = %.2f, want above 0", pullAway)
+		}
+	})
+
+	t.Run("off unless balance_season_end is set", func(t *testing.T) {
+		off := *cfg
+		off.Guidelines.BalanceSeasonEnd = false
+		s := newScheduler(&off, nil, nil, nil)
+		if p := s.seasonEndPenalty(strategy.Game{Home: "Astros", Away: "Padres"}, Slot{Date: mustDate("2026-05-16")}); p != 0 {
+			t.Errorf("penalty with the guideline off = %.2f, want 0", p)
+		}
+	})
+}
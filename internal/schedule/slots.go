@@ -1,6 +1,7 @@
 package schedule
 
 import (
+	"slices"
 	"sort"
 	"time"
 
@@ -23,44 +24,15 @@ type BlackoutSlot struct {
 }
 
 // GenerateSlots builds all available (date, time, field) tuples for the season,
-// excluding blackout dates and field reservations.
+// excluding blackout dates, field reservations, and weekday slots before
+// weekday_start_offset ends.
 func GenerateSlots(cfg *config.Config) []Slot {
 	blackoutDates := make(map[time.Time]bool)
 	for _, b := range cfg.Season.BlackoutDates {
 		blackoutDates[b.Date.Time] = true
 	}
 
-	holidayDates := make(map[time.Time]bool)
-	for _, h := range cfg.TimeSlots.HolidayDates {
-		holidayDates[h.Time] = true
-	}
-
-	// Build reservation lookup: field+date+time -> true
-	// Also track full-day reservations: field+date -> true
-	type resKey struct {
-		field string
-		date  time.Time
-		time  string
-	}
-	type fieldDateKey struct {
-		field string
-		date  time.Time
-	}
-	reservations := make(map[resKey]bool)
-	fullDayRes := make(map[fieldDateKey]bool)
-	for _, f := range cfg.Fields {
-		for _, r := range f.Reservations {
-			for _, rd := range r.Dates() {
-				if len(r.Times) == 0 {
-					fullDayRes[fieldDateKey{f.Name, rd}] = true
-				} else {
-					for _, t := range r.Times {
-						reservations[resKey{f.Name, rd, t}] = true
-					}
-				}
-			}
-		}
-	}
+	reservations := reservationsByDate(cfg)
 
 	var slots []Slot
 	d := cfg.Season.StartDate.Time
@@ -70,14 +42,14 @@ func GenerateSlots(cfg *config.Config) []Slot {
 			continue
 		}
 
-		times := timesForDay(d, holidayDates, cfg.TimeSlots)
+		if rampUp(cfg, d) {
+			d = d.AddDate(0, 0, 1)
+			continue
+		}
 
-		for _, t := range times {
-			for _, f := range cfg.Fields {
-				if fullDayRes[fieldDateKey{f.Name, d}] {
-					continue
-				}
-				if reservations[resKey{f.Name, d, t}] {
+		for _, f := range cfg.Fields {
+			for _, t := range cfg.FieldTimesForDay(f.Name, d) {
+				if reservations.blocks(f.Name, d, t) {
 					continue
 				}
 				slots = append(slots, Slot{Date: d, Time: t, Field: f.Name})
@@ -91,8 +63,8 @@ func GenerateSlots(cfg *config.Config) []Slot {
 		if !slots[i].Date.Equal(slots[j].Date) {
 			return slots[i].Date.Before(slots[j].Date)
 		}
-		if slots[i].Time != slots[j].Time {
-			return slots[i].Time < slots[j].Time
+		if c := config.CompareTimes(slots[i].Time, slots[j].Time); c != 0 {
+			return c < 0
 		}
 		return slots[i].Field < slots[j].Field
 	})
@@ -113,36 +85,7 @@ func GenerateOverflowSlots(cfg *config.Config) []Slot {
 		blackoutDates[b.Date.Time] = true
 	}
 
-	holidayDates := make(map[time.Time]bool)
-	for _, h := range cfg.TimeSlots.HolidayDates {
-		holidayDates[h.Time] = true
-	}
-
-	// Build reservation lookups (same as GenerateSlots)
-	type resKey struct {
-		field string
-		date  time.Time
-		time  string
-	}
-	type fieldDateKey struct {
-		field string
-		date  time.Time
-	}
-	reservations := make(map[resKey]bool)
-	fullDayRes := make(map[fieldDateKey]bool)
-	for _, f := range cfg.Fields {
-		for _, r := range f.Reservations {
-			for _, rd := range r.Dates() {
-				if len(r.Times) == 0 {
-					fullDayRes[fieldDateKey{f.Name, rd}] = true
-				} else {
-					for _, t := range r.Times {
-						reservations[resKey{f.Name, rd, t}] = true
-					}
-				}
-			}
-		}
-	}
+	reservations := reservationsByDate(cfg)
 
 	var slots []Slot
 	d := cfg.Season.EndDate.Time.AddDate(0, 0, 1) // day after end_date
@@ -152,13 +95,9 @@ func GenerateOverflowSlots(cfg *config.Config) []Slot {
 			continue
 		}
 
-		times := timesForDay(d, holidayDates, cfg.TimeSlots)
-		for _, t := range times {
-			for _, f := range cfg.Fields {
-				if fullDayRes[fieldDateKey{f.Name, d}] {
-					continue
-				}
-				if reservations[resKey{f.Name, d, t}] {
+		for _, f := range cfg.Fields {
+			for _, t := range cfg.FieldTimesForDay(f.Name, d) {
+				if reservations.blocks(f.Name, d, t) {
 					continue
 				}
 				slots = append(slots, Slot{Date: d, Time: t, Field: f.Name})
@@ -172,8 +111,8 @@ func GenerateOverflowSlots(cfg *config.Config) []Slot {
 		if !slots[i].Date.Equal(slots[j].Date) {
 			return slots[i].Date.Before(slots[j].Date)
 		}
-		if slots[i].Time != slots[j].Time {
-			return slots[i].Time < slots[j].Time
+		if c := config.CompareTimes(slots[i].Time, slots[j].Time); c != 0 {
+			return c < 0
 		}
 		return slots[i].Field < slots[j].Field
 	})
@@ -181,21 +120,98 @@ func GenerateOverflowSlots(cfg *config.Config) []Slot {
 	return slots
 }
 
+// RampUpSlots returns the weekday slots weekday_start_offset keeps off the
+// opening days of the season: those that would otherwise be available.
+func RampUpSlots(cfg *config.Config) []Slot {
+	blackoutDates := make(map[time.Time]bool)
+	for _, b := range cfg.Season.BlackoutDates {
+		blackoutDates[b.Date.Time] = true
+	}
+	reservations := reservationsByDate(cfg)
+
+	var slots []Slot
+	for d := cfg.Season.StartDate.Time; d.Before(cfg.Season.WeekdaysStart()) && !d.After(cfg.Season.EndDate.Time); d = d.AddDate(0, 0, 1) {
+		if blackoutDates[d] || !rampUp(cfg, d) {
+			continue
+		}
+		for _, f := range cfg.Fields {
+			for _, t := range cfg.FieldTimesForDay(f.Name, d) {
+				if !reservations.blocks(f.Name, d, t) {
+					slots = append(slots, Slot{Date: d, Time: t, Field: f.Name})
+				}
+			}
+		}
+	}
+	return slots
+}
+
+// rampUp reports whether d is a weekday-template day that falls before
+// weekday games start. Holidays that follow a weekend template still play.
+func rampUp(cfg *config.Config, d time.Time) bool {
+	return d.Before(cfg.Season.WeekdaysStart()) && cfg.TimeSlots.DayTemplate(d) == "weekday"
+}
+
+// HeldOpenSlots returns the slots reserve_open_slots keeps open: the last
+// count slots, by time and then field, of each Saturday or each week. slots
+// must be sorted as GenerateSlots returns them. Returns nil when nothing is
+// reserved.
+func HeldOpenSlots(cfg *config.Config, slots []Slot) []Slot {
+	r := cfg.Season.ReserveOpenSlots
+	if r == nil || r.Count <= 0 {
+		return nil
+	}
+
+	var periods [][]Slot
+	var current time.Time
+	for _, slot := range slots {
+		var period time.Time
+		switch r.Per {
+		case "saturday":
+			if slot.Date.Weekday() != time.Saturday {
+				continue
+			}
+			period = slot.Date
+		default:
+			period = WeekStart(slot.Date)
+		}
+		if len(periods) == 0 || !period.Equal(current) {
+			periods = append(periods, nil)
+			current = period
+		}
+		periods[len(periods)-1] = append(periods[len(periods)-1], slot)
+	}
+
+	var held []Slot
+	for _, p := range periods {
+		held = append(held, p[max(0, len(p)-r.Count):]...)
+	}
+	return held
+}
+
+// withoutHeld splits slots into those the scheduler may use and those
+// reserve_open_slots holds open.
+func withoutHeld(cfg *config.Config, slots []Slot) (open, held []Slot) {
+	held = HeldOpenSlots(cfg, slots)
+	if len(held) == 0 {
+		return slots, nil
+	}
+	for _, slot := range slots {
+		if !slices.Contains(held, slot) {
+			open = append(open, slot)
+		}
+	}
+	return open, held
+}
+
 // GenerateBlackoutSlots returns all slots that are blacked out (season-wide
 // blackouts and field reservations) for display on the master sheet.
 func GenerateBlackoutSlots(cfg *config.Config) []BlackoutSlot {
-	holidayDates := make(map[time.Time]bool)
-	for _, h := range cfg.TimeSlots.HolidayDates {
-		holidayDates[h.Time] = true
-	}
-
 	var blackouts []BlackoutSlot
 
 	// Season-wide blackout dates
 	for _, b := range cfg.Season.BlackoutDates {
-		times := timesForDay(b.Date.Time, holidayDates, cfg.TimeSlots)
-		for _, t := range times {
-			for _, f := range cfg.Fields {
+		for _, f := range cfg.Fields {
+			for _, t := range cfg.FieldTimesForDay(f.Name, b.Date.Time) {
 				blackouts = append(blackouts, BlackoutSlot{
 					Date:   b.Date.Time,
 					Time:   t,
@@ -219,36 +235,33 @@ func GenerateBlackoutSlots(cfg *config.Config) []BlackoutSlot {
 				if rd.Before(cfg.Season.StartDate.Time) || rd.After(effectiveEnd) {
 					continue
 				}
-				if len(r.Times) == 0 {
-					times := timesForDay(rd, holidayDates, cfg.TimeSlots)
-					for _, t := range times {
-						blackouts = append(blackouts, BlackoutSlot{
-							Date:   rd,
-							Time:   t,
-							Field:  f.Name,
-							Reason: r.Reason,
-						})
-					}
-				} else {
-					for _, t := range r.Times {
-						blackouts = append(blackouts, BlackoutSlot{
-							Date:   rd,
-							Time:   t,
-							Field:  f.Name,
-							Reason: r.Reason,
-						})
+				// Listed times show even if the day doesn't offer them
+				times := append([]string{}, r.Times...)
+				for _, t := range cfg.FieldTimesForDay(f.Name, rd) {
+					if r.Blocks(t) && !slices.Contains(r.Times, t) {
+						times = append(times, t)
 					}
 				}
+				for _, t := range times {
+					blackouts = append(blackouts, BlackoutSlot{
+						Date:   rd,
+						Time:   t,
+						Field:  f.Name,
+						Reason: r.Reason,
+					})
+				}
 			}
 		}
 	}
 
-	sort.Slice(blackouts, func(i, j int) bool {
+	// Stable, so entries for the same slot stay in config order: season
+	// blackouts first, then each field's reservations as listed.
+	sort.SliceStable(blackouts, func(i, j int) bool {
 		if !blackouts[i].Date.Equal(blackouts[j].Date) {
 			return blackouts[i].Date.Before(blackouts[j].Date)
 		}
-		if blackouts[i].Time != blackouts[j].Time {
-			return blackouts[i].Time < blackouts[j].Time
+		if c := config.CompareTimes(blackouts[i].Time, blackouts[j].Time); c != 0 {
+			return c < 0
 		}
 		return blackouts[i].Field < blackouts[j].Field
 	})
@@ -256,16 +269,38 @@ func GenerateBlackoutSlots(cfg *config.Config) []BlackoutSlot {
 	return blackouts
 }
 
-func timesForDay(d time.Time, holidays map[time.Time]bool, ts config.TimeSlots) []string {
-	if holidays[d] {
-		return ts.Sunday
+type fieldDate struct {
+	field string
+	date  time.Time
+}
+
+// fieldReservations indexes field reservations by the field and date they cover.
+type fieldReservations map[fieldDate][]config.Reservation
+
+func reservationsByDate(cfg *config.Config) fieldReservations {
+	idx := make(fieldReservations)
+	for _, f := range cfg.Fields {
+		for _, r := range f.Reservations {
+			for _, rd := range r.Dates() {
+				idx[fieldDate{f.Name, rd}] = append(idx[fieldDate{f.Name, rd}], r)
+			}
+		}
 	}
-	switch d.Weekday() {
-	case time.Saturday:
-		return ts.Saturday
-	case time.Sunday:
-		return ts.Sunday
-	default:
-		return ts.Weekday
+	return idx
+}
+
+// blocks reports whether any reservation covers the field at time t on d.
+func (idx fieldReservations) blocks(field string, d time.Time, t string) bool {
+	for _, r := range idx[fieldDate{field, d}] {
+		if r.Blocks(t) {
+			return true
+		}
 	}
+	return false
+}
+
+// TimesForDay returns the configured slot times for a date, honoring
+// holiday templates. It does not consider blackouts or reservations.
+func TimesForDay(cfg *config.Config, d time.Time) []string {
+	return cfg.TimesForDay(d)
 }
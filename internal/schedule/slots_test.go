@@ -1,6 +1,7 @@
 package schedule
 
 import (
+	"slices"
 	"testing"
 	"time"
 
@@ -67,8 +68,8 @@ func testConfig() *config.Config {
 			Weekday:  []string{"17:45"},
 			Saturday: []string{"12:30", "14:45", "17:00"},
 			Sunday:   []string{"17:00"},
-			HolidayDates: []config.Date{
-				date(2026, 5, 25),
+			HolidayDates: []config.Holiday{
+				{Date: date(2026, 5, 25)},
 			},
 		},
 	}
@@ -147,6 +148,22 @@ func TestGenerateSlots(t *testing.T) {
 		}
 	})
 
+	t.Run("holiday can follow saturday template", func(t *testing.T) {
+		cfg := testConfig()
+		cfg.Season.BlackoutDates = nil
+		cfg.TimeSlots.HolidayDates = []config.Holiday{{Date: date(2026, 5, 25), As: "saturday"}}
+		var holidaySlots []Slot
+		for _, s := range GenerateSlots(cfg) {
+			if s.Date.Equal(mustDate("2026-05-25")) {
+				holidaySlots = append(holidaySlots, s)
+			}
+		}
+		// 3 fields × 3 Saturday times = 9 slots
+		if len(holidaySlots) != 9 {
+			t.Errorf("Memorial Day slots = %d, want 9", len(holidaySlots))
+		}
+	})
+
 	t.Run("full-day reservation removes all slots for that field", func(t *testing.T) {
 		// May 2 is a Saturday. Symonds has a full-day reservation (no times specified).
 		sat := mustDate("2026-05-02")
@@ -211,6 +228,33 @@ func TestGenerateSlots(t *testing.T) {
 		}
 	})
 
+	t.Run("until and from reservations block slots around a boundary", func(t *testing.T) {
+		cfg := testConfig()
+		cfg.Fields[2].Reservations = []config.Reservation{
+			{Date: datePtr(2026, 5, 9), Until: "16:00", Reason: "Softball"},
+			{Date: datePtr(2026, 5, 16), From: "14:45", Reason: "Softball"},
+		}
+		open := make(map[string][]string)
+		for _, s := range GenerateSlots(cfg) {
+			if s.Field == "Washington Park" {
+				key := s.Date.Format("01/02")
+				open[key] = append(open[key], s.Time)
+			}
+		}
+		tests := []struct {
+			date string
+			want []string
+		}{
+			{"05/09", []string{"17:00"}},
+			{"05/16", []string{"12:30"}},
+		}
+		for _, tt := range tests {
+			if got := open[tt.date]; !slices.Equal(got, tt.want) {
+				t.Errorf("Washington Park on %s = %v, want %v", tt.date, got, tt.want)
+			}
+		}
+	})
+
 	t.Run("slots are sorted by date then time then field", func(t *testing.T) {
 		for i := 1; i < len(slots); i++ {
 			prev, curr := slots[i-1], slots[i]
@@ -266,6 +310,22 @@ func TestGenerateBlackoutSlots(t *testing.T) {
 		}
 	})
 
+	t.Run("until reservations show each blocked time", func(t *testing.T) {
+		cfg := testConfig()
+		cfg.Fields[2].Reservations = []config.Reservation{
+			{Date: datePtr(2026, 5, 9), Until: "16:00", Reason: "Softball"},
+		}
+		var times []string
+		for _, b := range GenerateBlackoutSlots(cfg) {
+			if b.Field == "Washington Park" && b.Date.Equal(mustDate("2026-05-09")) {
+				times = append(times, b.Time)
+			}
+		}
+		if want := []string{"12:30", "14:45"}; !slices.Equal(times, want) {
+			t.Errorf("blocked times = %v, want %v", times, want)
+		}
+	})
+
 	t.Run("reservation slots included as blackouts", func(t *testing.T) {
 		found := false
 		for _, b := range blackouts {
@@ -281,3 +341,199 @@ func TestGenerateBlackoutSlots(t *testing.T) {
 		}
 	})
 }
+
+func TestReservationSpanningOverflow(t *testing.T) {
+	// A tournament on Washington Park from the last weekend of the season
+	// into the overflow period must block the field on every day, on both
+	// sides of end_date, and show on the master sheet for each of them.
+	cfg := testConfig()
+	cfg.Season.OverflowEndDate = datePtr(2026, 6, 5)
+	cfg.Fields[2].Reservations = []config.Reservation{
+		{StartDate: datePtr(2026, 5, 30), EndDate: datePtr(2026, 6, 2), Reason: "Tournament"},
+	}
+	reserved := []string{"2026-05-30", "2026-05-31", "2026-06-01", "2026-06-02"}
+
+	t.Run("no slots on the reserved field", func(t *testing.T) {
+		for _, s := range append(GenerateSlots(cfg), GenerateOverflowSlots(cfg)...) {
+			if s.Field == "Washington Park" && slices.ContainsFunc(reserved, func(ds string) bool { return s.Date.Equal(mustDate(ds)) }) {
+				t.Errorf("unexpected Washington Park slot on %s at %s", s.Date.Format("01/02"), s.Time)
+			}
+		}
+	})
+
+	t.Run("every reserved day is a blackout", func(t *testing.T) {
+		blackouts := GenerateBlackoutSlots(cfg)
+		for _, ds := range reserved {
+			for _, tm := range TimesForDay(cfg, mustDate(ds)) {
+				if !slices.ContainsFunc(blackouts, func(b BlackoutSlot) bool {
+					return b.Date.Equal(mustDate(ds)) && b.Time == tm && b.Field == "Washington Park" && b.Reason == "Tournament"
+				}) {
+					t.Errorf("missing Tournament blackout for Washington Park on %s at %s", ds, tm)
+				}
+			}
+		}
+	})
+
+	t.Run("other fields stay open in overflow", func(t *testing.T) {
+		var open int
+		for _, s := range GenerateOverflowSlots(cfg) {
+			if s.Date.Equal(mustDate("2026-06-01")) {
+				open++
+			}
+		}
+		if open != 2 { // Moscariello and Symonds at 17:45
+			t.Errorf("overflow slots on 06/01 = %d, want 2", open)
+		}
+	})
+}
+
+func TestLatestStartSlots(t *testing.T) {
+	cfg := testConfig()
+	cfg.TimeSlots.Weekday = []string{"17:45", "19:30"}
+	cfg.TimeSlots.LatestStart = map[string]string{"monday": "18:00"}
+
+	monday, tuesday := mustDate("2026-04-27"), mustDate("2026-04-28")
+	times := map[time.Time][]string{}
+	for _, s := range GenerateSlots(cfg) {
+		if s.Field == "Symonds Field" && (s.Date.Equal(monday) || s.Date.Equal(tuesday)) {
+			times[s.Date] = append(times[s.Date], s.Time)
+		}
+	}
+	if got := times[monday]; !slices.Equal(got, []string{"17:45"}) {
+		t.Errorf("Monday slots = %v, want only 17:45", got)
+	}
+	if got := times[tuesday]; !slices.Equal(got, []string{"17:45", "19:30"}) {
+		t.Errorf("Tuesday slots = %v, want 17:45 and 19:30", got)
+	}
+}
+
+func TestFieldTimeSlots(t *testing.T) {
+	cfg := testConfig()
+	cfg.Fields = append(cfg.Fields, config.Field{
+		Name:      "Kids Field",
+		TimeSlots: &config.FieldTimeSlots{Weekday: []string{}, Saturday: []string{"9:00", "11:00"}, Sunday: []string{}},
+	})
+	saturday, sunday := mustDate("2026-05-09"), mustDate("2026-05-03")
+
+	t.Run("the field plays only its own times", func(t *testing.T) {
+		var kids []Slot
+		for _, s := range GenerateSlots(cfg) {
+			if s.Field == "Kids Field" {
+				kids = append(kids, s)
+			}
+		}
+		for _, s := range kids {
+			if s.Date.Weekday() != time.Saturday || (s.Time != "9:00" && s.Time != "11:00") {
+				t.Errorf("unexpected Kids Field slot %s %s", s.Date.Format("Mon 01/02"), s.Time)
+			}
+		}
+		if len(kids) == 0 {
+			t.Error("expected Saturday morning slots on Kids Field")
+		}
+	})
+
+	t.Run("new times sort ahead of later ones", func(t *testing.T) {
+		var times []string
+		for _, s := range GenerateSlots(cfg) {
+			if s.Date.Equal(saturday) && !slices.Contains(times, s.Time) {
+				times = append(times, s.Time)
+			}
+		}
+		if want := []string{"9:00", "11:00", "12:30", "14:45", "17:00"}; !slices.Equal(times, want) {
+			t.Errorf("Saturday times = %v, want %v", times, want)
+		}
+		if got := cfg.TimesForDay(sunday); !slices.Equal(got, []string{"17:00"}) {
+			t.Errorf("Sunday TimesForDay = %v, want [17:00]", got)
+		}
+	})
+
+	t.Run("blackout dates cover the field's own times", func(t *testing.T) {
+		var times []string
+		for _, b := range GenerateBlackoutSlots(cfg) {
+			if b.Field == "Kids Field" {
+				times = append(times, b.Date.Format("01/02")+" "+b.Time)
+			}
+		}
+		if want := []string{"05/23 9:00", "05/23 11:00"}; !slices.Equal(times, want) {
+			t.Errorf("Kids Field blackouts = %v, want %v", times, want)
+		}
+	})
+}
+
+func TestWeekdayStartOffset(t *testing.T) {
+	cfg := testConfig()
+	cfg.Season.WeekdayStartOffset = 9 // weekday games start Monday May 4
+	cfg.TimeSlots.HolidayDates = append(cfg.TimeSlots.HolidayDates, config.Holiday{Date: date(2026, 4, 29), As: "saturday"})
+	slots := GenerateSlots(cfg)
+
+	days := make(map[time.Time]bool)
+	for _, s := range slots {
+		days[s.Date] = true
+	}
+	for _, tt := range []struct {
+		day  string
+		want bool
+	}{
+		{"2026-04-25", true},  // opening Saturday
+		{"2026-04-26", true},  // Sunday
+		{"2026-04-27", false}, // Monday of the ramp-up
+		{"2026-04-29", true},  // holiday on a Saturday template
+		{"2026-05-01", false}, // last ramp-up Friday
+		{"2026-05-04", true},  // weekday games start
+	} {
+		if days[mustDate(tt.day)] != tt.want {
+			t.Errorf("slots on %s = %v, want %v", tt.day, days[mustDate(tt.day)], tt.want)
+		}
+	}
+
+	// Mon 04/27, Tue 04/28, Thu 04/30, and Fri 05/01, on three fields.
+	held := RampUpSlots(cfg)
+	if len(held) != 12 {
+		t.Errorf("RampUpSlots = %d, want 12", len(held))
+	}
+	cfg.Season.WeekdayStartOffset = 0
+	if n := len(GenerateSlots(cfg)) - len(slots); n != len(held) {
+		t.Errorf("ramp-up removed %d slots but RampUpSlots reports %d", n, len(held))
+	}
+}
+
+func TestHeldOpenSlots(t *testing.T) {
+	t.Run("nothing held by default", func(t *testing.T) {
+		cfg := testConfig()
+		if held := HeldOpenSlots(cfg, GenerateSlots(cfg)); held != nil {
+			t.Errorf("HeldOpenSlots() = %v, want nil", held)
+		}
+	})
+
+	t.Run("last slot of each Saturday", func(t *testing.T) {
+		cfg := testConfig()
+		cfg.Season.ReserveOpenSlots = &config.ReserveOpenSlots{Count: 1, Per: "saturday"}
+		held := HeldOpenSlots(cfg, GenerateSlots(cfg))
+		var dates []string
+		for _, s := range held {
+			dates = append(dates, s.Date.Format("2006-01-02"))
+			if s.Time != "17:00" || s.Field != "Washington Park" {
+				t.Errorf("held %s %s at %s, want the 17:00 Washington Park slot", s.Date.Format("01/02"), s.Time, s.Field)
+			}
+		}
+		want := []string{"2026-04-25", "2026-05-02", "2026-05-09", "2026-05-16", "2026-05-30"}
+		if !slices.Equal(dates, want) {
+			t.Errorf("held dates = %v, want %v", dates, want)
+		}
+	})
+
+	t.Run("per week", func(t *testing.T) {
+		cfg := testConfig()
+		cfg.Season.ReserveOpenSlots = &config.ReserveOpenSlots{Count: 2, Per: "week"}
+		held := HeldOpenSlots(cfg, GenerateSlots(cfg))
+		if len(held) != 12 {
+			t.Fatalf("held %d slots, want 2 in each of 6 weeks", len(held))
+		}
+		// Mother's Day is blacked out, so that week's last slots are Saturday's
+		for _, s := range held[4:6] {
+			if !s.Date.Equal(mustDate("2026-05-09")) || s.Time != "17:00" {
+				t.Errorf("held %s %s, want Sat 05/09 17:00", s.Date.Format("01/02"), s.Time)
+			}
+		}
+	})
+}
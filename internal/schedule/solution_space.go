@@ -0,0 +1,84 @@
+package schedule
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+// SolutionSpace summarizes many independent scheduling attempts, as a
+// gauge of how constrained a season is: a low success rate means the
+// rules are close to infeasible.
+type SolutionSpace struct {
+	Samples   int       // attempts run
+	Succeeded int       // attempts that placed every game
+	Games     int       // games each attempt tried to place
+	Scores    []float64 // soft scores of the successful attempts, lowest first
+	// MostScheduled is the most games any attempt placed, equal to Games
+	// when at least one succeeded.
+	MostScheduled int
+}
+
+// SuccessRate returns the fraction of attempts that placed every game.
+func (sp *SolutionSpace) SuccessRate() float64 {
+	if sp.Samples == 0 {
+		return 0
+	}
+	return float64(sp.Succeeded) / float64(sp.Samples)
+}
+
+// Mean returns the average soft score of the successful attempts, or NaN
+// if none succeeded.
+func (sp *SolutionSpace) Mean() float64 {
+	if len(sp.Scores) == 0 {
+		return math.NaN()
+	}
+	var sum float64
+	for _, score := range sp.Scores {
+		sum += score
+	}
+	return sum / float64(len(sp.Scores))
+}
+
+// Quantile returns the soft score below which fraction q of the successful
+// attempts fall (0 for the best, 0.5 for the median, 1 for the worst), or
+// NaN if none succeeded.
+func (sp *SolutionSpace) Quantile(q float64) float64 {
+	if len(sp.Scores) == 0 {
+		return math.NaN()
+	}
+	q = min(max(q, 0), 1)
+	return sp.Scores[int(math.Round(q*float64(len(sp.Scores)-1)))]
+}
+
+// EstimateSolutionSpace runs samples randomized scheduling attempts for the
+// config's season, the same passes Schedule picks its best from, without
+// repair. The first attempts use Schedule's seeds, so its Diagnostics
+// match a 50-sample estimate.
+func EstimateSolutionSpace(cfg *config.Config, samples int) (*SolutionSpace, error) {
+	if samples <= 0 {
+		return nil, fmt.Errorf("samples must be positive, got %d", samples)
+	}
+	strat, err := strategy.FromConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	games := strat.GenerateMatchups(cfg.Divisions)
+	slots, _ := withoutHeld(cfg, GenerateSlots(cfg))
+	s := newScheduler(cfg, slots, GenerateOverflowSlots(cfg), games)
+
+	sp := &SolutionSpace{Samples: samples, Games: len(games)}
+	for i := range samples {
+		candidate, ok := s.attempt(int64(42 + i))
+		sp.MostScheduled = max(sp.MostScheduled, len(candidate.assignments))
+		if ok {
+			sp.Succeeded++
+			sp.Scores = append(sp.Scores, candidate.softScore())
+		}
+	}
+	sort.Float64s(sp.Scores)
+	return sp, nil
+}
@@ -0,0 +1,69 @@
+package schedule
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+func TestEstimateSolutionSpace(t *testing.T) {
+	cfg := schedulerTestConfig()
+	games := (&strategy.DivisionWeighted{}).GenerateMatchups(cfg.Divisions)
+
+	sp, err := EstimateSolutionSpace(cfg, scheduleAttempts)
+	if err != nil {
+		t.Fatalf("EstimateSolutionSpace() error: %v", err)
+	}
+
+	t.Run("matches Schedule's attempts", func(t *testing.T) {
+		result, err := Schedule(cfg, GenerateSlots(cfg), GenerateOverflowSlots(cfg), games)
+		if err != nil {
+			t.Fatalf("Schedule() error: %v", err)
+		}
+		if sp.Succeeded != result.Diagnostics.Succeeded {
+			t.Errorf("Succeeded = %d, want %d from Schedule", sp.Succeeded, result.Diagnostics.Succeeded)
+		}
+		if sp.Games != len(games) {
+			t.Errorf("Games = %d, want %d", sp.Games, len(games))
+		}
+	})
+
+	t.Run("summarizes successful attempts", func(t *testing.T) {
+		if sp.Succeeded == 0 {
+			t.Fatal("expected at least one successful attempt")
+		}
+		if len(sp.Scores) != sp.Succeeded {
+			t.Errorf("scores = %d, want one per success (%d)", len(sp.Scores), sp.Succeeded)
+		}
+		if !sort.Float64sAreSorted(sp.Scores) {
+			t.Error("scores not sorted lowest first")
+		}
+		if sp.MostScheduled != sp.Games {
+			t.Errorf("MostScheduled = %d, want %d", sp.MostScheduled, sp.Games)
+		}
+		if got, want := sp.SuccessRate(), float64(sp.Succeeded)/float64(sp.Samples); got != want {
+			t.Errorf("SuccessRate() = %v, want %v", got, want)
+		}
+		if sp.Quantile(0) != sp.Scores[0] || sp.Quantile(1) != sp.Scores[len(sp.Scores)-1] {
+			t.Error("Quantile(0) and Quantile(1) should be the best and worst scores")
+		}
+		if m := sp.Mean(); m < sp.Quantile(0) || m > sp.Quantile(1) {
+			t.Errorf("Mean() = %v outside score range", m)
+		}
+	})
+
+	t.Run("empty estimate", func(t *testing.T) {
+		empty := &SolutionSpace{}
+		if empty.SuccessRate() != 0 || !math.IsNaN(empty.Mean()) || !math.IsNaN(empty.Quantile(0.5)) {
+			t.Error("expected zero rate and NaN statistics with no samples")
+		}
+	})
+
+	t.Run("rejects non-positive samples", func(t *testing.T) {
+		if _, err := EstimateSolutionSpace(cfg, 0); err == nil {
+			t.Error("expected an error for 0 samples")
+		}
+	})
+}
@@ -0,0 +1,77 @@
+package schedule
+
+import "github.com/derekprior/rbrl/internal/strategy"
+
+// trackStarts records a game placed (sign 1) or removed (sign -1) in the
+// teams' start time overages. On a field offering n start times that day a
+// game is worth 1/n of a game at each of them; the overage at a time is games
+// played there minus that fair share. Fields with a single start time
+// that day don't count. Nothing is tracked without balance_start_times.
+func (s *scheduler) trackStarts(game strategy.Game, slot Slot, sign float64) {
+	if !s.cfg.Guidelines.BalanceStartTimes {
+		return
+	}
+	times := s.cfg.FieldTimesForDay(slot.Field, slot.Date)
+	if len(times) < 2 {
+		return
+	}
+	for _, team := range []string{game.Home, game.Away} {
+		if s.teamStarts[team] == nil {
+			s.teamStarts[team] = make(map[string]float64)
+		}
+		s.teamStarts[team][slot.Time] += sign
+		for _, t := range times {
+			s.teamStarts[team][t] -= sign / float64(len(times))
+		}
+	}
+}
+
+// startPenalty scores a slot by how far its game's teams are already over
+// their share of its start time, and rewards times they're under, so each
+// team works through a mix of times rather than settling on one.
+func (s *scheduler) startPenalty(game strategy.Game, slot Slot) float64 {
+	if !s.cfg.Guidelines.BalanceStartTimes || len(s.cfg.FieldTimesForDay(slot.Field, slot.Date)) < 2 {
+		return 0
+	}
+	return (s.teamStarts[game.Home][slot.Time] + s.teamStarts[game.Away][slot.Time]) * 3
+}
+
+// startDeviation is the sum of squared overages across the team's start
+// times: 0 when it played each time equally often.
+func (s *scheduler) startDeviation(team string) float64 {
+	d := 0.0
+	for _, over := range s.teamStarts[team] {
+		d += over * over
+	}
+	return d
+}
+
+// startTimeSpread is how many more games the team plays at its most
+// frequent start time than at its least frequent one, among the times its
+// games' fields offered on days with a choice.
+func (s *scheduler) startTimeSpread(team string) int {
+	games := make(map[string]int)
+	for _, a := range s.assignments {
+		if a.Game.Home != team && a.Game.Away != team {
+			continue
+		}
+		times := s.cfg.FieldTimesForDay(a.Slot.Field, a.Slot.Date)
+		if len(times) < 2 {
+			continue
+		}
+		for _, t := range times {
+			if _, ok := games[t]; !ok {
+				games[t] = 0 // offered, even if never played
+			}
+		}
+		games[a.Slot.Time]++
+	}
+	if len(games) == 0 {
+		return 0
+	}
+	most, fewest := 0, len(s.assignments)
+	for _, n := range games {
+		most, fewest = max(most, n), min(fewest, n)
+	}
+	return most - fewest
+}
@@ -0,0 +1,72 @@
+package schedule
+
+import (
+	"testing"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+func TestBalanceStartTimes(t *testing.T) {
+	cfg := schedulerTestConfig()
+	cfg.Guidelines.BalanceStartTimes = true
+	early := func(date string) Slot { return Slot{Date: mustDate(date), Time: "12:30", Field: "Symonds Field"} }
+	game := strategy.Game{Home: "Angels", Away: "Astros"}
+
+	t.Run("scoreSlot steers a team off the time it keeps playing", func(t *testing.T) {
+		s := newScheduler(cfg, nil, nil, nil)
+		s.assign(strategy.Game{Home: "Angels", Away: "Cubs"}, early("2026-05-02"))
+		s.assign(strategy.Game{Home: "Padres", Away: "Angels"}, early("2026-05-09"))
+		sat := mustDate("2026-05-16")
+		again := s.scoreSlot(game, Slot{Date: sat, Time: "12:30", Field: "Washington Park"})
+		later := s.scoreSlot(game, Slot{Date: sat, Time: "14:45", Field: "Washington Park"})
+		if again <= later {
+			t.Errorf("12:30 scored %.2f, 14:45 %.2f; want 12:30 higher for the Angels", again, later)
+		}
+		if p := s.startPenalty(game, Slot{Date: mustDate("2026-05-12"), Time: "17:45", Field: "Symonds Field"}); p != 0 {
+			t.Errorf("startPenalty on a weekday with one time = %.2f, want 0", p)
+		}
+		if got := s.startTimeSpread("Angels"); got != 2 {
+			t.Errorf("startTimeSpread = %d, want 2 (two 12:30s, no 14:45 or 17:00)", got)
+		}
+	})
+
+	t.Run("unassign restores an even mix", func(t *testing.T) {
+		s := newScheduler(cfg, nil, nil, nil)
+		s.assign(game, early("2026-05-02"))
+		if s.startDeviation("Angels") == 0 {
+			t.Fatal("expected a deviation after one 12:30 game")
+		}
+		s.unassign(0)
+		if d := s.startDeviation("Angels"); d > 1e-9 {
+			t.Errorf("startDeviation after unassign = %v, want 0", d)
+		}
+	})
+
+	t.Run("a field with its own times counts only those", func(t *testing.T) {
+		own := *cfg
+		own.Fields = append([]config.Field(nil), cfg.Fields...)
+		own.Fields[1].TimeSlots = &config.FieldTimeSlots{Saturday: []string{"12:30"}}
+		s := newScheduler(&own, nil, nil, nil)
+		s.assign(game, early("2026-05-02"))
+		if p := s.startPenalty(game, early("2026-05-09")); p != 0 {
+			t.Errorf("startPenalty on a field with one Saturday time = %.2f, want 0", p)
+		}
+		if got := s.startTimeSpread("Angels"); got != 0 {
+			t.Errorf("startTimeSpread = %d, want 0 with no choice of time", got)
+		}
+	})
+
+	t.Run("nothing tracked when off", func(t *testing.T) {
+		off := *cfg
+		off.Guidelines.BalanceStartTimes = false
+		s := newScheduler(&off, nil, nil, nil)
+		s.assign(game, early("2026-05-02"))
+		if p := s.startPenalty(game, early("2026-05-09")); p != 0 {
+			t.Errorf("startPenalty = %.2f, want 0 without balance_start_times", p)
+		}
+		if got := s.startTimeSpread("Angels"); got != 1 {
+			t.Errorf("startTimeSpread = %d, want 1 even without the guideline", got)
+		}
+	})
+}
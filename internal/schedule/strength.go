@@ -0,0 +1,117 @@
+package schedule
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derekprior/rbrl/internal/config"
+)
+
+// strongTeams returns the teams rated above the average of the rated teams.
+// Teams without a rating are never strong.
+func strongTeams(cfg *config.Config) map[string]bool {
+	ratings := cfg.Ratings()
+	if len(ratings) == 0 {
+		return nil
+	}
+	total := 0.0
+	for _, r := range ratings {
+		total += r
+	}
+	avg := total / float64(len(ratings))
+
+	strong := make(map[string]bool)
+	for team, r := range ratings {
+		if r > avg {
+			strong[team] = true
+		}
+	}
+	return strong
+}
+
+// teamGamesInOrder returns the team's assignments by date, then time.
+func (s *scheduler) teamGamesInOrder(team string) []Assignment {
+	var games []Assignment
+	for _, a := range s.assignments {
+		if a.Game.Home == team || a.Game.Away == team {
+			games = append(games, a)
+		}
+	}
+	sort.SliceStable(games, func(i, j int) bool {
+		if !games[i].Slot.Date.Equal(games[j].Slot.Date) {
+			return games[i].Slot.Date.Before(games[j].Slot.Date)
+		}
+		return config.CompareTimes(games[i].Slot.Time, games[j].Slot.Time) < 0
+	})
+	return games
+}
+
+// strongRuns returns the team's runs of consecutive games against strong
+// opponents, each in date order.
+func (s *scheduler) strongRuns(team string) [][]Assignment {
+	var runs [][]Assignment
+	var run []Assignment
+	for _, a := range s.teamGamesInOrder(team) {
+		if s.strong[opponentOf(a.Game.Home, a.Game.Away, team)] {
+			run = append(run, a)
+			continue
+		}
+		if len(run) > 0 {
+			runs = append(runs, run)
+			run = nil
+		}
+	}
+	if len(run) > 0 {
+		runs = append(runs, run)
+	}
+	return runs
+}
+
+// strongStreakWith returns the length of the run of strong opponents a
+// game against a strong opponent at slot would put the team in: the strong
+// games right before it, the game itself, and the strong games right after.
+func (s *scheduler) strongStreakWith(team string, slot Slot) int {
+	games := s.teamGamesInOrder(team)
+	i := sort.Search(len(games), func(i int) bool {
+		d := games[i].Slot.Date
+		return d.After(slot.Date) || (d.Equal(slot.Date) && games[i].Slot.Time >= slot.Time)
+	})
+
+	run := 1
+	for j := i - 1; j >= 0 && s.strong[opponentOf(games[j].Game.Home, games[j].Game.Away, team)]; j-- {
+		run++
+	}
+	for j := i; j < len(games) && s.strong[opponentOf(games[j].Game.Home, games[j].Game.Away, team)]; j++ {
+		run++
+	}
+	return run
+}
+
+// toughestStretch returns the team's longest run of games against strong
+// opponents, the earliest if several tie.
+func (s *scheduler) toughestStretch(team string) []Assignment {
+	var longest []Assignment
+	for _, run := range s.strongRuns(team) {
+		if len(run) > len(longest) {
+			longest = run
+		}
+	}
+	return longest
+}
+
+func opponentOf(home, away, team string) string {
+	if home == team {
+		return away
+	}
+	return home
+}
+
+// describeStretch lists a run of the team's games as "Opponent MM/DD".
+func describeStretch(team string, run []Assignment) string {
+	parts := make([]string, len(run))
+	for i, a := range run {
+		parts[i] = fmt.Sprintf("%s %s", opponentOf(a.Game.Home, a.Game.Away, team), a.Slot.Date.Format("01/02"))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,60 @@
+package schedule
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/derekprior/rbrl/internal/config"
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+func TestStrongOpponentStreak(t *testing.T) {
+	cfg := schedulerTestConfig()
+	// Average rating is 5: Astros, Athletics, and Cubs are above it.
+	cfg.Teams = []config.Team{
+		{Name: "Astros", Rating: 8},
+		{Name: "Athletics", Rating: 7},
+		{Name: "Cubs", Rating: 6},
+		{Name: "Padres", Rating: 2},
+		{Name: "Royals", Rating: 2},
+	}
+	cfg.Guidelines.MaxStrongOpponentStreak = 2
+
+	s := newScheduler(cfg, nil, nil, nil)
+	s.assign(strategy.Game{Home: "Angels", Away: "Astros"}, Slot{Date: mustDate("2026-05-04"), Time: "17:45", Field: "Symonds Field"})
+	s.assign(strategy.Game{Home: "Athletics", Away: "Angels"}, Slot{Date: mustDate("2026-05-06"), Time: "17:45", Field: "Symonds Field"})
+	s.assign(strategy.Game{Home: "Angels", Away: "Padres"}, Slot{Date: mustDate("2026-05-09"), Time: "17:00", Field: "Symonds Field"})
+
+	t.Run("unrated and below-average teams are not strong", func(t *testing.T) {
+		for team, want := range map[string]bool{"Astros": true, "Cubs": true, "Padres": false, "Mariners": false} {
+			if s.strong[team] != want {
+				t.Errorf("strong[%s] = %v, want %v", team, s.strong[team], want)
+			}
+		}
+	})
+
+	t.Run("scoreSlot penalizes a third strong opponent in a row", func(t *testing.T) {
+		game := strategy.Game{Home: "Angels", Away: "Cubs"}
+		third := s.scoreSlot(game, Slot{Date: mustDate("2026-05-07"), Time: "17:45", Field: "Symonds Field"})
+		afterBreak := s.scoreSlot(game, Slot{Date: mustDate("2026-05-11"), Time: "17:45", Field: "Symonds Field"})
+		if third-afterBreak < 25 {
+			t.Errorf("score before break = %.2f, after = %.2f, want the streak penalized", third, afterBreak)
+		}
+	})
+
+	t.Run("buildMetrics reports the toughest stretch", func(t *testing.T) {
+		s.assign(strategy.Game{Home: "Cubs", Away: "Angels"}, Slot{Date: mustDate("2026-05-07"), Time: "17:45", Field: "Symonds Field"})
+		warnings, metrics := s.buildMetrics()
+		if got := metrics["Angels"].ToughestStretch; !slices.Equal(got, []string{"Astros", "Athletics", "Cubs"}) {
+			t.Errorf("ToughestStretch = %v, want [Astros Athletics Cubs]", got)
+		}
+		want := "Angels faces 3 above-average opponents in a row (max 2): Astros 05/04, Athletics 05/06, Cubs 05/07"
+		if !slices.ContainsFunc(warnings, func(w Warning) bool { return strings.Contains(w.Message, want) }) {
+			t.Errorf("warnings = %v, want %q", warnings, want)
+		}
+		if metrics["Padres"].ToughestStretch != nil {
+			t.Errorf("Padres ToughestStretch = %v, want none", metrics["Padres"].ToughestStretch)
+		}
+	})
+}
@@ -0,0 +1,48 @@
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/derekprior/rbrl/internal/config"
+)
+
+// Validate checks invariants the scheduler should never break, whatever
+// the guidelines: no two games in one slot, no team in two games at once,
+// and no team over max_games_per_day_per_team. It returns every breach
+// found, or nil. Schedule runs it on every result, as a guard against
+// bookkeeping bugs in displacement and repair.
+func (r *Result) Validate(cfg *config.Config) error {
+	maxPerDay := max(cfg.Rules.MaxGamesPerDayPerTeam, 1)
+	slotGame := make(map[slotKey]Assignment)
+	teamTime := make(map[teamTimeKey]bool)
+	type teamDate struct {
+		team string
+		date time.Time
+	}
+	perDay := make(map[teamDate]int)
+	var errs []error
+	for _, a := range r.Assignments {
+		sk := slotKey{a.Slot.Date, a.Slot.Time, a.Slot.Field}
+		if other, ok := slotGame[sk]; ok {
+			errs = append(errs, fmt.Errorf("%s @ %s and %s @ %s are both at %s %s on %s",
+				a.Game.Away, a.Game.Home, other.Game.Away, other.Game.Home,
+				a.Slot.Date.Format("Mon 01/02"), a.Slot.Time, a.Slot.Field))
+		}
+		slotGame[sk] = a
+		for _, team := range []string{a.Game.Home, a.Game.Away} {
+			tk := teamTimeKey{team, a.Slot.Date, a.Slot.Time}
+			if teamTime[tk] {
+				errs = append(errs, fmt.Errorf("%s plays twice at %s %s", team, a.Slot.Date.Format("Mon 01/02"), a.Slot.Time))
+			}
+			teamTime[tk] = true
+			dk := teamDate{team, a.Slot.Date}
+			if perDay[dk]++; perDay[dk] == maxPerDay+1 {
+				errs = append(errs, fmt.Errorf("%s plays more than %d game(s) on %s (max_games_per_day_per_team)",
+					team, maxPerDay, a.Slot.Date.Format("Mon 01/02")))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
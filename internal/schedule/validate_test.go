@@ -0,0 +1,67 @@
+package schedule
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+func TestResultValidate(t *testing.T) {
+	cfg := schedulerTestConfig()
+	sat := mustDate("2026-05-02")
+	game := func(home, away, hhmm, field string) Assignment {
+		return Assignment{
+			Game: strategy.Game{Home: home, Away: away},
+			Slot: Slot{Date: sat, Time: hhmm, Field: field},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		assignments []Assignment
+		want        string
+	}{
+		{"clean", []Assignment{
+			game("Angels", "Astros", "12:30", "Symonds Field"),
+			game("Cubs", "Padres", "12:30", "Washington Park"),
+		}, ""},
+		{"double-booked slot", []Assignment{
+			game("Angels", "Astros", "12:30", "Symonds Field"),
+			game("Cubs", "Padres", "12:30", "Symonds Field"),
+		}, "Padres @ Cubs and Astros @ Angels are both at Sat 05/02 12:30 on Symonds Field"},
+		{"team in two places at once", []Assignment{
+			game("Angels", "Astros", "12:30", "Symonds Field"),
+			game("Cubs", "Angels", "12:30", "Washington Park"),
+		}, "Angels plays twice at Sat 05/02 12:30"},
+		{"over the daily cap", []Assignment{
+			game("Angels", "Astros", "12:30", "Symonds Field"),
+			game("Cubs", "Angels", "17:00", "Washington Park"),
+		}, "Angels plays more than 1 game(s) on Sat 05/02 (max_games_per_day_per_team)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&Result{Assignments: tt.assignments}).Validate(cfg)
+			if tt.want == "" {
+				if err != nil {
+					t.Errorf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Validate() = %v, want %q", err, tt.want)
+			}
+		})
+	}
+
+	t.Run("Schedule results pass", func(t *testing.T) {
+		games := (&strategy.DivisionWeighted{}).GenerateMatchups(cfg.Divisions)
+		result, err := Schedule(cfg, GenerateSlots(cfg), nil, games)
+		if err != nil {
+			t.Fatalf("Schedule() error: %v", err)
+		}
+		if err := result.Validate(cfg); err != nil {
+			t.Errorf("Validate() = %v", err)
+		}
+	})
+}
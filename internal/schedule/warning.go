@@ -0,0 +1,36 @@
+package schedule
+
+// Warning categories, one per guideline buildMetrics checks.
+const (
+	WarningOffDate           = "off-date"            // game on a team's preferred_off_dates
+	WarningThreeInFour       = "3-in-4"              // three games in four days
+	WarningRematch           = "rematch"             // same matchup too soon
+	WarningGroupSpacing      = "group-spacing"       // opponent group games too close together
+	WarningSaturdayFloor     = "saturday-floor"      // fewer Saturday games than min_saturday_games
+	WarningByeWeeks          = "bye-weeks"           // too many straight weeks without a game
+	WarningStrongStreak      = "strong-streak"       // too many above-average opponents in a row
+	WarningSameField         = "same-field"          // three or more games at one field in a week
+	WarningBothWeekendDays   = "both-weekend-days"   // a team plays Saturday and Sunday of one weekend
+	WarningDistinctFields    = "distinct-fields"     // a team plays at more fields than max_distinct_fields
+	WarningOpeningAwayStreak = "opening-away-streak" // a team opens with more away games than max_opening_away_streak
+	WarningTimeslotSkew      = "timeslot-skew"       // a team stuck at one time of day
+	WarningDivisionTimeslots = "division-timeslots"  // shared timeslots filled by one division
+	WarningSundayImbalance   = "sunday-imbalance"    // Sunday games spread across teams
+	WarningPaceImbalance     = "pace-imbalance"      // games-played spread at the end of a week
+	WarningPastTarget        = "past-target"         // games after target_end_date
+	WarningOverflow          = "overflow"            // games in the overflow period
+)
+
+// Warning is a guideline the schedule doesn't meet. Teams lists the teams
+// involved, in the order the message names them; it is empty for warnings
+// about the league as a whole, such as Sunday imbalance or overflow.
+type Warning struct {
+	Category string
+	Teams    []string
+	Message  string
+}
+
+// String returns the warning's message, as the CLI prints it.
+func (w Warning) String() string {
+	return w.Message
+}
@@ -0,0 +1,46 @@
+package schedule
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+func TestBuildMetricsWarnings(t *testing.T) {
+	cfg := schedulerTestConfig()
+	s := newScheduler(cfg, nil, nil, nil)
+	s.assign(strategy.Game{Home: "Angels", Away: "Astros"}, Slot{Date: mustDate("2026-05-03"), Time: "17:00", Field: "Symonds Field"})
+	s.assign(strategy.Game{Home: "Astros", Away: "Angels"}, Slot{Date: mustDate("2026-05-05"), Time: "17:45", Field: "Symonds Field"})
+	s.assign(strategy.Game{Home: "Angels", Away: "Cubs"}, Slot{Date: mustDate("2026-05-17"), Time: "17:00", Field: "Symonds Field"})
+
+	warnings, metrics := s.buildMetrics()
+	byCategory := make(map[string]Warning)
+	for _, w := range warnings {
+		if w.String() != w.Message {
+			t.Errorf("String() = %q, want the message %q", w.String(), w.Message)
+		}
+		byCategory[w.Category] = w
+	}
+
+	rematch, ok := byCategory[WarningRematch]
+	if !ok {
+		t.Fatalf("no rematch warning in %v", warnings)
+	}
+	if !slices.Equal(rematch.Teams, []string{"Angels", "Astros"}) {
+		t.Errorf("rematch teams = %v, want [Angels Astros]", rematch.Teams)
+	}
+	for _, team := range rematch.Teams {
+		if !slices.Contains(metrics[team].Violations, rematch.Message) {
+			t.Errorf("%s violations = %v, want the rematch warning", team, metrics[team].Violations)
+		}
+	}
+
+	sunday, ok := byCategory[WarningSundayImbalance]
+	if !ok {
+		t.Fatalf("no Sunday imbalance warning in %v", warnings)
+	}
+	if sunday.Teams != nil {
+		t.Errorf("Sunday imbalance teams = %v, want none for a league-wide warning", sunday.Teams)
+	}
+}
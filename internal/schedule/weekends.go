@@ -0,0 +1,59 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+// FullWeekends returns, for each team that plays on both the Saturday and
+// the Sunday of some weekend, those weekends' Saturdays in date order.
+// Teams without one are left out.
+func FullWeekends(assignments []Assignment) map[string][]time.Time {
+	played := make(map[string]map[time.Time]bool)
+	for _, a := range assignments {
+		for _, team := range []string{a.Game.Home, a.Game.Away} {
+			if played[team] == nil {
+				played[team] = make(map[time.Time]bool)
+			}
+			played[team][a.Slot.Date] = true
+		}
+	}
+	full := make(map[string][]time.Time)
+	for team, dates := range played {
+		for d := range dates {
+			if d.Weekday() == time.Saturday && dates[d.AddDate(0, 0, 1)] {
+				full[team] = insertSorted(full[team], d)
+			}
+		}
+	}
+	return full
+}
+
+// otherWeekendDay returns the Sunday after a Saturday or the Saturday
+// before a Sunday, and false for weekdays.
+func otherWeekendDay(d time.Time) (time.Time, bool) {
+	switch d.Weekday() {
+	case time.Saturday:
+		return d.AddDate(0, 0, 1), true
+	case time.Sunday:
+		return d.AddDate(0, 0, -1), true
+	}
+	return time.Time{}, false
+}
+
+// bothWeekendDays counts the game's teams that already play on the other
+// day of the slot's weekend, for avoid_both_weekend_days.
+func (s *scheduler) bothWeekendDays(game strategy.Game, slot Slot) int {
+	other, ok := otherWeekendDay(slot.Date)
+	if !ok {
+		return 0
+	}
+	n := 0
+	for _, team := range []string{game.Home, game.Away} {
+		if s.gamesOn(team, other) > 0 {
+			n++
+		}
+	}
+	return n
+}
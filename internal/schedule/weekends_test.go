@@ -0,0 +1,64 @@
+package schedule
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/derekprior/rbrl/internal/strategy"
+)
+
+func TestFullWeekends(t *testing.T) {
+	game := func(home, away, date string) Assignment {
+		return Assignment{Game: strategy.Game{Home: home, Away: away}, Slot: Slot{Date: mustDate(date), Time: "12:30", Field: "Symonds Field"}}
+	}
+	full := FullWeekends([]Assignment{
+		game("Angels", "Astros", "2026-05-03"), // Sunday
+		game("Cubs", "Angels", "2026-05-02"),   // Saturday
+		game("Angels", "Royals", "2026-05-09"), // Saturday, but not Sunday
+		game("Cubs", "Padres", "2026-05-10"),   // Sunday after a Saturday off
+		game("Royals", "Cubs", "2026-05-04"),   // Monday after a full weekend
+	})
+	want := map[string][]time.Time{"Angels": {mustDate("2026-05-02")}}
+	if len(full) != len(want) || !slices.Equal(full["Angels"], want["Angels"]) {
+		t.Errorf("FullWeekends() = %v, want %v", full, want)
+	}
+}
+
+func TestAvoidBothWeekendDays(t *testing.T) {
+	cfg := schedulerTestConfig()
+	cfg.Guidelines.AvoidBothWeekendDays = true
+	s := newScheduler(cfg, nil, nil, nil)
+	s.assign(strategy.Game{Home: "Angels", Away: "Astros", Label: "Game 1"}, Slot{Date: mustDate("2026-05-02"), Time: "12:30", Field: "Symonds Field"})
+
+	game := strategy.Game{Home: "Angels", Away: "Cubs"}
+	sunday := Slot{Date: mustDate("2026-05-03"), Time: "17:00", Field: "Symonds Field"}
+	nextSunday := Slot{Date: mustDate("2026-05-10"), Time: "17:00", Field: "Symonds Field"}
+	if n := s.bothWeekendDays(game, sunday); n != 1 {
+		t.Errorf("bothWeekendDays on the Sunday after an Angels Saturday = %d, want 1", n)
+	}
+	if n := s.bothWeekendDays(game, nextSunday); n != 0 {
+		t.Errorf("bothWeekendDays the following Sunday = %d, want 0", n)
+	}
+	if n := s.bothWeekendDays(game, Slot{Date: mustDate("2026-05-04")}); n != 0 {
+		t.Errorf("bothWeekendDays on a Monday = %d, want 0", n)
+	}
+
+	s.assign(game, sunday)
+	warnings, metrics := s.buildMetrics()
+	if metrics["Angels"].FullWeekends != 1 || metrics["Cubs"].FullWeekends != 0 {
+		t.Errorf("FullWeekends = Angels %d, Cubs %d; want 1 and 0", metrics["Angels"].FullWeekends, metrics["Cubs"].FullWeekends)
+	}
+	found := false
+	for _, w := range warnings {
+		if w.Category == WarningBothWeekendDays {
+			found = true
+			if !slices.Equal(w.Teams, []string{"Angels"}) {
+				t.Errorf("warning teams = %v, want [Angels]", w.Teams)
+			}
+		}
+	}
+	if !found {
+		t.Error("no both-weekend-days warning")
+	}
+}
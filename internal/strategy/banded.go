@@ -0,0 +1,164 @@
+package strategy
+
+import (
+	"fmt"
+
+	"github.com/derekprior/rbrl/internal/config"
+)
+
+// Banded generates matchups so every team plays between Min and Max games,
+// ignoring divisions. Each game goes to the team with the fewest games so
+// far, against an opponent still short of Min that it has met least (then
+// the one with the fewest games), so pairs meet evenly.
+// Teams end within one game of each other; Max only matters when Min games
+// each can't pair up evenly. Home and away are then
+// balanced so each team's differ by at most one.
+type Banded struct {
+	Min, Max int
+}
+
+func (s *Banded) GenerateMatchups(divisions []config.Division) []Game {
+	var teams []string
+	for _, div := range divisions {
+		teams = append(teams, div.Teams...)
+	}
+	most := max(s.Max, s.Min)
+
+	games := make(map[string]int)
+	met := make(map[[2]string]int)
+	pair := func(a, b string) [2]string {
+		if b < a {
+			a, b = b, a
+		}
+		return [2]string{a, b}
+	}
+
+	var out []Game
+	for {
+		team := ""
+		for _, t := range teams {
+			if games[t] < s.Min && (team == "" || games[t] < games[team]) {
+				team = t
+			}
+		}
+		if team == "" {
+			break
+		}
+
+		opponent := ""
+		for _, o := range teams {
+			if o == team || games[o] >= most {
+				continue
+			}
+			if opponent == "" || s.better(team, o, opponent, games, met[pair(team, o)], met[pair(team, opponent)]) {
+				opponent = o
+			}
+		}
+		if opponent == "" {
+			break // no one left under Max; config validation rules this out
+		}
+
+		out = append(out, Game{Home: team, Away: opponent, Label: fmt.Sprintf("Game %d", len(out)+1)})
+		games[team]++
+		games[opponent]++
+		met[pair(team, opponent)]++
+	}
+	balanceHomes(out)
+	return out
+}
+
+// better reports whether o is a better opponent for team than cur: one
+// still short of Min first, then the one team has met least, then the one
+// with fewer games. metO and metCur are how often team has met each.
+func (s *Banded) better(team, o, cur string, games map[string]int, metO, metCur int) bool {
+	if shortO, shortCur := games[o] < s.Min, games[cur] < s.Min; shortO != shortCur {
+		return shortO
+	}
+	if metO != metCur {
+		return metO < metCur
+	}
+	return games[o] < games[cur]
+}
+
+// balanceHomes flips home and away until every team's home and away games
+// differ by at most one. A team with two or more extra home games follows
+// games from home team to away team until it reaches a team short of home
+// games, then flips every game on that path: the ends move toward even and
+// the teams in between are unchanged. Teams short of home games do the same
+// in reverse. A partner is always reachable, since the teams reachable this
+// way can't all lean the same way as the team that started.
+func balanceHomes(games []Game) {
+	surplus := make(map[string]int) // home games minus away games
+	var teams []string
+	for _, g := range games {
+		for _, team := range []string{g.Home, g.Away} {
+			if _, ok := surplus[team]; !ok {
+				teams = append(teams, team)
+				surplus[team] = 0
+			}
+		}
+		surplus[g.Home]++
+		surplus[g.Away]--
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for _, team := range teams {
+			switch {
+			case surplus[team] >= 2:
+				changed = flipPath(games, surplus, team, 1) || changed
+			case surplus[team] <= -2:
+				changed = flipPath(games, surplus, team, -1) || changed
+			}
+		}
+	}
+}
+
+// flipPath searches breadth-first from start for a team leaning the other
+// way and flips the games on the path. dir is 1 when start has extra home
+// games (follow home -> away) and -1 when it has extra away games (follow
+// away -> home). Reports whether it found a path.
+func flipPath(games []Game, surplus map[string]int, start string, dir int) bool {
+	from := func(g Game) (string, string) {
+		if dir > 0 {
+			return g.Home, g.Away
+		}
+		return g.Away, g.Home
+	}
+
+	via := map[string]int{start: -1} // team -> index of the game that reached it
+	queue := []string{start}
+	end := ""
+	for len(queue) > 0 && end == "" {
+		team := queue[0]
+		queue = queue[1:]
+		for i, g := range games {
+			a, b := from(g)
+			if a != team {
+				continue
+			}
+			if _, seen := via[b]; seen {
+				continue
+			}
+			via[b] = i
+			if surplus[b]*dir <= -1 {
+				end = b
+				break
+			}
+			queue = append(queue, b)
+		}
+	}
+	if end == "" {
+		return false
+	}
+
+	for team := end; team != start; {
+		i := via[team]
+		prev, _ := from(games[i])
+		games[i].Home, games[i].Away = games[i].Away, games[i].Home
+		team = prev
+	}
+	surplus[start] -= 2 * dir
+	surplus[end] += 2 * dir
+	return true
+}
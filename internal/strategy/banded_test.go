@@ -0,0 +1,97 @@
+package strategy
+
+import (
+	"testing"
+
+	"github.com/derekprior/rbrl/internal/config"
+)
+
+func TestBandedMatchups(t *testing.T) {
+	divs := []config.Division{
+		{Name: "American", Teams: []string{"Angels", "Astros", "Athletics", "Mariners", "Royals"}},
+		{Name: "National", Teams: []string{"Cubs", "Padres", "Phillies", "Pirates"}},
+	}
+	tally := func(games []Game) (counts, homes map[string]int, met map[[2]string]int) {
+		counts, homes, met = make(map[string]int), make(map[string]int), make(map[[2]string]int)
+		for _, g := range games {
+			counts[g.Home]++
+			counts[g.Away]++
+			homes[g.Home]++
+			a, b := g.Home, g.Away
+			if b < a {
+				a, b = b, a
+			}
+			met[[2]string{a, b}]++
+		}
+		return counts, homes, met
+	}
+
+	tests := []struct {
+		name     string
+		min, max int
+	}{
+		{"exact count", 10, 10},
+		{"odd total needs one extra game", 11, 12},
+		{"wide band stays at the minimum", 10, 12},
+		{"max defaults to min", 8, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			games := (&Banded{Min: tt.min, Max: tt.max}).GenerateMatchups(divs)
+			counts, homes, met := tally(games)
+			hi := max(tt.max, tt.min)
+			extra := 0
+			for _, div := range divs {
+				for _, team := range div.Teams {
+					n := counts[team]
+					if n < tt.min || n > hi {
+						t.Errorf("%s plays %d games, want %d–%d", team, n, tt.min, hi)
+					}
+					extra += n - tt.min
+					if away := n - homes[team]; homes[team]-away > 1 || away-homes[team] > 1 {
+						t.Errorf("%s is %d home, %d away; want within one", team, homes[team], away)
+					}
+				}
+			}
+			if extra > 1 {
+				t.Errorf("%d games over the minimum, want at most 1", extra)
+			}
+
+			// Nine teams and at least eight games each: every pair meets
+			// once before any pair meets a third time
+			least, most := len(games), 0
+			for i, a := range []string{"Angels", "Astros", "Athletics", "Mariners", "Royals", "Cubs", "Padres", "Phillies", "Pirates"} {
+				for _, b := range []string{"Angels", "Astros", "Athletics", "Mariners", "Royals", "Cubs", "Padres", "Phillies", "Pirates"}[i+1:] {
+					p := [2]string{a, b}
+					if b < a {
+						p = [2]string{b, a}
+					}
+					least, most = min(least, met[p]), max(most, met[p])
+				}
+			}
+			if least < 1 || most-least > 1 {
+				t.Errorf("pairs meet between %d and %d times, want everyone at least once and within one", least, most)
+			}
+		})
+	}
+
+	t.Run("band wider than needed stays at the minimum", func(t *testing.T) {
+		ten := []config.Division{
+			{Name: "American", Teams: []string{"Angels", "Astros", "Athletics", "Mariners", "Royals"}},
+			{Name: "National", Teams: []string{"Cubs", "Padres", "Phillies", "Pirates", "Marlins"}},
+		}
+		counts, _, _ := tally((&Banded{Min: 11, Max: 12}).GenerateMatchups(ten))
+		for team, n := range counts {
+			if n != 11 {
+				t.Errorf("%s plays %d games, want 11", team, n)
+			}
+		}
+	})
+
+	t.Run("labels are numbered", func(t *testing.T) {
+		games := (&Banded{Min: 2}).GenerateMatchups(divs[:1])
+		if len(games) != 5 || games[0].Label != "Game 1" || games[4].Label != "Game 5" {
+			t.Errorf("games = %+v, want Game 1 through Game 5", games)
+		}
+	})
+}
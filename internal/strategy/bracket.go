@@ -0,0 +1,107 @@
+package strategy
+
+import (
+	"fmt"
+
+	"github.com/derekprior/rbrl/internal/config"
+)
+
+// Bracket generates a single-elimination playoff. Seeds lists teams best
+// first; when empty, teams are seeded in division order. When the field is
+// not a power of two, the top seeds get first-round byes. Later-round games
+// use placeholders like "Winner G1" and depend on the games that feed them.
+type Bracket struct {
+	Seeds []string
+}
+
+func (s *Bracket) GenerateMatchups(divisions []config.Division) []Game {
+	seeds := s.Seeds
+	if len(seeds) == 0 {
+		for _, div := range divisions {
+			seeds = append(seeds, div.Teams...)
+		}
+	}
+	if len(seeds) < 2 {
+		return nil
+	}
+
+	size := 1
+	rounds := 0
+	for size < len(seeds) {
+		size *= 2
+		rounds++
+	}
+
+	// entrant is a bracket line: a team name or a placeholder for the
+	// winner of an earlier game, along with that game's label.
+	type entrant struct {
+		name  string
+		label string
+	}
+	var entrants []*entrant
+	for _, seed := range bracketOrder(size) {
+		if seed <= len(seeds) {
+			entrants = append(entrants, &entrant{name: seeds[seed-1]})
+		} else {
+			entrants = append(entrants, nil) // bye
+		}
+	}
+
+	var games []Game
+	gameNum := 1
+	for round := 1; round <= rounds; round++ {
+		var next []*entrant
+		for i := 0; i < len(entrants); i += 2 {
+			home, away := entrants[i], entrants[i+1]
+			if home == nil || away == nil {
+				// Bye: the present entrant advances without a game
+				if home == nil {
+					home = away
+				}
+				next = append(next, home)
+				continue
+			}
+
+			label := fmt.Sprintf("%s G%d", roundName(round, rounds), gameNum)
+			game := Game{Home: home.name, Away: away.name, Label: label, Round: round}
+			for _, e := range []*entrant{home, away} {
+				if e.label != "" {
+					game.DependsOn = append(game.DependsOn, e.label)
+				}
+			}
+			games = append(games, game)
+			next = append(next, &entrant{name: fmt.Sprintf("Winner G%d", gameNum), label: label})
+			gameNum++
+		}
+		entrants = next
+	}
+
+	return games
+}
+
+// bracketOrder returns seed numbers in bracket line order for a bracket of
+// the given power-of-two size, so that 1 and 2 can only meet in the final.
+func bracketOrder(size int) []int {
+	order := []int{1}
+	for n := 2; n <= size; n *= 2 {
+		var next []int
+		for _, seed := range order {
+			next = append(next, seed, n+1-seed)
+		}
+		order = next
+	}
+	return order
+}
+
+func roundName(round, rounds int) string {
+	switch rounds - round {
+	case 0:
+		return "Final"
+	case 1:
+		return "Semifinal"
+	case 2:
+		return "Quarterfinal"
+	default:
+		return fmt.Sprintf("Round %d", round)
+	}
+}
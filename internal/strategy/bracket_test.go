@@ -0,0 +1,79 @@
+package strategy
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/derekprior/rbrl/internal/config"
+)
+
+func TestBracketOrder(t *testing.T) {
+	got := bracketOrder(8)
+	want := []int{1, 8, 4, 5, 2, 7, 3, 6}
+	if !slices.Equal(got, want) {
+		t.Errorf("bracketOrder(8) = %v, want %v", got, want)
+	}
+}
+
+func TestBracketMatchups(t *testing.T) {
+	t.Run("full bracket of four", func(t *testing.T) {
+		s := &Bracket{Seeds: []string{"Angels", "Cubs", "Padres", "Royals"}}
+		games := s.GenerateMatchups(nil)
+		if len(games) != 3 {
+			t.Fatalf("games = %d, want 3", len(games))
+		}
+		want := []Game{
+			{Home: "Angels", Away: "Royals", Label: "Semifinal G1", Round: 1},
+			{Home: "Cubs", Away: "Padres", Label: "Semifinal G2", Round: 1},
+			{Home: "Winner G1", Away: "Winner G2", Label: "Final G3", Round: 2,
+				DependsOn: []string{"Semifinal G1", "Semifinal G2"}},
+		}
+		for i, w := range want {
+			g := games[i]
+			if g.Home != w.Home || g.Away != w.Away || g.Label != w.Label || g.Round != w.Round ||
+				!slices.Equal(g.DependsOn, w.DependsOn) {
+				t.Errorf("game %d = %+v, want %+v", i, g, w)
+			}
+		}
+	})
+
+	t.Run("top seeds get byes", func(t *testing.T) {
+		s := &Bracket{Seeds: []string{"S1", "S2", "S3", "S4", "S5", "S6"}}
+		games := s.GenerateMatchups(nil)
+		// Single elimination always needs one fewer game than teams
+		if len(games) != 5 {
+			t.Fatalf("games = %d, want 5", len(games))
+		}
+		for _, g := range games {
+			if g.Round == 1 && (g.Home == "S1" || g.Home == "S2" || g.Away == "S1" || g.Away == "S2") {
+				t.Errorf("top seed plays in round 1: %+v", g)
+			}
+		}
+		if games[2].Home != "S1" || games[2].Away != "Winner G1" {
+			t.Errorf("first semifinal = %s vs %s, want S1 vs Winner G1", games[2].Home, games[2].Away)
+		}
+	})
+
+	t.Run("seeds default to division order", func(t *testing.T) {
+		divs := []config.Division{{Name: "A", Teams: []string{"T1", "T2"}}}
+		games := (&Bracket{}).GenerateMatchups(divs)
+		if len(games) != 1 || games[0].Home != "T1" || games[0].Label != "Final G1" {
+			t.Errorf("games = %+v, want T1 hosting the final", games)
+		}
+	})
+}
+
+func TestFromConfig(t *testing.T) {
+	cfg := &config.Config{
+		Strategy: "bracket",
+		Playoffs: config.Playoffs{Seeds: []string{"Cubs", "Angels"}},
+	}
+	strat, err := FromConfig(cfg)
+	if err != nil {
+		t.Fatalf("FromConfig() error: %v", err)
+	}
+	b, ok := strat.(*Bracket)
+	if !ok || !slices.Equal(b.Seeds, cfg.Playoffs.Seeds) {
+		t.Errorf("FromConfig() = %#v, want Bracket with configured seeds", strat)
+	}
+}
@@ -0,0 +1,81 @@
+package strategy
+
+import (
+	"fmt"
+
+	"github.com/derekprior/rbrl/internal/config"
+)
+
+// Fixed generates exactly the games listed in the config, such as
+// traditional exhibition games layered onto a regular slate.
+type Fixed struct {
+	Games []config.FixedGame
+}
+
+func (s *Fixed) GenerateMatchups(divisions []config.Division) []Game {
+	games := make([]Game, len(s.Games))
+	for i, g := range s.Games {
+		games[i] = Game{Home: g.Home, Away: g.Away, Label: fmt.Sprintf("Game %d", i+1), WeekendOnly: g.WeekendOnly, Note: g.Note}
+	}
+	return games
+}
+
+// Part is one strategy of a Composite.
+type Part struct {
+	Strategy     Strategy
+	AllowRepeats bool // keep games between pairs earlier parts already play
+}
+
+// Composite concatenates its parts' matchups, in order. A part's game
+// between two teams an earlier part already pairs is dropped unless the
+// part allows repeats. Labels that clash with an earlier part's are
+// renumbered to the next free "Game N", with dependencies following them.
+type Composite struct {
+	Parts []Part
+}
+
+func (s *Composite) GenerateMatchups(divisions []config.Division) []Game {
+	var games []Game
+	used := make(map[string]bool)
+	next := 1
+	for _, part := range s.Parts {
+		earlier := make(map[[2]string]bool)
+		for _, g := range games {
+			earlier[pairKey(g.Home, g.Away)] = true
+		}
+		renamed := make(map[string]string)
+		for _, g := range part.Strategy.GenerateMatchups(divisions) {
+			if !part.AllowRepeats && earlier[pairKey(g.Home, g.Away)] {
+				continue
+			}
+			if used[g.Label] {
+				for used[fmt.Sprintf("Game %d", next)] {
+					next++
+				}
+				renamed[g.Label] = fmt.Sprintf("Game %d", next)
+				g.Label = renamed[g.Label]
+			}
+			if len(g.DependsOn) > 0 {
+				deps := make([]string, len(g.DependsOn))
+				for i, dep := range g.DependsOn {
+					if label, ok := renamed[dep]; ok {
+						dep = label
+					}
+					deps[i] = dep
+				}
+				g.DependsOn = deps
+			}
+			used[g.Label] = true
+			games = append(games, g)
+		}
+	}
+	return games
+}
+
+// pairKey identifies two teams regardless of home and away.
+func pairKey(a, b string) [2]string {
+	if b < a {
+		a, b = b, a
+	}
+	return [2]string{a, b}
+}
@@ -0,0 +1,88 @@
+package strategy
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/derekprior/rbrl/internal/config"
+)
+
+func TestComposite(t *testing.T) {
+	divs := []config.Division{
+		{Name: "American", Teams: []string{"Angels", "Astros"}},
+		{Name: "National", Teams: []string{"Cubs", "Padres"}},
+	}
+	exhibitions := &Fixed{Games: []config.FixedGame{
+		{Home: "Cubs", Away: "Angels"},   // already an inter-division game
+		{Home: "Padres", Away: "Cubs"},   // already an intra-division game
+		{Home: "Astros", Away: "Padres"}, // already an inter-division game
+	}}
+	base := (&DivisionWeighted{}).GenerateMatchups(divs)
+
+	t.Run("repeats dropped by default", func(t *testing.T) {
+		games := (&Composite{Parts: []Part{{Strategy: &DivisionWeighted{}}, {Strategy: exhibitions}}}).GenerateMatchups(divs)
+		if len(games) != len(base) {
+			t.Errorf("games = %d, want the base %d", len(games), len(base))
+		}
+	})
+
+	t.Run("repeats kept when allowed, with fresh labels", func(t *testing.T) {
+		games := (&Composite{Parts: []Part{{Strategy: &DivisionWeighted{}}, {Strategy: exhibitions, AllowRepeats: true}}}).GenerateMatchups(divs)
+		if len(games) != len(base)+3 {
+			t.Fatalf("games = %d, want %d", len(games), len(base)+3)
+		}
+		last := games[len(games)-1]
+		if last.Home != "Astros" || last.Away != "Padres" || last.Label != "Game 11" {
+			t.Errorf("last game = %+v, want Astros vs Padres labeled Game 11", last)
+		}
+		var labels []string
+		for _, g := range games {
+			labels = append(labels, g.Label)
+		}
+		slices.Sort(labels)
+		if len(slices.Compact(labels)) != len(games) {
+			t.Errorf("labels = %v, want all distinct", labels)
+		}
+	})
+
+	t.Run("dependencies follow renamed labels", func(t *testing.T) {
+		bracket := &Bracket{Seeds: []string{"Angels", "Astros", "Cubs", "Padres"}}
+		games := (&Composite{Parts: []Part{{Strategy: bracket}, {Strategy: bracket, AllowRepeats: true}}}).GenerateMatchups(divs)
+		if len(games) != 6 {
+			t.Fatalf("games = %d, want two brackets of 3", len(games))
+		}
+		final := games[5]
+		if len(final.DependsOn) != 2 {
+			t.Fatalf("final = %+v, want two dependencies", final)
+		}
+		for _, dep := range final.DependsOn {
+			if !slices.ContainsFunc(games[3:5], func(g Game) bool { return g.Label == dep }) {
+				t.Errorf("final depends on %q, not a semifinal", dep)
+			}
+		}
+	})
+}
+
+func TestFromConfigStrategies(t *testing.T) {
+	cfg := &config.Config{
+		Divisions: []config.Division{{Name: "A", Teams: []string{"Angels", "Astros", "Cubs"}}},
+		Strategies: []config.StrategyBlock{
+			{Strategy: "division_weighted"},
+			{Strategy: "fixed", Games: []config.FixedGame{{Home: "Cubs", Away: "Angels", WeekendOnly: true, Note: "Opening Day Ceremony"}}, AllowRepeats: true},
+		},
+	}
+	strat, err := FromConfig(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	games := strat.GenerateMatchups(cfg.Divisions)
+	if len(games) != 7 {
+		t.Fatalf("games = %d, want 6 round robin games plus 1 exhibition", len(games))
+	}
+	if !games[6].WeekendOnly || games[0].WeekendOnly {
+		t.Error("want only the exhibition marked weekend_only")
+	}
+	if games[6].Note != "Opening Day Ceremony" || games[0].Note != "" {
+		t.Error("want only the exhibition to carry the note")
+	}
+}
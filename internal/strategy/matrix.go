@@ -0,0 +1,50 @@
+package strategy
+
+import (
+	"fmt"
+
+	"github.com/derekprior/rbrl/internal/config"
+)
+
+// Matrix generates exactly Meetings[pair] games between each pair of teams,
+// keyed with the name that sorts first. Each pair alternates home and away,
+// so its split differs by at most one; the odd games of pairs meeting an
+// odd number of times are then given to whichever side evens out each
+// team's season.
+type Matrix struct {
+	Meetings map[[2]string]int
+}
+
+func (s *Matrix) GenerateMatchups(divisions []config.Division) []Game {
+	var teams []string
+	for _, div := range divisions {
+		teams = append(teams, div.Teams...)
+	}
+
+	var out, odd []Game
+	for i, a := range teams {
+		for _, b := range teams[i+1:] {
+			key := [2]string{a, b}
+			if b < a {
+				key = [2]string{b, a}
+			}
+			n := s.Meetings[key]
+			for j := range n / 2 * 2 {
+				if j%2 == 0 {
+					out = append(out, Game{Home: a, Away: b})
+				} else {
+					out = append(out, Game{Home: b, Away: a})
+				}
+			}
+			if n%2 == 1 {
+				odd = append(odd, Game{Home: a, Away: b})
+			}
+		}
+	}
+	balanceHomes(odd)
+	out = append(out, odd...)
+	for i := range out {
+		out[i].Label = fmt.Sprintf("Game %d", i+1)
+	}
+	return out
+}
@@ -0,0 +1,66 @@
+package strategy
+
+import (
+	"testing"
+
+	"github.com/derekprior/rbrl/internal/config"
+)
+
+func TestMatrixMatchups(t *testing.T) {
+	divs := []config.Division{
+		{Name: "American", Teams: []string{"Angels", "Astros", "Royals"}},
+		{Name: "National", Teams: []string{"Cubs", "Padres"}},
+	}
+	meetings := map[[2]string]int{
+		{"Angels", "Astros"}: 3,
+		{"Angels", "Royals"}: 2,
+		{"Astros", "Royals"}: 1,
+		{"Angels", "Cubs"}:   1,
+		{"Cubs", "Royals"}:   1,
+		{"Cubs", "Padres"}:   4,
+		{"Astros", "Padres"}: 1,
+		{"Padres", "Royals"}: 0,
+	}
+	games := (&Matrix{Meetings: meetings}).GenerateMatchups(divs)
+
+	met := make(map[[2]string]int)
+	pairHomes := make(map[[2]string]int) // games hosted by the first team of the pair
+	homes, counts := make(map[string]int), make(map[string]int)
+	labels := make(map[string]bool)
+	for _, g := range games {
+		key := [2]string{g.Home, g.Away}
+		if g.Away < g.Home {
+			key = [2]string{g.Away, g.Home}
+		}
+		met[key]++
+		if g.Home == key[0] {
+			pairHomes[key]++
+		}
+		homes[g.Home]++
+		counts[g.Home]++
+		counts[g.Away]++
+		if labels[g.Label] {
+			t.Errorf("duplicate label %q", g.Label)
+		}
+		labels[g.Label] = true
+	}
+
+	total := 0
+	for key, n := range meetings {
+		total += n
+		if met[key] != n {
+			t.Errorf("%s vs %s met %d times, want %d", key[0], key[1], met[key], n)
+		}
+		if h := pairHomes[key]; 2*h-n > 1 || n-2*h > 1 {
+			t.Errorf("%s hosts %s %d of %d times; want within one of even", key[0], key[1], h, n)
+		}
+	}
+	if len(games) != total {
+		t.Errorf("got %d games, want %d", len(games), total)
+	}
+	for team, n := range counts {
+		if away := n - homes[team]; homes[team]-away > 1 || away-homes[team] > 1 {
+			t.Errorf("%s is %d home, %d away; want within one", team, homes[team], away)
+		}
+	}
+}
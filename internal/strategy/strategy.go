@@ -8,9 +8,18 @@ import (
 
 // Game represents a single matchup between two teams.
 type Game struct {
-	Home  string
-	Away  string
-	Label string // unique identifier like "Game 1"
+	Home      string
+	Away      string
+	Label     string   // unique identifier like "Game 1"
+	Round     int      // bracket or round-robin round (1 = first); 0 when not applicable
+	DependsOn []string // labels of games that must be played first
+
+	// WeekendOnly keeps the game off weekdays, for showcase matchups.
+	WeekendOnly bool
+
+	// Note annotates the game in the workbook and exports, e.g.
+	// "Opening Day Ceremony".
+	Note string
 }
 
 // Strategy generates the list of matchups for a season.
@@ -23,28 +32,101 @@ func Get(name string) (Strategy, error) {
 	switch name {
 	case "division_weighted":
 		return &DivisionWeighted{}, nil
+	case "bracket":
+		return &Bracket{}, nil
+	case "banded":
+		return &Banded{}, nil
+	case "fixed":
+		return &Fixed{}, nil
+	case "matrix":
+		return &Matrix{}, nil
 	default:
 		return nil, fmt.Errorf("unknown strategy: %q", name)
 	}
 }
 
+// FromConfig returns the Strategy named in the config, with any
+// strategy-specific settings applied. A config listing strategies gets a
+// Composite of them.
+func FromConfig(cfg *config.Config) (Strategy, error) {
+	if len(cfg.Strategies) == 0 {
+		return configured(cfg, cfg.Strategy, nil)
+	}
+	composite := &Composite{}
+	for _, b := range cfg.Strategies {
+		strat, err := configured(cfg, b.Strategy, b.Games)
+		if err != nil {
+			return nil, err
+		}
+		composite.Parts = append(composite.Parts, Part{Strategy: strat, AllowRepeats: b.AllowRepeats})
+	}
+	return composite, nil
+}
+
+// configured returns the named Strategy with the config's settings for it;
+// games are the matchups of a fixed strategy.
+func configured(cfg *config.Config, name string, games []config.FixedGame) (Strategy, error) {
+	strat, err := Get(name)
+	if err != nil {
+		return nil, err
+	}
+	switch st := strat.(type) {
+	case *Bracket:
+		st.Seeds = cfg.Playoffs.Seeds
+	case *DivisionWeighted:
+		st.HomeWeights = cfg.HomeWeights()
+	case *Banded:
+		st.Min, st.Max = cfg.Banded.MinGamesPerTeam, cfg.Banded.MaxGamesPerTeam
+	case *Fixed:
+		st.Games = games
+	case *Matrix:
+		st.Meetings = cfg.Meetings()
+	}
+	return strat, nil
+}
+
 // DivisionWeighted generates matchups where intra-division opponents play
-// twice and inter-division opponents play once.
-type DivisionWeighted struct{}
+// twice and inter-division opponents play once. Each inter-division game
+// goes home to the team with fewer home games so far relative to its away
+// games, ties going to the name that sorts first. Without HomeWeights, a
+// final balanceHomes pass then flips inter-division games until every
+// team's home and away games differ by at most one.
+//
+// HomeWeights tilts that split: a game between teams weighted a and b is
+// worth a/(a+b) of a home game to the first, and goes home to whichever
+// team is further behind its expected home games so far. Weights default
+// to 1.
+//
+// Games are numbered into rounds in which each team plays at most once:
+// the first leg of the intra-division round robin, then the return leg,
+// then the inter-division games. Rounds group the matchups logically; the
+// scheduler still places them freely in time.
+type DivisionWeighted struct {
+	HomeWeights map[string]float64
+}
 
 func (s *DivisionWeighted) GenerateMatchups(divisions []config.Division) []Game {
 	var games []Game
 	gameNum := 1
 
+	// Every division's legs share round numbers, so a leg lasts as many
+	// rounds as the largest division needs.
+	legRounds := 0
+	for _, div := range divisions {
+		legRounds = max(legRounds, circleRounds(len(div.Teams)))
+	}
+
 	// Intra-division: each pair plays twice (home/away split)
 	for _, div := range divisions {
 		for i := 0; i < len(div.Teams); i++ {
 			for j := i + 1; j < len(div.Teams); j++ {
+				round := circleRound(len(div.Teams), i, j)
 				games = append(games,
 					Game{
 						Home:  div.Teams[i],
 						Away:  div.Teams[j],
 						Label: fmt.Sprintf("Game %d", gameNum),
+						Round: round,
 					},
 				)
 				gameNum++
@@ -53,6 +135,7 @@ func (s *DivisionWeighted) GenerateMatchups(divisions []config.Division) []Game
 						Home:  div.Teams[j],
 						Away:  div.Teams[i],
 						Label: fmt.Sprintf("Game %d", gameNum),
+						Round: legRounds + round,
 					},
 				)
 				gameNum++
@@ -60,25 +143,88 @@ func (s *DivisionWeighted) GenerateMatchups(divisions []config.Division) []Game
 		}
 	}
 
-	// Inter-division: each cross-division pair plays once.
-	// Alternate home/away to balance across teams.
+	net := make(map[string]int) // home games minus away games so far
+	for _, g := range games {
+		net[g.Home]++
+		net[g.Away]--
+	}
+
+	// Inter-division: each cross-division pair plays once, home to
+	// whichever team is further behind. Round k pairs d0[i] with d1[j]
+	// where i+j ≡ k, so each team plays once a round.
+	var inter []Game
 	if len(divisions) == 2 {
 		d0, d1 := divisions[0], divisions[1]
+		interRounds := max(len(d0.Teams), len(d1.Teams))
+		deficit := make(map[string]float64) // expected home games minus actual
 		for i, t0 := range d0.Teams {
 			for j, t1 := range d1.Teams {
+				k := (i + j) % interRounds
 				home, away := t0, t1
-				if (i+j)%2 == 1 {
+				if t1 < t0 {
 					home, away = t1, t0
 				}
-				games = append(games, Game{
+				if len(s.HomeWeights) > 0 {
+					wh, wa := s.homeWeight(home), s.homeWeight(away)
+					deficit[home] += wh / (wh + wa)
+					deficit[away] += wa / (wh + wa)
+					if deficit[away] > deficit[home] {
+						home, away = away, home
+					}
+					deficit[home]--
+				} else if net[away] < net[home] {
+					home, away = away, home
+				}
+				net[home]++
+				net[away]--
+				inter = append(inter, Game{
 					Home:  home,
 					Away:  away,
 					Label: fmt.Sprintf("Game %d", gameNum),
+					Round: 2*legRounds + k + 1,
 				})
 				gameNum++
 			}
 		}
 	}
+	// The greedy pass can leave a team two games off even when a better
+	// split exists; intra-division games are already even, so evening out
+	// the inter-division games evens out each team's season.
+	if len(s.HomeWeights) == 0 {
+		balanceHomes(inter)
+	}
+	games = append(games, inter...)
 
 	return games
 }
+
+// circleRounds returns how many rounds a single round robin of n teams
+// takes: n-1, or n when an odd team count leaves one team idle each round.
+func circleRounds(n int) int {
+	if n < 2 {
+		return 0
+	}
+	if n%2 == 1 {
+		return n
+	}
+	return n - 1
+}
+
+// circleRound returns the round (1 = first) in which teams i and j, i < j,
+// meet in a single round robin of n teams under the circle method. With an
+// even count the last team is the fixed point of the circle.
+func circleRound(n, i, j int) int {
+	r := circleRounds(n)
+	if n%2 == 0 && j == n-1 {
+		return (2*i)%r + 1
+	}
+	return (i+j)%r + 1
+}
+
+// homeWeight returns the team's home weight, defaulting to 1.
+func (s *DivisionWeighted) homeWeight(team string) float64 {
+	if w, ok := s.HomeWeights[team]; ok {
+		return w
+	}
+	return 1
+}
@@ -1,6 +1,7 @@
 package strategy
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/derekprior/rbrl/internal/config"
@@ -13,6 +14,49 @@ func testDivisions() []config.Division {
 	}
 }
 
+func TestDivisionWeightedSingleDivision(t *testing.T) {
+	divs := []config.Division{
+		{Name: "League", Teams: []string{"Angels", "Astros", "Cubs", "Padres", "Royals", "Twins"}},
+	}
+	games := (&DivisionWeighted{}).GenerateMatchups(divs)
+
+	t.Run("double round robin", func(t *testing.T) {
+		// C(6,2) = 15 pairs × 2 games
+		if len(games) != 30 {
+			t.Errorf("total games = %d, want 30", len(games))
+		}
+	})
+
+	t.Run("each pair plays home and away once", func(t *testing.T) {
+		type pair struct{ home, away string }
+		seen := make(map[pair]int)
+		for _, g := range games {
+			seen[pair{g.Home, g.Away}]++
+		}
+		teams := divs[0].Teams
+		for _, a := range teams {
+			for _, b := range teams {
+				if a == b {
+					continue
+				}
+				if n := seen[pair{a, b}]; n != 1 {
+					t.Errorf("%s hosts %s %d times, want 1", a, b, n)
+				}
+			}
+		}
+	})
+
+	t.Run("labels are unique", func(t *testing.T) {
+		labels := make(map[string]bool)
+		for _, g := range games {
+			if labels[g.Label] {
+				t.Errorf("duplicate label %q", g.Label)
+			}
+			labels[g.Label] = true
+		}
+	})
+}
+
 func TestDivisionWeightedMatchups(t *testing.T) {
 	s := &DivisionWeighted{}
 	divs := testDivisions()
@@ -149,3 +193,134 @@ func TestDivisionWeightedSmall(t *testing.T) {
 		}
 	}
 }
+
+func TestDivisionWeightedHomeWeights(t *testing.T) {
+	divs := []config.Division{
+		{Name: "American", Teams: []string{"Angels", "Astros", "Athletics", "Mariners", "Royals"}},
+		{Name: "National", Teams: []string{"Cubs", "Padres", "Phillies", "Pirates", "Marlins"}},
+	}
+	homeCounts := func(games []Game) map[string]int {
+		counts := make(map[string]int)
+		for _, g := range games {
+			counts[g.Home]++
+		}
+		return counts
+	}
+
+	t.Run("no weights splits evenly", func(t *testing.T) {
+		plain := (&DivisionWeighted{}).GenerateMatchups(divs)
+		equal := (&DivisionWeighted{HomeWeights: map[string]float64{"Angels": 1}}).GenerateMatchups(divs)
+		for team, n := range homeCounts(plain) {
+			if n < 6 || n > 7 {
+				t.Errorf("%s has %d home games, want 6 or 7", team, n)
+			}
+			if m := homeCounts(equal)[team]; m < 6 || m > 7 {
+				t.Errorf("with equal weights %s has %d home games, want 6 or 7", team, m)
+			}
+		}
+	})
+
+	t.Run("heavier team gets more home games", func(t *testing.T) {
+		s := &DivisionWeighted{HomeWeights: map[string]float64{"Angels": 3}}
+		games := s.GenerateMatchups(divs)
+		if len(games) != 65 {
+			t.Fatalf("total games = %d, want 65", len(games))
+		}
+		home := homeCounts(games)
+		if home["Angels"] < 8 {
+			t.Errorf("Angels home games = %d, want at least 8 (4 intra + 4 of 5 inter)", home["Angels"])
+		}
+		for _, team := range []string{"Astros", "Athletics", "Mariners", "Royals"} {
+			if home[team] >= home["Angels"] {
+				t.Errorf("%s has %d home games, want fewer than the Angels' %d", team, home[team], home["Angels"])
+			}
+		}
+	})
+}
+
+func TestDivisionWeightedInterDivisionHomes(t *testing.T) {
+	tests := []struct {
+		name string
+		divs []config.Division
+	}{
+		{"five and five", testDivisions()},
+		{"four and three", []config.Division{
+			{Name: "American", Teams: []string{"Angels", "Astros", "Athletics", "Mariners"}},
+			{Name: "National", Teams: []string{"Cubs", "Padres", "Phillies"}},
+		}},
+		{"six and four", []config.Division{
+			{Name: "American", Teams: []string{"Royals", "Angels", "Twins", "Astros", "Mariners", "Athletics"}},
+			{Name: "National", Teams: []string{"Pirates", "Cubs", "Phillies", "Padres"}},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			games := (&DivisionWeighted{}).GenerateMatchups(tt.divs)
+			net := make(map[string]int)
+			for _, g := range games {
+				net[g.Home]++
+				net[g.Away]--
+			}
+			for team, n := range net {
+				// An even number of games splits exactly; an odd number
+				// leaves one extra home or away game.
+				if n < -1 || n > 1 {
+					t.Errorf("%s has %+d home games over away, want within one", team, n)
+				}
+			}
+
+			again := (&DivisionWeighted{}).GenerateMatchups(tt.divs)
+			if !slices.EqualFunc(games, again, func(a, b Game) bool {
+				return a.Home == b.Home && a.Away == b.Away && a.Round == b.Round
+			}) {
+				t.Error("GenerateMatchups is not deterministic")
+			}
+		})
+	}
+}
+
+func TestDivisionWeightedRounds(t *testing.T) {
+	tests := []struct {
+		name   string
+		divs   []config.Division
+		rounds int
+	}{
+		// 5 teams: 5 rounds a leg (one idle each round), then 5 inter rounds
+		{"two divisions of five", testDivisions(), 15},
+		// 6 teams: 5 rounds a leg, no inter-division games
+		{"one division of six", []config.Division{
+			{Name: "League", Teams: []string{"Angels", "Astros", "Cubs", "Padres", "Royals", "Twins"}},
+		}, 10},
+		// legs last as long as the larger division needs; inter rounds
+		// follow the larger division too
+		{"uneven divisions", []config.Division{
+			{Name: "American", Teams: []string{"Angels", "Astros", "Athletics", "Mariners"}},
+			{Name: "National", Teams: []string{"Cubs", "Padres", "Phillies"}},
+		}, 3 + 3 + 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			games := (&DivisionWeighted{}).GenerateMatchups(tt.divs)
+			played := make(map[int]map[string]bool)
+			most := 0
+			for _, g := range games {
+				if g.Round < 1 || g.Round > tt.rounds {
+					t.Fatalf("%s @ %s in round %d, want 1 to %d", g.Away, g.Home, g.Round, tt.rounds)
+				}
+				if played[g.Round] == nil {
+					played[g.Round] = make(map[string]bool)
+				}
+				for _, team := range []string{g.Home, g.Away} {
+					if played[g.Round][team] {
+						t.Errorf("%s plays twice in round %d", team, g.Round)
+					}
+					played[g.Round][team] = true
+				}
+				most = max(most, g.Round)
+			}
+			if most != tt.rounds {
+				t.Errorf("last round = %d, want %d", most, tt.rounds)
+			}
+		})
+	}
+}
@@ -3,11 +3,14 @@ package validator
 import (
 	"fmt"
 	"math"
+	"slices"
 	"sort"
 	"time"
 
 	"github.com/derekprior/rbrl/internal/config"
-	"github.com/xuri/excelize/v2"
+	"github.com/derekprior/rbrl/internal/excel"
+	"github.com/derekprior/rbrl/internal/schedule"
+	"github.com/derekprior/rbrl/internal/strategy"
 )
 
 // Violation represents a constraint violation found during validation.
@@ -18,18 +21,14 @@ type Violation struct {
 	Days    int // for rematch violations: days between games (0 = not applicable)
 }
 
-// Validate reads a schedule Excel file and checks it against the config rules.
+// Validate reads a schedule workbook, or a CSV export of its master sheet
+// when path ends in .csv, and checks it against the config rules.
 func Validate(cfg *config.Config, path string) ([]Violation, error) {
-	f, err := excelize.OpenFile(path)
+	rows, err := excel.ReadMasterRows(path)
 	if err != nil {
-		return nil, fmt.Errorf("opening file: %w", err)
-	}
-	defer f.Close()
-
-	assignments, err := readAssignments(f)
-	if err != nil {
-		return nil, fmt.Errorf("reading assignments: %w", err)
+		return nil, err
 	}
+	assignments := readAssignments(rows)
 
 	var violations []Violation
 
@@ -37,18 +36,36 @@ func Validate(cfg *config.Config, path string) ([]Violation, error) {
 	violations = append(violations, checkMaxGamesPerDay(cfg, assignments)...)
 	violations = append(violations, checkConsecutiveDays(cfg, assignments)...)
 	violations = append(violations, checkMaxGamesPerWeek(cfg, assignments)...)
+	violations = append(violations, checkMaxHomeGamesPerWeek(cfg, assignments)...)
+	violations = append(violations, checkMaxSaturdayGames(cfg, assignments)...)
+	violations = append(violations, checkDistinctFields(cfg, assignments)...)
+	violations = append(violations, checkOpeningAwayStreak(cfg, assignments)...)
 	violations = append(violations, checkMaxGamesPerTimeslot(cfg, assignments)...)
+	violations = append(violations, checkMaxGamesPerDate(cfg, assignments)...)
+
+	violations = append(violations, checkGameOnLegalDate(cfg, assignments)...)
+	violations = append(violations, checkHomeField(cfg, assignments)...)
+	violations = append(violations, checkExcludedFields(cfg, assignments)...)
+	violations = append(violations, checkWeekendOnly(cfg, assignments)...)
+	violations = append(violations, checkMatchupBlackouts(cfg, assignments)...)
+	violations = append(violations, checkFieldOverlap(cfg, assignments)...)
+	violations = append(violations, checkSharedLocation(cfg, assignments)...)
+	violations = append(violations, checkFieldBuffer(cfg, assignments)...)
 
 	// Check soft constraints
 	violations = append(violations, checkRematchProximity(cfg, assignments)...)
 	violations = append(violations, check3In4Days(cfg, assignments)...)
 	violations = append(violations, checkSundayBalance(cfg, assignments)...)
+	violations = append(violations, checkPaceBalance(cfg, assignments)...)
+	violations = append(violations, checkGroupSpacing(cfg, assignments)...)
+	violations = append(violations, checkBothWeekendDays(cfg, assignments)...)
 
 	// Check overflow usage
-	violations = append(violations, checkOverflowUsage(cfg, f, assignments)...)
+	violations = append(violations, checkOverflowUsage(cfg, rows, assignments)...)
 
 	// Check game completeness
 	violations = append(violations, checkGameCompleteness(cfg, assignments)...)
+	violations = append(violations, checkMatchupCounts(cfg, assignments)...)
 
 	return violations, nil
 }
@@ -62,16 +79,8 @@ type parsedGame struct {
 	Away  string
 }
 
-func readAssignments(f *excelize.File) ([]parsedGame, error) {
-	rows, err := f.GetRows("Master Schedule")
-	if err != nil {
-		return nil, fmt.Errorf("reading Master Schedule: %w", err)
-	}
-
-	if len(rows) == 0 {
-		return nil, fmt.Errorf("Master Schedule is empty")
-	}
-
+// readAssignments parses the games from master schedule rows, header first.
+func readAssignments(rows [][]string) []parsedGame {
 	// Header row determines field columns (index 3+)
 	header := rows[0]
 	type fieldCol struct {
@@ -118,7 +127,7 @@ func readAssignments(f *excelize.File) ([]parsedGame, error) {
 		}
 	}
 
-	return games, nil
+	return games
 }
 
 // parseGameCell parses "Away @ Home" and returns (away, home, true).
@@ -163,6 +172,9 @@ func checkConsecutiveDays(cfg *config.Config, games []parsedGame) []Violation {
 	for team, dates := range teamDates {
 		consecutive := 1
 		for i := 1; i < len(dates); i++ {
+			if dates[i].Equal(dates[i-1]) {
+				continue // doubleheader: same day
+			}
 			if dates[i].Sub(dates[i-1]) == 24*time.Hour {
 				consecutive++
 				if consecutive > cfg.Rules.MaxConsecutiveDays {
@@ -202,6 +214,170 @@ func checkMaxGamesPerWeek(cfg *config.Config, games []parsedGame) []Violation {
 	return violations
 }
 
+// checkMaxHomeGamesPerWeek reports each home game that puts its team over
+// max_home_games_per_week in an ISO week.
+func checkMaxHomeGamesPerWeek(cfg *config.Config, games []parsedGame) []Violation {
+	maxHome := cfg.Rules.MaxHomeGamesPerWeek
+	if maxHome <= 0 {
+		return nil
+	}
+
+	type teamWeek struct {
+		team       string
+		year, week int
+	}
+	hosted := make(map[teamWeek]int)
+	var violations []Violation
+	for _, g := range games {
+		year, week := g.Date.ISOWeek()
+		k := teamWeek{g.Home, year, week}
+		hosted[k]++
+		if hosted[k] > maxHome {
+			violations = append(violations, Violation{
+				Row:  g.Row,
+				Type: "error",
+				Message: fmt.Sprintf("%s hosts %d games in week %d (max %d home)",
+					g.Home, hosted[k], week, maxHome),
+			})
+		}
+	}
+	return violations
+}
+
+// checkMaxGamesPerDate reports each game that puts a date over
+// max_games_per_date, counting every field.
+func checkMaxGamesPerDate(cfg *config.Config, games []parsedGame) []Violation {
+	maxDate := cfg.Rules.MaxGamesPerDate
+	if maxDate <= 0 {
+		return nil
+	}
+
+	played := make(map[time.Time]int)
+	var violations []Violation
+	for _, g := range games {
+		played[g.Date]++
+		if played[g.Date] > maxDate {
+			violations = append(violations, Violation{
+				Row:     g.Row,
+				Type:    "error",
+				Message: fmt.Sprintf("%d games on %s (max %d per date)", played[g.Date], g.Date.Format("01/02"), maxDate),
+			})
+		}
+	}
+	return violations
+}
+
+// checkMaxSaturdayGames reports each Saturday game that puts a team over its
+// max_saturday_games.
+func checkMaxSaturdayGames(cfg *config.Config, games []parsedGame) []Violation {
+	played := make(map[string]int)
+	var violations []Violation
+	for _, g := range games {
+		if g.Date.Weekday() != time.Saturday {
+			continue
+		}
+		for _, team := range []string{g.Home, g.Away} {
+			limit := cfg.Team(team).MaxSaturdayGames
+			if limit <= 0 {
+				continue
+			}
+			played[team]++
+			if played[team] > limit {
+				violations = append(violations, Violation{
+					Row:     g.Row,
+					Type:    "error",
+					Message: fmt.Sprintf("%s plays %d Saturday games (max %d)", team, played[team], limit),
+				})
+			}
+		}
+	}
+	return violations
+}
+
+// checkDistinctFields reports each team that plays at more fields than its
+// max_distinct_fields, once, at the row of the first game past the cap. It
+// is a rule violation with enforce_max_distinct_fields and a guideline one
+// otherwise.
+func checkDistinctFields(cfg *config.Config, games []parsedGame) []Violation {
+	typ := "warning"
+	if cfg.Rules.EnforceMaxDistinctFields {
+		typ = "error"
+	}
+	fields := make(map[string]map[string]bool)
+	firstOver := make(map[string]int) // team -> row of its first game past the cap
+	for _, g := range games {
+		for _, team := range []string{g.Home, g.Away} {
+			limit := cfg.Team(team).MaxDistinctFields
+			if limit <= 0 {
+				continue
+			}
+			if fields[team] == nil {
+				fields[team] = make(map[string]bool)
+			}
+			fields[team][g.Field] = true
+			if _, ok := firstOver[team]; !ok && len(fields[team]) > limit {
+				firstOver[team] = g.Row
+			}
+		}
+	}
+
+	var violations []Violation
+	for _, team := range cfg.AllTeams() {
+		if row, ok := firstOver[team]; ok {
+			violations = append(violations, Violation{
+				Row:  row,
+				Type: typ,
+				Message: fmt.Sprintf("%s plays at %d fields (max_distinct_fields %d)",
+					team, len(fields[team]), cfg.Team(team).MaxDistinctFields),
+			})
+		}
+	}
+	return violations
+}
+
+// checkOpeningAwayStreak reports each team that opens its season with more
+// away games than max_opening_away_streak, at the row of the first game past
+// the cap. It is a rule violation with enforce_max_opening_away_streak and a
+// guideline one otherwise.
+func checkOpeningAwayStreak(cfg *config.Config, games []parsedGame) []Violation {
+	limit := cfg.Guidelines.MaxOpeningAwayStreak
+	if limit <= 0 {
+		return nil
+	}
+	typ := "warning"
+	if cfg.Rules.EnforceMaxOpeningAwayStreak {
+		typ = "error"
+	}
+	byTeam := make(map[string][]parsedGame)
+	for _, g := range games {
+		byTeam[g.Home] = append(byTeam[g.Home], g)
+		byTeam[g.Away] = append(byTeam[g.Away], g)
+	}
+
+	var violations []Violation
+	for _, team := range cfg.AllTeams() {
+		teamGames := byTeam[team]
+		sort.SliceStable(teamGames, func(i, j int) bool {
+			if !teamGames[i].Date.Equal(teamGames[j].Date) {
+				return teamGames[i].Date.Before(teamGames[j].Date)
+			}
+			return config.CompareTimes(teamGames[i].Time, teamGames[j].Time) < 0
+		})
+		var homes []bool
+		for _, g := range teamGames {
+			homes = append(homes, g.Home == team)
+		}
+		if n := schedule.OpeningAwayStreak(homes); n > limit {
+			violations = append(violations, Violation{
+				Row:     teamGames[limit].Row,
+				Type:    typ,
+				Message: fmt.Sprintf("%s opens the season with %d away games (max_opening_away_streak %d)", team, n, limit),
+			})
+		}
+	}
+	return violations
+}
+
 func checkMaxGamesPerTimeslot(cfg *config.Config, games []parsedGame) []Violation {
 	type slotKey struct {
 		date time.Time
@@ -224,11 +400,301 @@ func checkMaxGamesPerTimeslot(cfg *config.Config, games []parsedGame) []Violatio
 	return violations
 }
 
-func checkRematchProximity(cfg *config.Config, games []parsedGame) []Violation {
-	if cfg.Guidelines.MinDaysBetweenSameMatchup <= 0 {
-		return nil
+// checkGameOnLegalDate flags games placed where the config provides no slot:
+// blackout dates, dates outside the season, times not configured for that
+// day, and field times blocked by a reservation.
+func checkGameOnLegalDate(cfg *config.Config, games []parsedGame) []Violation {
+	var fieldNames []string
+	for _, f := range cfg.Fields {
+		fieldNames = append(fieldNames, f.Name)
+	}
+	column := func(field string) string {
+		return excel.FieldColumnName(field, fieldNames)
+	}
+	fieldNamed := make(map[string]string) // master sheet column -> field name
+	for _, name := range fieldNames {
+		fieldNamed[column(name)] = name
+	}
+
+	blackoutDates := make(map[time.Time]string)
+	for _, b := range cfg.Season.BlackoutDates {
+		blackoutDates[b.Date.Time] = b.Reason
+	}
+
+	type slotKey struct {
+		date  time.Time
+		time  string
+		field string
+	}
+	open := make(map[slotKey]bool)
+	for _, s := range append(schedule.GenerateSlots(cfg), schedule.GenerateOverflowSlots(cfg)...) {
+		open[slotKey{s.Date, s.Time, column(s.Field)}] = true
+	}
+	rampUp := make(map[slotKey]bool)
+	for _, s := range schedule.RampUpSlots(cfg) {
+		rampUp[slotKey{s.Date, s.Time, column(s.Field)}] = true
+	}
+	reserved := make(map[slotKey]string)
+	for _, b := range schedule.GenerateBlackoutSlots(cfg) {
+		sk := slotKey{b.Date, b.Time, column(b.Field)}
+		if _, ok := reserved[sk]; !ok {
+			reserved[sk] = b.Reason // first in config order, as the master sheet shows
+		}
+	}
+
+	seasonEnd := cfg.Season.EndDate.Time
+	if cfg.Season.OverflowEndDate != nil {
+		seasonEnd = cfg.Season.OverflowEndDate.Time
+	}
+
+	var violations []Violation
+	for _, g := range games {
+		sk := slotKey{g.Date, g.Time, g.Field}
+		if open[sk] {
+			continue
+		}
+
+		game := fmt.Sprintf("%s @ %s on %s", g.Away, g.Home, g.Date.Format("01/02"))
+		var msg string
+		if reason, ok := blackoutDates[g.Date]; ok {
+			msg = fmt.Sprintf("%s is on a blackout date (%s)", game, reason)
+		} else if g.Date.Before(cfg.Season.StartDate.Time) || g.Date.After(seasonEnd) {
+			msg = fmt.Sprintf("%s is outside the season", game)
+		} else if !slices.Contains(schedule.TimesForDay(cfg, g.Date), g.Time) {
+			msg = fmt.Sprintf("%s at %s is not a scheduled time for %s", game, g.Time, g.Date.Format("Monday"))
+		} else if name, ok := fieldNamed[g.Field]; ok && !slices.Contains(cfg.FieldTimesForDay(name, g.Date), g.Time) {
+			msg = fmt.Sprintf("%s at %s is not a time %s offers on %s (its time_slots)", game, g.Time, g.Field, g.Date.Format("Monday"))
+		} else if rampUp[sk] {
+			msg = fmt.Sprintf("%s is a weekday game before %s (weekday_start_offset)", game, cfg.Season.WeekdaysStart().Format("01/02"))
+		} else if reason, ok := reserved[sk]; ok {
+			msg = fmt.Sprintf("%s at %s on %s is reserved (%s)", game, g.Time, g.Field, reason)
+		} else {
+			msg = fmt.Sprintf("%s at %s on %s is not an available slot", game, g.Time, g.Field)
+		}
+		violations = append(violations, Violation{Row: g.Row, Type: "error", Message: msg})
+	}
+	return violations
+}
+
+// checkHomeField reports home games played away from the team's home_field.
+func checkHomeField(cfg *config.Config, games []parsedGame) []Violation {
+	var fieldNames []string
+	for _, f := range cfg.Fields {
+		fieldNames = append(fieldNames, f.Name)
+	}
+
+	var violations []Violation
+	for _, g := range games {
+		field := cfg.Team(g.Home).HomeField
+		if field == "" || g.Field == excel.FieldColumnName(field, fieldNames) {
+			continue
+		}
+		violations = append(violations, Violation{
+			Row:  g.Row,
+			Type: "error",
+			Message: fmt.Sprintf("%s @ %s on %s is on %s, but %s home games must be on %s",
+				g.Away, g.Home, g.Date.Format("01/02"), g.Field, g.Home, field),
+		})
+	}
+	return violations
+}
+
+// checkExcludedFields reports games on a field either team has listed in
+// excluded_fields.
+func checkExcludedFields(cfg *config.Config, games []parsedGame) []Violation {
+	var fieldNames []string
+	for _, f := range cfg.Fields {
+		fieldNames = append(fieldNames, f.Name)
+	}
+
+	var violations []Violation
+	for _, g := range games {
+		for _, team := range []string{g.Home, g.Away} {
+			for _, field := range cfg.Team(team).ExcludedFields {
+				if g.Field != excel.FieldColumnName(field, fieldNames) {
+					continue
+				}
+				violations = append(violations, Violation{
+					Row:  g.Row,
+					Type: "error",
+					Message: fmt.Sprintf("%s @ %s on %s is on %s, which %s can't use (excluded_fields)",
+						g.Away, g.Home, g.Date.Format("01/02"), g.Field, team),
+				})
+			}
+		}
+	}
+	return violations
+}
+
+// checkWeekendOnly flags weekend_only fixed games without a matching game,
+// same home and away teams, on a Saturday or Sunday.
+func checkWeekendOnly(cfg *config.Config, games []parsedGame) []Violation {
+	type matchup struct{ home, away string }
+	var needed []matchup
+	count := make(map[matchup]int)
+	for _, b := range cfg.Strategies {
+		for _, g := range b.Games {
+			if g.WeekendOnly {
+				m := matchup{g.Home, g.Away}
+				if count[m] == 0 {
+					needed = append(needed, m)
+				}
+				count[m]++
+			}
+		}
+	}
+	for _, g := range games {
+		if wd := g.Date.Weekday(); wd == time.Saturday || wd == time.Sunday {
+			count[matchup{g.Home, g.Away}]--
+		}
 	}
 
+	var violations []Violation
+	for _, m := range needed {
+		if count[m] > 0 {
+			violations = append(violations, Violation{
+				Type:    "error",
+				Message: fmt.Sprintf("%d weekend_only %s @ %s game(s) not played on a Saturday or Sunday", count[m], m.away, m.home),
+			})
+		}
+	}
+	return violations
+}
+
+// checkMatchupBlackouts reports games between two teams on a date
+// matchup_blackouts keeps them apart, whichever team is home, and any game
+// of a team blacked out against "any".
+func checkMatchupBlackouts(cfg *config.Config, games []parsedGame) []Violation {
+	var violations []Violation
+	for _, g := range games {
+		for _, m := range cfg.Rules.MatchupBlackouts {
+			if !m.Matches(g.Home, g.Away, g.Date) {
+				continue
+			}
+			msg := fmt.Sprintf("%s @ %s on %s is on a matchup blackout date", g.Away, g.Home, g.Date.Format("01/02"))
+			if m.Reason != "" {
+				msg += fmt.Sprintf(" (%s)", m.Reason)
+			}
+			violations = append(violations, Violation{Row: g.Row, Type: "error", Message: msg})
+		}
+	}
+	return violations
+}
+
+// checkSharedLocation reports games at the same date and time on two fields
+// grouped by same_physical_location.
+func checkSharedLocation(cfg *config.Config, games []parsedGame) []Violation {
+	var fieldNames []string
+	for _, f := range cfg.Fields {
+		fieldNames = append(fieldNames, f.Name)
+	}
+	shared := make(map[string][]string) // master sheet column -> columns at the same location
+	for _, name := range fieldNames {
+		for _, other := range cfg.Rules.SharedLocation(name) {
+			col := excel.FieldColumnName(name, fieldNames)
+			shared[col] = append(shared[col], excel.FieldColumnName(other, fieldNames))
+		}
+	}
+
+	var violations []Violation
+	for i, g := range games {
+		others := shared[g.Field]
+		for _, other := range games[:i] {
+			if !other.Date.Equal(g.Date) || other.Time != g.Time || !slices.Contains(others, other.Field) {
+				continue
+			}
+			violations = append(violations, Violation{
+				Row:  g.Row,
+				Type: "error",
+				Message: fmt.Sprintf("%s @ %s on %s %s %s shares a location with %s @ %s on %s (same_physical_location)",
+					g.Away, g.Home, g.Field, g.Date.Format("01/02"), g.Time, other.Away, other.Home, other.Field),
+			})
+		}
+	}
+	return violations
+}
+
+// checkFieldOverlap reports games that start on a field before the
+// previous game there has ended, given time_slots.game_minutes. Start times
+// can differ and still collide when games run long.
+func checkFieldOverlap(cfg *config.Config, games []parsedGame) []Violation {
+	type fieldDate struct {
+		field string
+		date  time.Time
+	}
+	byDay := make(map[fieldDate][]parsedGame)
+	for _, g := range games {
+		fd := fieldDate{g.Field, g.Date}
+		byDay[fd] = append(byDay[fd], g)
+	}
+
+	var violations []Violation
+	for _, g := range games {
+		for _, other := range byDay[fieldDate{g.Field, g.Date}] {
+			if other.Row >= g.Row || !cfg.TimeSlots.Overlap(other.Time, g.Time) {
+				continue
+			}
+			violations = append(violations, Violation{
+				Row:  g.Row,
+				Type: "error",
+				Message: fmt.Sprintf("%s @ %s at %s on %s %s starts before %s @ %s (%s) ends; games run %d minutes",
+					g.Away, g.Home, g.Time, g.Field, g.Date.Format("01/02"),
+					other.Away, other.Home, other.Time, int(cfg.TimeSlots.GameLength().Minutes())),
+			})
+		}
+	}
+	return violations
+}
+
+// checkFieldBuffer reports games too close to an earlier game on a field
+// with min_minutes_between_games. Games that overlap outright are left to
+// checkFieldOverlap.
+func checkFieldBuffer(cfg *config.Config, games []parsedGame) []Violation {
+	var fieldNames []string
+	for _, f := range cfg.Fields {
+		fieldNames = append(fieldNames, f.Name)
+	}
+	fields := make(map[string]config.Field)
+	for _, f := range cfg.Fields {
+		fields[excel.FieldColumnName(f.Name, fieldNames)] = f
+	}
+
+	type fieldDate struct {
+		field string
+		date  time.Time
+	}
+	byDay := make(map[fieldDate][]parsedGame)
+	for _, g := range games {
+		if fields[g.Field].MinMinutesBetweenGames > 0 {
+			fd := fieldDate{g.Field, g.Date}
+			byDay[fd] = append(byDay[fd], g)
+		}
+	}
+
+	var violations []Violation
+	for _, g := range games {
+		field, ok := fields[g.Field]
+		if !ok || field.MinMinutesBetweenGames <= 0 {
+			continue
+		}
+		for _, other := range byDay[fieldDate{g.Field, g.Date}] {
+			if other.Row >= g.Row || !field.TooClose(other.Time, g.Time, cfg.TimeSlots.GameLength()) ||
+				cfg.TimeSlots.Overlap(other.Time, g.Time) {
+				continue
+			}
+			violations = append(violations, Violation{
+				Row:  g.Row,
+				Type: "error",
+				Message: fmt.Sprintf("%s @ %s at %s on %s %s is within %d minutes of %s @ %s at %s",
+					g.Away, g.Home, g.Time, g.Field, g.Date.Format("01/02"), field.MinMinutesBetweenGames,
+					other.Away, other.Home, other.Time),
+			})
+		}
+	}
+	return violations
+}
+
+func checkRematchProximity(cfg *config.Config, games []parsedGame) []Violation {
 	type matchup struct{ a, b string }
 	matchDates := make(map[matchup][]time.Time)
 	for _, g := range games {
@@ -241,15 +707,16 @@ func checkRematchProximity(cfg *config.Config, games []parsedGame) []Violation {
 
 	var violations []Violation
 	for mk, dates := range matchDates {
+		minDays := cfg.RematchDays(mk.a, mk.b)
 		sortDates(dates)
 		for i := 1; i < len(dates); i++ {
 			days := int(dates[i].Sub(dates[i-1]).Hours() / 24)
-			if days < cfg.Guidelines.MinDaysBetweenSameMatchup {
+			if days < minDays {
 				violations = append(violations, Violation{
 					Type: "warning",
 					Days: days,
 					Message: fmt.Sprintf("%s vs %s rematch after %d days (min %d): %s and %s",
-						mk.a, mk.b, days, cfg.Guidelines.MinDaysBetweenSameMatchup,
+						mk.a, mk.b, days, minDays,
 						dates[i-1].Format("01/02"), dates[i].Format("01/02")),
 				})
 			}
@@ -262,6 +729,49 @@ func checkRematchProximity(cfg *config.Config, games []parsedGame) []Violation {
 	return violations
 }
 
+// checkGroupSpacing warns when a team plays members of the same opponent
+// group closer together than min_days_between_group_games.
+func checkGroupSpacing(cfg *config.Config, games []parsedGame) []Violation {
+	minDays := cfg.Guidelines.MinDaysBetweenGroupGames
+	if minDays <= 0 {
+		return nil
+	}
+
+	type teamGroup struct{ team, group string }
+	groupDates := make(map[teamGroup][]time.Time)
+	for _, g := range games {
+		for _, group := range cfg.Guidelines.GroupsOf(g.Away) {
+			k := teamGroup{g.Home, group}
+			groupDates[k] = append(groupDates[k], g.Date)
+		}
+		for _, group := range cfg.Guidelines.GroupsOf(g.Home) {
+			k := teamGroup{g.Away, group}
+			groupDates[k] = append(groupDates[k], g.Date)
+		}
+	}
+
+	var violations []Violation
+	for _, team := range cfg.AllTeams() {
+		for _, og := range cfg.Guidelines.OpponentGroups {
+			dates := groupDates[teamGroup{team, og.Name}]
+			sortDates(dates)
+			for i := 1; i < len(dates); i++ {
+				days := int(dates[i].Sub(dates[i-1]).Hours() / 24)
+				if days < minDays {
+					violations = append(violations, Violation{
+						Type: "warning",
+						Days: days,
+						Message: fmt.Sprintf("%s plays opponent group %s after %d days (min %d): %s and %s",
+							team, og.Name, days, minDays,
+							dates[i-1].Format("01/02"), dates[i].Format("01/02")),
+					})
+				}
+			}
+		}
+	}
+	return violations
+}
+
 func check3In4Days(cfg *config.Config, games []parsedGame) []Violation {
 	if !cfg.Rules.Max3In4Days {
 		return nil
@@ -309,7 +819,7 @@ func checkSundayBalance(cfg *config.Config, games []parsedGame) []Violation {
 			minSun = c
 		}
 	}
-	if maxSun-minSun > 1 {
+	if maxSun-minSun > cfg.Guidelines.SundayTolerance() {
 		return []Violation{{
 			Type:    "warning",
 			Message: fmt.Sprintf("Sunday game imbalance: min %d, max %d across teams", minSun, maxSun),
@@ -318,6 +828,55 @@ func checkSundayBalance(cfg *config.Config, games []parsedGame) []Violation {
 	return nil
 }
 
+func checkPaceBalance(cfg *config.Config, games []parsedGame) []Violation {
+	tolerance, ok := cfg.Guidelines.PaceTolerance()
+	if !ok || !cfg.Guidelines.BalancePace {
+		return nil
+	}
+
+	assignments := make([]schedule.Assignment, len(games))
+	for i, g := range games {
+		assignments[i] = schedule.Assignment{
+			Game: strategy.Game{Home: g.Home, Away: g.Away},
+			Slot: schedule.Slot{Date: g.Date, Time: g.Time, Field: g.Field},
+		}
+	}
+	gap := schedule.PaceSpread(cfg.AllTeams(), assignments)
+	if gap.Spread() > tolerance {
+		return []Violation{{
+			Type: "warning",
+			Message: fmt.Sprintf("Pace imbalance: week of %s ends with teams at %d to %d games played",
+				gap.Week.Format("Jan 2"), gap.Min, gap.Max),
+		}}
+	}
+	return nil
+}
+
+func checkBothWeekendDays(cfg *config.Config, games []parsedGame) []Violation {
+	if !cfg.Guidelines.AvoidBothWeekendDays {
+		return nil
+	}
+
+	assignments := make([]schedule.Assignment, len(games))
+	for i, g := range games {
+		assignments[i] = schedule.Assignment{
+			Game: strategy.Game{Home: g.Home, Away: g.Away},
+			Slot: schedule.Slot{Date: g.Date, Time: g.Time, Field: g.Field},
+		}
+	}
+	full := schedule.FullWeekends(assignments)
+	var violations []Violation
+	for _, team := range cfg.AllTeams() {
+		for _, sat := range full[team] {
+			violations = append(violations, Violation{
+				Type:    "warning",
+				Message: fmt.Sprintf("%s plays both days of the weekend of %s", team, sat.Format("01/02")),
+			})
+		}
+	}
+	return violations
+}
+
 func checkGameCompleteness(cfg *config.Config, games []parsedGame) []Violation {
 	counts := make(map[string]int)
 	for _, g := range games {
@@ -337,6 +896,59 @@ func checkGameCompleteness(cfg *config.Config, games []parsedGame) []Violation {
 	return violations
 }
 
+// checkMatchupCounts flags pairs that meet more often than the strategy's
+// matchups call for, such as a game pasted in twice or an exhibition
+// repeating a regular-season game without allow_repeats.
+func checkMatchupCounts(cfg *config.Config, games []parsedGame) []Violation {
+	strat, err := strategy.FromConfig(cfg)
+	if err != nil {
+		return nil
+	}
+	type pair [2]string
+	key := func(a, b string) pair {
+		if b < a {
+			a, b = b, a
+		}
+		return pair{a, b}
+	}
+	expected := make(map[pair]int)
+	for _, g := range strat.GenerateMatchups(cfg.Divisions) {
+		if len(g.DependsOn) > 0 {
+			return nil // later playoff rounds get their teams from results
+		}
+		expected[key(g.Home, g.Away)]++
+	}
+	played := make(map[pair]int)
+	var order []pair
+	for _, g := range games {
+		k := key(g.Home, g.Away)
+		if played[k] == 0 {
+			order = append(order, k)
+		}
+		played[k]++
+	}
+
+	var violations []Violation
+	for _, k := range order {
+		if played[k] > expected[k] {
+			violations = append(violations, Violation{
+				Type: "error",
+				Message: fmt.Sprintf("%s and %s meet %s; the strategy calls for %d",
+					k[0], k[1], times(played[k]), expected[k]),
+			})
+		}
+	}
+	return violations
+}
+
+// times formats a count of meetings, e.g. "once" or "3 times".
+func times(n int) string {
+	if n == 1 {
+		return "once"
+	}
+	return fmt.Sprintf("%d times", n)
+}
+
 func buildTeamDates(games []parsedGame) map[string][]time.Time {
 	m := make(map[string][]time.Time)
 	for _, g := range games {
@@ -359,7 +971,7 @@ func sortDates(dates []time.Time) {
 
 // checkOverflowUsage warns when games are scheduled in the overflow period
 // but open slots exist in the regular season.
-func checkOverflowUsage(cfg *config.Config, f *excelize.File, games []parsedGame) []Violation {
+func checkOverflowUsage(cfg *config.Config, rows [][]string, games []parsedGame) []Violation {
 	if cfg.Season.OverflowEndDate == nil {
 		return nil
 	}
@@ -376,7 +988,7 @@ func checkOverflowUsage(cfg *config.Config, f *excelize.File, games []parsedGame
 		return nil
 	}
 
-	openSlots := countOpenRegularSlots(cfg, f, games)
+	openSlots := countOpenRegularSlots(cfg, rows, games)
 	if openSlots == 0 {
 		return nil
 	}
@@ -391,12 +1003,7 @@ func checkOverflowUsage(cfg *config.Config, f *excelize.File, games []parsedGame
 
 // countOpenRegularSlots counts empty, non-blackout field cells in the master
 // sheet for rows with dates on or before the season end date.
-func countOpenRegularSlots(cfg *config.Config, f *excelize.File, games []parsedGame) int {
-	rows, err := f.GetRows("Master Schedule")
-	if err != nil || len(rows) == 0 {
-		return 0
-	}
-
+func countOpenRegularSlots(cfg *config.Config, rows [][]string, games []parsedGame) int {
 	header := rows[0]
 	numFields := len(header) - 3 // columns after Date, Day, Time
 	if numFields <= 0 {
@@ -432,6 +1039,9 @@ func countOpenRegularSlots(cfg *config.Config, f *excelize.File, games []parsedG
 
 		for fi := 0; fi < numFields; fi++ {
 			colIdx := fi + 3
+			if excel.ExtraColumn(header[colIdx]) {
+				continue
+			}
 			cell := ""
 			if colIdx < len(row) {
 				cell = row[colIdx]
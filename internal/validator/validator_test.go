@@ -1,6 +1,11 @@
 package validator
 
 import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -40,8 +45,8 @@ func fullTestConfig() *config.Config {
 			Weekday:  []string{"17:45"},
 			Saturday: []string{"12:30", "14:45", "17:00"},
 			Sunday:   []string{"17:00"},
-			HolidayDates: []config.Date{
-				date(2026, 5, 25),
+			HolidayDates: []config.Holiday{
+				{Date: date(2026, 5, 25)},
 			},
 		},
 		Strategy: "division_weighted",
@@ -106,6 +111,100 @@ func TestValidateGeneratedSchedule(t *testing.T) {
 	})
 }
 
+func TestValidateCSVExport(t *testing.T) {
+	cfg := fullTestConfig()
+	slots := schedule.GenerateSlots(cfg)
+	games := (&strategy.DivisionWeighted{}).GenerateMatchups(cfg.Divisions)
+	result, err := schedule.Schedule(cfg, slots, nil, games)
+	if err != nil {
+		t.Fatalf("Schedule() error: %v", err)
+	}
+	// Double-book a team so there is something to report.
+	result.Assignments[1].Game.Home = result.Assignments[0].Game.Home
+	f, err := excel.Generate(cfg, result, slots, schedule.GenerateBlackoutSlots(cfg))
+	if err != nil {
+		t.Fatalf("Generate() error: %v", err)
+	}
+	dir := t.TempDir()
+	if err := f.SaveAs(dir + "/schedule.xlsx"); err != nil {
+		t.Fatalf("SaveAs error: %v", err)
+	}
+
+	// Export the master sheet the way a spreadsheet app would, with
+	// unpadded dates.
+	rows, err := f.GetRows("Master Schedule")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	for i, row := range rows {
+		if i > 0 && len(row) > 0 {
+			if d, err := time.Parse("01/02/2006", row[0]); err == nil {
+				row[0] = d.Format("1/2/2006")
+			}
+		}
+		w.Write(row)
+	}
+	w.Flush()
+	if err := os.WriteFile(dir+"/schedule.csv", buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	fromXLSX, err := Validate(cfg, dir+"/schedule.xlsx")
+	if err != nil {
+		t.Fatalf("Validate(xlsx) error: %v", err)
+	}
+	fromCSV, err := Validate(cfg, dir+"/schedule.csv")
+	if err != nil {
+		t.Fatalf("Validate(csv) error: %v", err)
+	}
+	// Some checks walk maps, so compare the violations in a fixed order.
+	sorted := func(vs []Violation) []string {
+		var out []string
+		for _, v := range vs {
+			out = append(out, fmt.Sprintf("%d %s %s", v.Row, v.Type, v.Message))
+		}
+		slices.Sort(out)
+		return out
+	}
+	if got, want := sorted(fromCSV), sorted(fromXLSX); len(got) == 0 || !slices.Equal(got, want) {
+		t.Errorf("CSV violations = %q, want the workbook's %q", got, want)
+	}
+}
+
+func TestValidateSingleDivisionSchedule(t *testing.T) {
+	cfg := fullTestConfig()
+	cfg.Divisions = []config.Division{
+		{Name: "League", Teams: []string{"Angels", "Astros", "Athletics", "Mariners", "Royals", "Twins"}},
+	}
+	slots := schedule.GenerateSlots(cfg)
+	games := (&strategy.DivisionWeighted{}).GenerateMatchups(cfg.Divisions)
+
+	result, err := schedule.Schedule(cfg, slots, nil, games)
+	if err != nil {
+		t.Fatalf("Schedule() error: %v", err)
+	}
+	f, err := excel.Generate(cfg, result, slots, schedule.GenerateBlackoutSlots(cfg))
+	if err != nil {
+		t.Fatalf("Generate() error: %v", err)
+	}
+	path := t.TempDir() + "/schedule.xlsx"
+	if err := f.SaveAs(path); err != nil {
+		t.Fatalf("SaveAs error: %v", err)
+	}
+
+	violations, err := Validate(cfg, path)
+	if err != nil {
+		t.Fatalf("Validate() error: %v", err)
+	}
+	for _, v := range violations {
+		if v.Type == "error" {
+			t.Errorf("hard violation: %s", v.Message)
+		}
+	}
+}
+
 func d(month, day int) time.Time {
 	return time.Date(2026, time.Month(month), day, 0, 0, 0, 0, time.UTC)
 }
@@ -172,6 +271,21 @@ func TestCheckConsecutiveDays(t *testing.T) {
 		}
 	})
 
+	t.Run("doubleheader does not reset or extend the streak", func(t *testing.T) {
+		games := []parsedGame{
+			{Row: 2, Date: d(5, 1), Home: "Angels", Away: "Cubs"},
+			{Row: 3, Date: d(5, 2), Home: "Angels", Away: "Padres"},
+			{Row: 4, Date: d(5, 2), Home: "Angels", Away: "Astros"},
+		}
+		if v := checkConsecutiveDays(cfg, games); len(v) != 0 {
+			t.Errorf("expected 0 violations, got %d", len(v))
+		}
+		games = append(games, parsedGame{Row: 5, Date: d(5, 3), Home: "Angels", Away: "Royals"})
+		if v := checkConsecutiveDays(cfg, games); len(v) == 0 {
+			t.Error("expected violation for 3 consecutive days around a doubleheader")
+		}
+	})
+
 	t.Run("violation for 3 consecutive days", func(t *testing.T) {
 		games := []parsedGame{
 			{Row: 2, Date: d(5, 1), Home: "Angels", Away: "Cubs"},
@@ -214,6 +328,270 @@ func TestCheckMaxGamesPerWeek(t *testing.T) {
 	})
 }
 
+func TestCheckMaxHomeGamesPerWeek(t *testing.T) {
+	games := []parsedGame{
+		{Row: 2, Date: d(5, 4), Home: "Angels", Away: "Cubs"},   // Mon
+		{Row: 3, Date: d(5, 6), Home: "Padres", Away: "Angels"}, // Wed
+		{Row: 4, Date: d(5, 9), Home: "Angels", Away: "Astros"}, // Sat
+		{Row: 5, Date: d(5, 11), Home: "Angels", Away: "Cubs"},  // next Mon
+	}
+
+	t.Run("unlimited by default", func(t *testing.T) {
+		cfg := &config.Config{Rules: defaultRules()}
+		if v := checkMaxHomeGamesPerWeek(cfg, games); len(v) != 0 {
+			t.Errorf("expected 0 violations, got %v", v)
+		}
+	})
+
+	t.Run("second home game in a week", func(t *testing.T) {
+		cfg := &config.Config{Rules: defaultRules()}
+		cfg.Rules.MaxHomeGamesPerWeek = 1
+		v := checkMaxHomeGamesPerWeek(cfg, games)
+		if len(v) != 1 || v[0].Row != 4 || v[0].Type != "error" || !strings.Contains(v[0].Message, "Angels hosts 2 games") {
+			t.Errorf("violations = %v, want one error on row 4 for the Angels", v)
+		}
+	})
+}
+
+func TestCheckMaxGamesPerDate(t *testing.T) {
+	games := []parsedGame{
+		{Row: 2, Date: d(5, 2), Home: "Angels", Away: "Cubs"},
+		{Row: 3, Date: d(5, 2), Home: "Astros", Away: "Padres"},
+		{Row: 4, Date: d(5, 2), Home: "Royals", Away: "Pirates"},
+		{Row: 5, Date: d(5, 3), Home: "Angels", Away: "Padres"},
+	}
+
+	t.Run("unlimited by default", func(t *testing.T) {
+		if v := checkMaxGamesPerDate(fullTestConfig(), games); len(v) != 0 {
+			t.Errorf("expected 0 violations, got %v", v)
+		}
+	})
+
+	t.Run("third game on a date over a cap of 2", func(t *testing.T) {
+		cfg := fullTestConfig()
+		cfg.Rules.MaxGamesPerDate = 2
+		v := checkMaxGamesPerDate(cfg, games)
+		if len(v) != 1 || v[0].Row != 4 || v[0].Type != "error" || !strings.Contains(v[0].Message, "3 games on 05/02 (max 2 per date)") {
+			t.Errorf("violations = %v, want one error on row 4", v)
+		}
+	})
+}
+
+func TestCheckMatchupCounts(t *testing.T) {
+	games := []parsedGame{
+		{Row: 2, Date: d(5, 2), Home: "Angels", Away: "Astros"},
+		{Row: 3, Date: d(5, 9), Home: "Astros", Away: "Angels"},
+		{Row: 4, Date: d(5, 16), Home: "Angels", Away: "Cubs"},
+	}
+
+	t.Run("within the strategy's counts", func(t *testing.T) {
+		if v := checkMatchupCounts(fullTestConfig(), games); len(v) != 0 {
+			t.Errorf("expected 0 violations, got %v", v)
+		}
+	})
+
+	t.Run("pair met more often than the strategy calls for", func(t *testing.T) {
+		extra := append(slices.Clone(games), parsedGame{Row: 5, Date: d(5, 20), Home: "Cubs", Away: "Angels"})
+		v := checkMatchupCounts(fullTestConfig(), extra)
+		if len(v) != 1 || v[0].Type != "error" || v[0].Message != "Angels and Cubs meet 2 times; the strategy calls for 1" {
+			t.Errorf("violations = %v, want one Angels and Cubs error", v)
+		}
+	})
+
+	t.Run("composite allows an exhibition repeat", func(t *testing.T) {
+		cfg := fullTestConfig()
+		cfg.Strategy = ""
+		cfg.Strategies = []config.StrategyBlock{
+			{Strategy: "division_weighted"},
+			{Strategy: "fixed", Games: []config.FixedGame{{Home: "Cubs", Away: "Angels"}}, AllowRepeats: true},
+		}
+		extra := append(slices.Clone(games), parsedGame{Row: 5, Date: d(5, 20), Home: "Cubs", Away: "Angels"})
+		if v := checkMatchupCounts(cfg, extra); len(v) != 0 {
+			t.Errorf("expected 0 violations, got %v", v)
+		}
+	})
+}
+
+func TestCheckWeekendOnly(t *testing.T) {
+	cfg := fullTestConfig()
+	cfg.Strategy = ""
+	cfg.Strategies = []config.StrategyBlock{
+		{Strategy: "division_weighted"},
+		{Strategy: "fixed", AllowRepeats: true, Games: []config.FixedGame{{Home: "Cubs", Away: "Angels", WeekendOnly: true}}},
+	}
+
+	t.Run("showcase on a Saturday", func(t *testing.T) {
+		games := []parsedGame{
+			{Row: 2, Date: d(5, 5), Home: "Cubs", Away: "Angels"},
+			{Row: 3, Date: d(5, 9), Home: "Cubs", Away: "Angels"},
+		}
+		if v := checkWeekendOnly(cfg, games); len(v) != 0 {
+			t.Errorf("expected 0 violations, got %v", v)
+		}
+	})
+
+	t.Run("only weekday meetings", func(t *testing.T) {
+		games := []parsedGame{
+			{Row: 2, Date: d(5, 5), Home: "Cubs", Away: "Angels"},
+			{Row: 3, Date: d(5, 9), Home: "Angels", Away: "Cubs"},
+		}
+		v := checkWeekendOnly(cfg, games)
+		if len(v) != 1 || v[0].Type != "error" || v[0].Message != "1 weekend_only Angels @ Cubs game(s) not played on a Saturday or Sunday" {
+			t.Errorf("violations = %v, want one weekend_only error", v)
+		}
+	})
+}
+
+func TestCheckMatchupBlackouts(t *testing.T) {
+	cfg := fullTestConfig()
+	cfg.Rules.MatchupBlackouts = []config.MatchupBlackout{
+		{Home: "Cubs", Away: "Angels", Date: config.Date{Time: d(5, 5)}, Reason: "shared coach"},
+	}
+
+	t.Run("other dates and opponents pass", func(t *testing.T) {
+		games := []parsedGame{
+			{Row: 2, Date: d(5, 6), Home: "Cubs", Away: "Angels"},
+			{Row: 3, Date: d(5, 5), Home: "Cubs", Away: "Padres"},
+		}
+		if v := checkMatchupBlackouts(cfg, games); len(v) != 0 {
+			t.Errorf("expected 0 violations, got %v", v)
+		}
+	})
+
+	t.Run("meeting on the date is an error either way round", func(t *testing.T) {
+		games := []parsedGame{
+			{Row: 2, Date: d(5, 5), Home: "Angels", Away: "Cubs"},
+		}
+		v := checkMatchupBlackouts(cfg, games)
+		if len(v) != 1 || v[0].Row != 2 || v[0].Message != "Cubs @ Angels on 05/05 is on a matchup blackout date (shared coach)" {
+			t.Errorf("violations = %v, want one matchup blackout error", v)
+		}
+	})
+
+	t.Run("any opponent flags each of the team's games that day", func(t *testing.T) {
+		cfg := fullTestConfig()
+		cfg.Rules.MatchupBlackouts = []config.MatchupBlackout{
+			{Home: "Padres", Away: config.AnyOpponent, Date: config.Date{Time: d(5, 5)}},
+		}
+		games := []parsedGame{
+			{Row: 2, Date: d(5, 5), Home: "Cubs", Away: "Padres"},
+			{Row: 3, Date: d(5, 5), Home: "Cubs", Away: "Angels"},
+			{Row: 4, Date: d(5, 6), Home: "Padres", Away: "Angels"},
+		}
+		if v := checkMatchupBlackouts(cfg, games); len(v) != 1 || v[0].Row != 2 {
+			t.Errorf("violations = %v, want one error on row 2", v)
+		}
+	})
+}
+
+func TestCheckSharedLocation(t *testing.T) {
+	cfg := fullTestConfig()
+	cfg.Rules.SamePhysicalLocation = [][]string{{"Symonds Field", "Washington Park"}}
+	games := []parsedGame{
+		{Row: 2, Date: d(5, 2), Time: "12:30", Field: "Symonds", Home: "Angels", Away: "Cubs"},
+		{Row: 3, Date: d(5, 2), Time: "12:30", Field: "Moscariello", Home: "Astros", Away: "Padres"},
+		{Row: 4, Date: d(5, 2), Time: "14:45", Field: "Washington", Home: "Royals", Away: "Pirates"},
+		{Row: 5, Date: d(5, 2), Time: "12:30", Field: "Washington", Home: "Mariners", Away: "Marlins"},
+	}
+	v := checkSharedLocation(cfg, games)
+	want := "Marlins @ Mariners on Washington 05/02 12:30 shares a location with Cubs @ Angels on Symonds (same_physical_location)"
+	if len(v) != 1 || v[0].Row != 5 || v[0].Message != want {
+		t.Errorf("violations = %v, want one shared location error on row 5", v)
+	}
+	if v := checkSharedLocation(fullTestConfig(), games); len(v) != 0 {
+		t.Errorf("expected 0 violations without groups, got %v", v)
+	}
+}
+
+func TestCheckMaxSaturdayGames(t *testing.T) {
+	games := []parsedGame{
+		{Row: 2, Date: d(5, 2), Home: "Angels", Away: "Cubs"},   // Sat
+		{Row: 3, Date: d(5, 4), Home: "Angels", Away: "Padres"}, // Mon
+		{Row: 4, Date: d(5, 9), Home: "Padres", Away: "Angels"}, // Sat
+	}
+
+	t.Run("no cap by default", func(t *testing.T) {
+		if v := checkMaxSaturdayGames(fullTestConfig(), games); len(v) != 0 {
+			t.Errorf("expected 0 violations, got %v", v)
+		}
+	})
+
+	t.Run("second Saturday over a cap of 1", func(t *testing.T) {
+		cfg := fullTestConfig()
+		cfg.Teams = []config.Team{{Name: "Angels", MaxSaturdayGames: 1}}
+		v := checkMaxSaturdayGames(cfg, games)
+		if len(v) != 1 || v[0].Row != 4 || v[0].Type != "error" || !strings.Contains(v[0].Message, "Angels plays 2 Saturday games (max 1)") {
+			t.Errorf("violations = %v, want one error on row 4 for the Angels", v)
+		}
+	})
+}
+
+func TestCheckDistinctFields(t *testing.T) {
+	games := []parsedGame{
+		{Row: 2, Date: d(5, 1), Field: "Symonds Field", Home: "Angels", Away: "Cubs"},
+		{Row: 3, Date: d(5, 4), Field: "Washington Park", Home: "Angels", Away: "Padres"},
+		{Row: 4, Date: d(5, 6), Field: "Moscariello Ballpark", Home: "Padres", Away: "Angels"},
+		{Row: 5, Date: d(5, 8), Field: "Symonds Field", Home: "Cubs", Away: "Angels"},
+	}
+
+	t.Run("no cap by default", func(t *testing.T) {
+		if v := checkDistinctFields(fullTestConfig(), games); len(v) != 0 {
+			t.Errorf("expected 0 violations, got %v", v)
+		}
+	})
+
+	t.Run("third field over a cap of 2 is a warning", func(t *testing.T) {
+		cfg := fullTestConfig()
+		cfg.Teams = []config.Team{{Name: "Angels", MaxDistinctFields: 2}}
+		v := checkDistinctFields(cfg, games)
+		if len(v) != 1 || v[0].Row != 4 || v[0].Type != "warning" || !strings.Contains(v[0].Message, "Angels plays at 3 fields (max_distinct_fields 2)") {
+			t.Errorf("violations = %v, want one warning on row 4 for the Angels", v)
+		}
+	})
+
+	t.Run("an error when enforced", func(t *testing.T) {
+		cfg := fullTestConfig()
+		cfg.Teams = []config.Team{{Name: "Angels", MaxDistinctFields: 2}}
+		cfg.Rules.EnforceMaxDistinctFields = true
+		if v := checkDistinctFields(cfg, games); len(v) != 1 || v[0].Type != "error" {
+			t.Errorf("violations = %v, want one error", v)
+		}
+	})
+}
+
+func TestCheckOpeningAwayStreak(t *testing.T) {
+	games := []parsedGame{
+		{Row: 2, Date: d(5, 1), Time: "17:45", Home: "Cubs", Away: "Angels"},
+		{Row: 3, Date: d(5, 2), Time: "12:30", Home: "Padres", Away: "Angels"},
+		{Row: 4, Date: d(5, 4), Time: "17:45", Home: "Cubs", Away: "Angels"},
+		{Row: 5, Date: d(5, 6), Time: "17:45", Home: "Angels", Away: "Padres"},
+	}
+
+	t.Run("off by default", func(t *testing.T) {
+		if v := checkOpeningAwayStreak(fullTestConfig(), games); len(v) != 0 {
+			t.Errorf("expected 0 violations, got %v", v)
+		}
+	})
+
+	t.Run("third opening road game over a cap of 2 is a warning", func(t *testing.T) {
+		cfg := fullTestConfig()
+		cfg.Guidelines.MaxOpeningAwayStreak = 2
+		v := checkOpeningAwayStreak(cfg, games)
+		if len(v) != 1 || v[0].Row != 4 || v[0].Type != "warning" || !strings.Contains(v[0].Message, "Angels opens the season with 3 away games (max_opening_away_streak 2)") {
+			t.Errorf("violations = %v, want one warning on row 4 for the Angels", v)
+		}
+	})
+
+	t.Run("an error when enforced", func(t *testing.T) {
+		cfg := fullTestConfig()
+		cfg.Guidelines.MaxOpeningAwayStreak = 2
+		cfg.Rules.EnforceMaxOpeningAwayStreak = true
+		if v := checkOpeningAwayStreak(cfg, games); len(v) != 1 || v[0].Type != "error" {
+			t.Errorf("violations = %v, want one error", v)
+		}
+	})
+}
+
 func TestCheckMaxGamesPerTimeslot(t *testing.T) {
 	cfg := &config.Config{Rules: defaultRules()}
 
@@ -308,6 +686,36 @@ func TestCheckRematchProximity(t *testing.T) {
 			t.Error("expected warning for rematch after 7 days")
 		}
 	})
+
+	t.Run("per-division overrides, stricter across divisions", func(t *testing.T) {
+		competitive, rec := 21, 7
+		cfg := &config.Config{
+			Divisions: []config.Division{
+				{Name: "American", Teams: []string{"Angels", "Astros"}, MinDaysBetweenSameMatchup: &competitive},
+				{Name: "National", Teams: []string{"Cubs", "Padres"}, MinDaysBetweenSameMatchup: &rec},
+			},
+			Guidelines: config.Guidelines{MinDaysBetweenSameMatchup: 14},
+		}
+		games := []parsedGame{
+			{Row: 2, Date: d(5, 1), Home: "Cubs", Away: "Padres"},
+			{Row: 3, Date: d(5, 11), Home: "Padres", Away: "Cubs"},
+			{Row: 4, Date: d(5, 1), Home: "Angels", Away: "Astros"},
+			{Row: 5, Date: d(5, 16), Home: "Astros", Away: "Angels"},
+			{Row: 6, Date: d(5, 2), Home: "Angels", Away: "Cubs"},
+			{Row: 7, Date: d(5, 20), Home: "Cubs", Away: "Angels"},
+		}
+		var got []string
+		for _, v := range checkRematchProximity(cfg, games) {
+			got = append(got, v.Message)
+		}
+		want := []string{
+			"Angels vs Astros rematch after 15 days (min 21): 05/01 and 05/16",
+			"Angels vs Cubs rematch after 18 days (min 21): 05/02 and 05/20",
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("warnings = %q, want %q", got, want)
+		}
+	})
 }
 
 func TestCheckOverflowUsage(t *testing.T) {
@@ -443,3 +851,371 @@ func TestCheckOverflowUsage(t *testing.T) {
 		}
 	})
 }
+
+func TestCheckGroupSpacing(t *testing.T) {
+	cfg := &config.Config{
+		Divisions: []config.Division{
+			{Name: "American", Teams: []string{"Angels", "Astros"}},
+			{Name: "National", Teams: []string{"Cubs", "Padres"}},
+		},
+		Guidelines: config.Guidelines{
+			OpponentGroups:           []config.OpponentGroup{{Name: "Travel", Teams: []string{"Cubs", "Padres"}}},
+			MinDaysBetweenGroupGames: 7,
+		},
+	}
+
+	t.Run("no warning when group games are spaced", func(t *testing.T) {
+		games := []parsedGame{
+			{Row: 2, Date: d(5, 1), Home: "Angels", Away: "Cubs"},
+			{Row: 3, Date: d(5, 9), Home: "Padres", Away: "Angels"},
+		}
+		if v := checkGroupSpacing(cfg, games); len(v) != 0 {
+			t.Errorf("expected 0 warnings, got %d: %v", len(v), v)
+		}
+	})
+
+	t.Run("warning when team faces group twice too soon", func(t *testing.T) {
+		games := []parsedGame{
+			{Row: 2, Date: d(5, 1), Home: "Angels", Away: "Cubs"},
+			{Row: 3, Date: d(5, 4), Home: "Padres", Away: "Angels"},
+		}
+		v := checkGroupSpacing(cfg, games)
+		if len(v) != 1 {
+			t.Fatalf("expected 1 warning, got %d: %v", len(v), v)
+		}
+		if v[0].Type != "warning" || !strings.Contains(v[0].Message, "Angels plays opponent group Travel") {
+			t.Errorf("unexpected violation: %+v", v[0])
+		}
+	})
+}
+
+func TestCheckHomeField(t *testing.T) {
+	cfg := &config.Config{
+		Fields: []config.Field{{Name: "Symonds Field"}, {Name: "Washington Park"}},
+		Teams:  []config.Team{{Name: "Angels", HomeField: "Symonds Field"}},
+	}
+
+	t.Run("home games on the home field pass", func(t *testing.T) {
+		games := []parsedGame{
+			{Row: 2, Date: d(5, 1), Field: "Symonds", Home: "Angels", Away: "Cubs"},
+			{Row: 3, Date: d(5, 2), Field: "Washington", Home: "Cubs", Away: "Angels"},
+		}
+		if v := checkHomeField(cfg, games); len(v) != 0 {
+			t.Errorf("expected 0 violations, got %d: %v", len(v), v)
+		}
+	})
+
+	t.Run("home game elsewhere is an error", func(t *testing.T) {
+		games := []parsedGame{
+			{Row: 4, Date: d(5, 3), Field: "Washington", Home: "Angels", Away: "Cubs"},
+		}
+		v := checkHomeField(cfg, games)
+		if len(v) != 1 {
+			t.Fatalf("expected 1 violation, got %d: %v", len(v), v)
+		}
+		if v[0].Type != "error" || v[0].Row != 4 || !strings.Contains(v[0].Message, "must be on Symonds Field") {
+			t.Errorf("unexpected violation: %+v", v[0])
+		}
+	})
+}
+
+func TestCheckExcludedFields(t *testing.T) {
+	cfg := &config.Config{
+		Fields: []config.Field{{Name: "Symonds Field"}, {Name: "Washington Park"}},
+		Teams:  []config.Team{{Name: "Royals", ExcludedFields: []string{"Washington Park"}}},
+	}
+
+	t.Run("games on other fields pass", func(t *testing.T) {
+		games := []parsedGame{
+			{Row: 2, Date: d(5, 1), Field: "Symonds", Home: "Royals", Away: "Cubs"},
+			{Row: 3, Date: d(5, 2), Field: "Washington", Home: "Cubs", Away: "Padres"},
+		}
+		if v := checkExcludedFields(cfg, games); len(v) != 0 {
+			t.Errorf("expected 0 violations, got %v", v)
+		}
+	})
+
+	t.Run("home or away on an excluded field is an error", func(t *testing.T) {
+		games := []parsedGame{
+			{Row: 4, Date: d(5, 3), Field: "Washington", Home: "Royals", Away: "Cubs"},
+			{Row: 5, Date: d(5, 4), Field: "Washington", Home: "Padres", Away: "Royals"},
+		}
+		v := checkExcludedFields(cfg, games)
+		if len(v) != 2 {
+			t.Fatalf("expected 2 violations, got %v", v)
+		}
+		for i, row := range []int{4, 5} {
+			if v[i].Type != "error" || v[i].Row != row || !strings.Contains(v[i].Message, "Royals can't use") {
+				t.Errorf("unexpected violation: %+v", v[i])
+			}
+		}
+	})
+}
+
+func TestCheckFieldBuffer(t *testing.T) {
+	cfg := &config.Config{
+		Fields:    []config.Field{{Name: "Symonds Field", MinMinutesBetweenGames: 30}, {Name: "Washington Park"}},
+		TimeSlots: config.TimeSlots{GameMinutes: 120},
+	}
+	tests := []struct {
+		name  string
+		games []parsedGame
+		want  int
+	}{
+		{"enough time between games", []parsedGame{
+			{Row: 2, Date: d(5, 2), Time: "12:30", Field: "Symonds", Home: "Angels", Away: "Cubs"},
+			{Row: 3, Date: d(5, 2), Time: "15:00", Field: "Symonds", Home: "Astros", Away: "Padres"},
+		}, 0},
+		{"back-to-back slots", []parsedGame{
+			{Row: 2, Date: d(5, 2), Time: "12:30", Field: "Symonds", Home: "Angels", Away: "Cubs"},
+			{Row: 3, Date: d(5, 2), Time: "14:45", Field: "Symonds", Home: "Astros", Away: "Padres"},
+		}, 1},
+		{"field without a buffer", []parsedGame{
+			{Row: 2, Date: d(5, 2), Time: "12:30", Field: "Washington", Home: "Angels", Away: "Cubs"},
+			{Row: 3, Date: d(5, 2), Time: "14:45", Field: "Washington", Home: "Astros", Away: "Padres"},
+		}, 0},
+		{"different days", []parsedGame{
+			{Row: 2, Date: d(5, 2), Time: "14:45", Field: "Symonds", Home: "Angels", Away: "Cubs"},
+			{Row: 3, Date: d(5, 3), Time: "12:30", Field: "Symonds", Home: "Astros", Away: "Padres"},
+		}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := checkFieldBuffer(cfg, tt.games)
+			if len(v) != tt.want {
+				t.Fatalf("expected %d violations, got %d: %v", tt.want, len(v), v)
+			}
+			if tt.want > 0 && (v[0].Type != "error" || v[0].Row != 3) {
+				t.Errorf("unexpected violation: %+v", v[0])
+			}
+		})
+	}
+}
+
+func TestCheckFieldOverlap(t *testing.T) {
+	tests := []struct {
+		name    string
+		minutes int
+		games   []parsedGame
+		want    int
+	}{
+		{"first game runs into the second", 0, []parsedGame{
+			{Row: 2, Date: d(5, 2), Time: "12:30", Field: "Symonds", Home: "Angels", Away: "Cubs"},
+			{Row: 3, Date: d(5, 2), Time: "14:00", Field: "Symonds", Home: "Astros", Away: "Padres"},
+		}, 1},
+		{"shorter games fit", 90, []parsedGame{
+			{Row: 2, Date: d(5, 2), Time: "12:30", Field: "Symonds", Home: "Angels", Away: "Cubs"},
+			{Row: 3, Date: d(5, 2), Time: "14:00", Field: "Symonds", Home: "Astros", Away: "Padres"},
+		}, 0},
+		{"different fields", 0, []parsedGame{
+			{Row: 2, Date: d(5, 2), Time: "12:30", Field: "Symonds", Home: "Angels", Away: "Cubs"},
+			{Row: 3, Date: d(5, 2), Time: "14:00", Field: "Washington", Home: "Astros", Away: "Padres"},
+		}, 0},
+		{"different days", 0, []parsedGame{
+			{Row: 2, Date: d(5, 2), Time: "14:00", Field: "Symonds", Home: "Angels", Away: "Cubs"},
+			{Row: 3, Date: d(5, 3), Time: "12:30", Field: "Symonds", Home: "Astros", Away: "Padres"},
+		}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{TimeSlots: config.TimeSlots{GameMinutes: tt.minutes}}
+			v := checkFieldOverlap(cfg, tt.games)
+			if len(v) != tt.want {
+				t.Fatalf("expected %d violations, got %d: %v", tt.want, len(v), v)
+			}
+			if tt.want > 0 && (v[0].Type != "error" || v[0].Row != 3) {
+				t.Errorf("unexpected violation: %+v", v[0])
+			}
+		})
+	}
+
+	t.Run("buffer check leaves overlaps alone", func(t *testing.T) {
+		cfg := &config.Config{Fields: []config.Field{{Name: "Symonds Field", MinMinutesBetweenGames: 30}}}
+		games := []parsedGame{
+			{Row: 2, Date: d(5, 2), Time: "12:30", Field: "Symonds", Home: "Angels", Away: "Cubs"},
+			{Row: 3, Date: d(5, 2), Time: "14:00", Field: "Symonds", Home: "Astros", Away: "Padres"},
+		}
+		if v := checkFieldBuffer(cfg, games); len(v) != 0 {
+			t.Errorf("expected only the overlap error, got buffer violations %v", v)
+		}
+	})
+}
+
+func TestCheckGameOnLegalDate(t *testing.T) {
+	cfg := fullTestConfig()
+	reserved := date(2026, 5, 5)
+	cfg.Fields[1].Reservations = []config.Reservation{{Date: &reserved, Reason: "Freshman"}}
+
+	t.Run("no violation for a game in an open slot", func(t *testing.T) {
+		games := []parsedGame{
+			{Row: 2, Date: d(5, 4), Time: "17:45", Field: "Symonds", Home: "Angels", Away: "Cubs"},
+		}
+		if v := checkGameOnLegalDate(cfg, games); len(v) != 0 {
+			t.Errorf("expected 0 violations, got %v", v)
+		}
+	})
+
+	tests := []struct {
+		name string
+		game parsedGame
+		want string
+	}{
+		{"blackout date", parsedGame{Row: 3, Date: d(5, 10), Time: "17:00", Field: "Symonds"}, "blackout date (Mother's Day)"},
+		{"outside the season", parsedGame{Row: 4, Date: d(6, 10), Time: "17:45", Field: "Symonds"}, "outside the season"},
+		{"time not configured for day", parsedGame{Row: 5, Date: d(5, 4), Time: "12:30", Field: "Symonds"}, "not a scheduled time"},
+		{"reserved field", parsedGame{Row: 6, Date: d(5, 5), Time: "17:45", Field: "Symonds"}, "reserved (Freshman)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.game.Home, tt.game.Away = "Angels", "Cubs"
+			v := checkGameOnLegalDate(cfg, []parsedGame{tt.game})
+			if len(v) != 1 {
+				t.Fatalf("expected 1 violation, got %d", len(v))
+			}
+			if v[0].Type != "error" || v[0].Row != tt.game.Row || !strings.Contains(v[0].Message, tt.want) {
+				t.Errorf("violation = %+v, want error on row %d containing %q", v[0], tt.game.Row, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckGameOnLegalDateRampUp(t *testing.T) {
+	cfg := fullTestConfig()
+	cfg.Season.WeekdayStartOffset = 14
+	games := []parsedGame{
+		{Row: 2, Date: d(5, 2), Time: "12:30", Field: "Symonds", Home: "Angels", Away: "Cubs"},
+		{Row: 3, Date: d(5, 4), Time: "17:45", Field: "Symonds", Home: "Angels", Away: "Cubs"},
+	}
+	v := checkGameOnLegalDate(cfg, games)
+	if len(v) != 1 || v[0].Row != 3 || !strings.Contains(v[0].Message, "weekday game before 05/09") {
+		t.Errorf("violations = %v, want one for the Monday game before 05/09", v)
+	}
+}
+
+func TestCheckGameOnLegalDateReservedOverflow(t *testing.T) {
+	cfg := fullTestConfig()
+	overflowEnd := date(2026, 6, 12)
+	cfg.Season.OverflowEndDate = &overflowEnd
+	start, end := date(2026, 5, 30), date(2026, 6, 3)
+	cfg.Fields[1].Reservations = []config.Reservation{{StartDate: &start, EndDate: &end, Reason: "Tournament"}}
+	overflowDay := d(6, 2)
+	games := []parsedGame{
+		{Row: 2, Date: overflowDay, Time: "17:45", Field: "Symonds", Home: "Angels", Away: "Cubs"},
+		{Row: 3, Date: overflowDay, Time: "17:45", Field: "Moscariello", Home: "Angels", Away: "Cubs"},
+	}
+	v := checkGameOnLegalDate(cfg, games)
+	if len(v) != 1 || v[0].Row != 2 || !strings.Contains(v[0].Message, "reserved (Tournament)") {
+		t.Errorf("violations = %v, want one for the reserved overflow game on row 2", v)
+	}
+}
+
+func TestCheckGameOnLegalDateFieldTimes(t *testing.T) {
+	cfg := fullTestConfig()
+	cfg.Fields[2].TimeSlots = &config.FieldTimeSlots{Saturday: []string{"9:00"}}
+	games := []parsedGame{
+		{Row: 2, Date: d(5, 2), Time: "9:00", Field: "Washington", Home: "Angels", Away: "Cubs"},
+		{Row: 3, Date: d(5, 9), Time: "9:00", Field: "Symonds", Home: "Astros", Away: "Padres"},
+		{Row: 4, Date: d(5, 9), Time: "12:30", Field: "Washington", Home: "Angels", Away: "Cubs"},
+	}
+	v := checkGameOnLegalDate(cfg, games)
+	if len(v) != 2 || v[0].Row != 3 || !strings.Contains(v[0].Message, "9:00 is not a time Symonds offers on Saturday") ||
+		v[1].Row != 4 || !strings.Contains(v[1].Message, "12:30 is not a time Washington offers on Saturday") {
+		t.Errorf("violations = %v, want rows 3 and 4 off their fields' times", v)
+	}
+}
+
+func TestCheckSundayBalance(t *testing.T) {
+	// Angels play two Sundays, Cubs none: a spread of 2.
+	games := []parsedGame{
+		{Row: 2, Date: d(5, 3), Home: "Angels", Away: "Astros"},
+		{Row: 3, Date: d(5, 17), Home: "Angels", Away: "Astros"},
+	}
+	one, two := 1, 2
+
+	tests := []struct {
+		name      string
+		tolerance *int
+		want      int
+	}{
+		{"default tolerance of 1", nil, 1},
+		{"explicit tolerance of 1", &one, 1},
+		{"tolerance of 2", &two, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := fullTestConfig()
+			cfg.Guidelines.SundayBalanceTolerance = tt.tolerance
+			if v := checkSundayBalance(cfg, games); len(v) != tt.want {
+				t.Errorf("expected %d violations, got %v", tt.want, v)
+			}
+		})
+	}
+}
+
+func TestCheckPaceBalance(t *testing.T) {
+	// By the end of the week of May 4 the Angels and Astros have played
+	// three games and everyone else none.
+	games := []parsedGame{
+		{Row: 2, Date: d(5, 4), Home: "Angels", Away: "Astros"},
+		{Row: 3, Date: d(5, 6), Home: "Astros", Away: "Angels"},
+		{Row: 4, Date: d(5, 8), Home: "Angels", Away: "Astros"},
+		{Row: 5, Date: d(5, 12), Home: "Cubs", Away: "Padres"},
+	}
+	two, three := 2, 3
+
+	t.Run("quiet without a tolerance", func(t *testing.T) {
+		if v := checkPaceBalance(fullTestConfig(), games); len(v) != 0 {
+			t.Errorf("expected 0 violations, got %v", v)
+		}
+	})
+
+	t.Run("warns past a configured tolerance", func(t *testing.T) {
+		cfg := fullTestConfig()
+		cfg.Guidelines.PaceBalanceTolerance = &two
+		v := checkPaceBalance(cfg, games)
+		if len(v) != 1 || v[0].Type != "warning" || !strings.Contains(v[0].Message, "week of May 4") {
+			t.Errorf("expected a pace warning for the week of May 4, got %v", v)
+		}
+	})
+
+	t.Run("quiet within a configured tolerance", func(t *testing.T) {
+		cfg := fullTestConfig()
+		cfg.Guidelines.PaceBalanceTolerance = &three
+		if v := checkPaceBalance(cfg, games); len(v) != 0 {
+			t.Errorf("expected 0 violations, got %v", v)
+		}
+	})
+
+	t.Run("quiet when balance_pace is off", func(t *testing.T) {
+		cfg := fullTestConfig()
+		cfg.Guidelines.BalancePace = false
+		cfg.Guidelines.PaceBalanceTolerance = &two
+		if v := checkPaceBalance(cfg, games); len(v) != 0 {
+			t.Errorf("expected 0 violations, got %v", v)
+		}
+	})
+}
+
+func TestCheckBothWeekendDays(t *testing.T) {
+	// May 2 and 3, 2026 are a Saturday and Sunday; May 9 is the next Saturday.
+	games := []parsedGame{
+		{Row: 2, Date: d(5, 2), Home: "Angels", Away: "Astros"},
+		{Row: 3, Date: d(5, 3), Home: "Cubs", Away: "Angels"},
+		{Row: 4, Date: d(5, 9), Home: "Astros", Away: "Cubs"},
+	}
+
+	t.Run("warns for each full weekend", func(t *testing.T) {
+		cfg := fullTestConfig()
+		cfg.Guidelines.AvoidBothWeekendDays = true
+		v := checkBothWeekendDays(cfg, games)
+		if len(v) != 1 || v[0].Type != "warning" || v[0].Message != "Angels plays both days of the weekend of 05/02" {
+			t.Errorf("expected one warning for the Angels, got %v", v)
+		}
+	})
+
+	t.Run("quiet when avoid_both_weekend_days is off", func(t *testing.T) {
+		if v := checkBothWeekendDays(fullTestConfig(), games); len(v) != 0 {
+			t.Errorf("expected 0 violations, got %v", v)
+		}
+	})
+}